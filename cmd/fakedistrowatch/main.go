@@ -0,0 +1,219 @@
+// Command fakedistrowatch serves a synthetic DistroWatch site for local development and tests:
+// the main page with the ranking table (over the span given in the parameter dataspan), distribution pages with screenshot links and details,
+// and the screenshots.
+//
+// The ranking is generated from -seed and the date, so it changes day by day and is reproducible,
+// or loaded from a JSON file with -ranking. Run the parser against it with
+//
+//	parser -base-url http://localhost:8081/
+package main
+
+import (
+	"flag"
+	"fmt"
+	"hash/fnv"
+	"html/template"
+	"image"
+	"image/color"
+	"image/png"
+	"log"
+	"net/http"
+	"path"
+	"strings"
+	"time"
+)
+
+const screenshotsPath = "/images/ktyxqzobhgijab/"
+
+var (
+	addr        = flag.String("addr", ":8081", "listen address")
+	seed        = flag.Int64("seed", 1, "seed of the generated ranking")
+	dateStr     = flag.String("date", "", "date of the generated ranking in format YYYYMMDD, today by default")
+	rows        = flag.Int("rows", 100, "number of rows in the generated ranking")
+	noEqual     = flag.Bool("no-equal", false, "generate ranking without rows with trend '='")
+	rankingPath = flag.String("ranking", "", "JSON file with ranking: [{\"name\", \"slug\", \"hpd\", \"trend\"}, ...]; overrides generation")
+)
+
+var funcMap = template.FuncMap{
+	"inc": func(i int) int { return i + 1 },
+	"trendImage": func(trend string) string {
+		switch trend {
+		case "<":
+			return "adown.png"
+		case ">":
+			return "aup.png"
+		}
+		return "alevel.png"
+	},
+}
+
+var indexTemplate = template.Must(template.New("index").Funcs(funcMap).Parse(`<!DOCTYPE html>
+<html>
+<head><title>DistroWatch.com: Put the fun back into computing. Use Linux, BSD.</title></head>
+<body>
+<form action="index.php" method="get">
+  <select name="dataspan">
+    <option value="52">Last 12 months</option>
+    <option value="26" selected="selected">Last 6 months</option>
+    <option value="13">Last 3 months</option>
+    <option value="4">Last 1 month</option>
+    <option value="2024">2024</option>
+    <option value="2023">2023</option>
+  </select>
+  <input type="submit" value="Go" />
+</form>
+<table class="News">
+  <tr><th class="Invert" colspan="3">Page Hit Ranking</th></tr>
+  <tr><th class="phr1">Rank</th><th class="phr2">Distribution</th><th class="phr3">HPD*</th></tr>
+{{- range $i, $row := . }}
+  <tr>
+    <th class="phr1">{{ inc $i }}</th>
+    <td class="phr2"><a href="table.php?distribution={{ $row.Slug }}" title="{{ $row.Name }}">{{ $row.Name }}</a></td>
+    <td class="phr3" title="Yesterday: {{ $row.HPD }}">{{ $row.HPD }}<img src="images/other/{{ trendImage $row.Trend }}" alt="{{ $row.Trend }}" title="Yesterday: {{ $row.HPD }}" /></td>
+  </tr>
+{{- end }}
+</table>
+</body>
+</html>
+`))
+
+var distrTemplate = template.Must(template.New("distr").Parse(`<!DOCTYPE html>
+<html>
+<head><title>DistroWatch.com: {{ .Name }}</title></head>
+<body>
+<table class="Info">
+  <tr>
+    <td class="TablesTitle"><a href="images/ktyxqzobhgijab/{{ .Slug }}.png"><img src="images/ktyxqzobhgijab/{{ .Slug }}-small.png" alt="{{ .Name }}" /></a>
+      <h1>{{ .Name }}</h1>
+      <ul>
+        <li><b>OS Type:</b> <a href="search.php?ostype={{ .Info.OSType }}">{{ .Info.OSType }}</a></li>
+        <li><b>Based on:</b> {{ range $i, $v := .Info.BasedOn }}{{ if $i }}, {{ end }}<a href="search.php?basedon={{ $v }}">{{ $v }}</a>{{ end }}</li>
+        <li><b>Origin:</b> <a href="search.php?origin={{ .Info.Origin }}">{{ .Info.Origin }}</a></li>
+        <li><b>Architecture:</b> {{ range $i, $v := .Info.Architectures }}{{ if $i }}, {{ end }}<a href="search.php?arch={{ $v }}">{{ $v }}</a>{{ end }}</li>
+        <li><b>Desktop:</b> {{ range $i, $v := .Info.Desktops }}{{ if $i }}, {{ end }}<a href="search.php?desktop={{ $v }}">{{ $v }}</a>{{ end }}</li>
+        <li><b>Category:</b> {{ range $i, $v := .Info.Categories }}{{ if $i }}, {{ end }}<a href="search.php?category={{ $v }}">{{ $v }}</a>{{ end }}</li>
+        <li><b>Status:</b> <font color="green">{{ .Info.Status }}</font></li>
+      </ul>
+    </td>
+  </tr>
+</table>
+<table class="Info">
+  <tr>
+    <th class="Info">Feature</th>
+{{- range .Info.Versions }}
+    <th class="TablesInvert">{{ . }}</th>
+{{- end }}
+  </tr>
+</table>
+</body>
+</html>
+`))
+
+// dataSpans are the tables distribution pages are looked up in: the default one (the last 26 weeks)
+// and the last 4, 13 and 52 weeks.
+var dataSpans = []string{"", "4", "13", "52"}
+
+// defaultDataSpan is the data span of the default table.
+const defaultDataSpan = "26"
+
+// ranking returns the ranking over the data span to serve now.
+// The ranking loaded with -ranking is served for every data span.
+func ranking(dataSpan string) ([]Row, error) {
+	if *rankingPath != "" {
+		return loadRanking(*rankingPath)
+	}
+	date := time.Now()
+	if *dateStr != "" {
+		var err error
+		date, err = time.Parse("20060102", *dateStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return generateRanking(*seed, dataSpan, date, *rows, *noEqual), nil
+}
+
+func handleIndex(w http.ResponseWriter, r *http.Request) {
+	if r.URL.Path != "/" && r.URL.Path != "/index.php" {
+		http.NotFound(w, r)
+		return
+	}
+	dataSpan := r.URL.Query().Get("dataspan")
+	if dataSpan == defaultDataSpan {
+		dataSpan = ""
+	}
+	table, err := ranking(dataSpan)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Content-Type", "text/html; charset=utf-8")
+	if err := indexTemplate.Execute(w, table); err != nil {
+		log.Print(err)
+	}
+}
+
+func handleDistr(w http.ResponseWriter, r *http.Request) {
+	slug := r.URL.Query().Get("distribution")
+	// The distribution may be ranked in any of the tables.
+	for _, dataSpan := range dataSpans {
+		table, err := ranking(dataSpan)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		for _, row := range table {
+			if row.Slug == slug {
+				w.Header().Set("Content-Type", "text/html; charset=utf-8")
+				data := struct {
+					Row
+					Info Info
+				}{row, distrInfo(row.Name)}
+				if err := distrTemplate.Execute(w, data); err != nil {
+					log.Print(err)
+				}
+				return
+			}
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// handleScreenshot serves PNG filled with the color derived from the file name.
+func handleScreenshot(w http.ResponseWriter, r *http.Request) {
+	name := path.Base(r.URL.Path)
+	if path.Ext(name) != ".png" {
+		http.NotFound(w, r)
+		return
+	}
+	h := fnv.New32a()
+	fmt.Fprint(h, strings.TrimSuffix(name, "-small.png"))
+	sum := h.Sum32()
+	fill := color.NRGBA{R: uint8(sum), G: uint8(sum >> 8), B: uint8(sum >> 16), A: 0xff}
+
+	width, height := 320, 200
+	if strings.HasSuffix(name, "-small.png") {
+		width, height = 80, 50
+	}
+	img := image.NewNRGBA(image.Rect(0, 0, width, height))
+	for i := 0; i < len(img.Pix); i += 4 {
+		img.Pix[i], img.Pix[i+1], img.Pix[i+2], img.Pix[i+3] = fill.R, fill.G, fill.B, fill.A
+	}
+	w.Header().Set("Content-Type", "image/png")
+	if err := png.Encode(w, img); err != nil {
+		log.Print(err)
+	}
+}
+
+func main() {
+	flag.Parse()
+	if _, err := ranking(""); err != nil {
+		log.Fatal(err)
+	}
+
+	http.HandleFunc("/", handleIndex)
+	http.HandleFunc("/table.php", handleDistr)
+	http.HandleFunc(screenshotsPath, handleScreenshot)
+	log.Printf("Serving fake DistroWatch on %s", *addr)
+	log.Fatal(http.ListenAndServe(*addr, nil))
+}
@@ -0,0 +1,169 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"os"
+	"strings"
+	"time"
+)
+
+// names is the pool of distributions the synthetic ranking is drawn from.
+var names = []string{
+	"MX Linux", "Mint", "EndeavourOS", "Debian", "Manjaro", "Pop!_OS", "Ubuntu", "Fedora",
+	"openSUSE", "Zorin", "elementary", "antiX", "NixOS", "Garuda", "KDE neon", "Kali",
+	"Solus", "Lite", "PCLinuxOS", "Puppy", "Arch", "Tails", "FreeBSD", "Slackware",
+	"Alpine", "Lubuntu", "Xubuntu", "Kubuntu", "Rocky", "AlmaLinux", "Gentoo", "Void",
+	"Mageia", "Peppermint", "Q4OS", "Sparky", "deepin", "Bodhi", "Parrot", "ArcoLinux",
+	"Kodachi", "Devuan", "CachyOS", "Nobara", "BigLinux", "Vanilla", "Bazzite", "Feren",
+	"Linuxfx", "OpenMandriva", "Ubuntu MATE", "Ubuntu Budgie", "Kaisen", "Regata",
+	"Artix", "Calculate", "Clear", "Qubes", "Whonix", "BunsenLabs", "Trisquel", "Guix",
+	"Haiku", "ReactOS", "GhostBSD", "NetBSD", "OpenBSD", "DragonFly", "TrueNAS", "Proxmox",
+	"Oracle", "RHEL", "CentOS", "Scientific", "ALT", "ROSA", "Astra", "Red OS",
+	"Kylin", "openKylin", "Ubuntu Kylin", "Emmabuntus", "Linux Lite", "LXLE", "Porteus", "Slax",
+	"SliTaz", "Tiny Core", "Damn Small", "Absolute", "Salix", "Zenwalk", "KaOS", "Chakra",
+	"Sabayon", "Redcore", "Exherbo", "Funtoo", "Septor", "Tsurugi", "BackBox", "Pentoo",
+	"BlackArch", "DragonOS", "Ultramarine", "Rhino", "Crunchbang++", "Vinari", "Nitrux", "blendOS",
+	"Athena", "Spiral", "Siduction", "Makulu", "Endless", "ChromeOS Flex", "FydeOS", "Kwort",
+}
+
+// Row describes one row of the ranking table.
+type Row struct {
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	HPD   int    `json:"hpd"`
+	Trend string `json:"trend"` // "<", ">" or "="
+}
+
+// slugify makes DistroWatch-like slug from the distribution name.
+func slugify(name string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(name) {
+		if r >= 'a' && r <= 'z' || r >= '0' && r <= '9' {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// generateRanking returns ranking over the data span for the given date which depends only on seed,
+// data span and date, so that consecutive days and different spans give different, but reproducible tables.
+// The empty data span is the default table of the main page.
+func generateRanking(seed int64, dataSpan string, date time.Time, rows int, noEqual bool) []Row {
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%s", seed, date.Format("20060102"))
+	if dataSpan != "" {
+		fmt.Fprintf(h, "/%s", dataSpan)
+	}
+	rnd := rand.New(rand.NewSource(int64(h.Sum64())))
+
+	pool := make([]string, len(names))
+	for i, j := range rnd.Perm(len(names)) {
+		pool[i] = names[j]
+	}
+	if rows > len(pool) {
+		rows = len(pool)
+	}
+
+	ranking := make([]Row, rows)
+	hpd := 2500 + rnd.Intn(1000)
+	for i := range ranking {
+		var trend string
+		switch n := rnd.Intn(10); {
+		case n < 4:
+			trend = "<"
+		case n < 8:
+			trend = ">"
+		default:
+			trend = "="
+		}
+		if noEqual && trend == "=" {
+			trend = ">"
+		}
+		ranking[i] = Row{Name: pool[i], Slug: slugify(pool[i]), HPD: hpd, Trend: trend}
+		hpd -= rnd.Intn(hpd/20 + 2)
+		if hpd < 1 {
+			hpd = 1
+		}
+	}
+	return ranking
+}
+
+// loadRanking reads ranking from JSON file with array of Row.
+func loadRanking(path string) ([]Row, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var ranking []Row
+	if err := json.NewDecoder(f).Decode(&ranking); err != nil {
+		return nil, err
+	}
+	for i := range ranking {
+		if ranking[i].Slug == "" {
+			ranking[i].Slug = slugify(ranking[i].Name)
+		}
+	}
+	return ranking, nil
+}
+
+// Info is the details of a distribution shown on its page.
+type Info struct {
+	OSType        string
+	BasedOn       []string
+	Origin        string
+	Architectures []string
+	Desktops      []string
+	Categories    []string
+	Status        string
+	Versions      []string
+}
+
+var (
+	bases      = []string{"Independent", "Debian", "Ubuntu", "Fedora", "Arch", "Gentoo", "Slackware"}
+	origins    = []string{"USA", "Germany", "France", "Russia", "Brazil", "Japan", "Ireland", "Italy"}
+	archs      = []string{"x86_64", "aarch64", "i686", "armhf", "riscv64"}
+	desktops   = []string{"KDE Plasma", "GNOME", "Xfce", "Cinnamon", "MATE", "LXQt", "No desktop"}
+	categories = []string{"Desktop", "Live Medium", "Beginners", "Server", "Security", "Old Computers"}
+)
+
+// pick returns 1..max distinct items of pool chosen by rnd.
+func pick(rnd *rand.Rand, pool []string, max int) []string {
+	n := 1 + rnd.Intn(max)
+	items := make([]string, n)
+	for i, j := range rnd.Perm(len(pool))[:n] {
+		items[i] = pool[j]
+	}
+	return items
+}
+
+// distrInfo returns details of the distribution which depend only on its name.
+func distrInfo(name string) Info {
+	h := fnv.New64a()
+	fmt.Fprint(h, name)
+	rnd := rand.New(rand.NewSource(int64(h.Sum64())))
+	info := Info{
+		OSType:        "Linux",
+		BasedOn:       pick(rnd, bases, 2),
+		Origin:        origins[rnd.Intn(len(origins))],
+		Architectures: pick(rnd, archs, 3),
+		Desktops:      pick(rnd, desktops, 3),
+		Categories:    pick(rnd, categories, 2),
+		Status:        "Active",
+	}
+	if strings.HasSuffix(name, "BSD") {
+		info.OSType = "BSD"
+		info.BasedOn = []string{"Independent"}
+	}
+	if rnd.Intn(10) == 0 {
+		info.Status = "Dormant"
+	}
+	major := 1 + rnd.Intn(30)
+	for i := 0; i < 3; i++ {
+		info.Versions = append(info.Versions, fmt.Sprintf("%d.%d", major, 2-i))
+	}
+	return info
+}
@@ -0,0 +1,80 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"sort"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// mergeMain handles subcommand merge.
+func mergeMain(args []string) {
+	flags := flag.NewFlagSet("merge", flag.ExitOnError)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: parser merge FROM INTO")
+		fmt.Fprintln(flags.Output(), "Merges everything recorded for distribution FROM into distribution INTO, e.g. after renaming,")
+		fmt.Fprintln(flags.Output(), "and makes FROM an alias of INTO.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	from, into := flags.Arg(0), flags.Arg(1)
+
+	lock, err := acquireLock()
+	check(err)
+	defer releaseLock(lock)
+	db, err := distrowatch.GetDB()
+	check(err)
+	defer closeCheck(db)
+
+	affected, err := parser.Merge(context.Background(), db, from, into, time.Now())
+	check(err)
+	fmt.Printf("Merged %q into %q.\n", from, into)
+	tables := make([]string, 0, len(affected))
+	for table := range affected {
+		tables = append(tables, table)
+	}
+	sort.Strings(tables)
+	for _, table := range tables {
+		fmt.Printf("  %s: %d rows\n", table, affected[table])
+	}
+}
+
+// aliasMain handles subcommand alias.
+func aliasMain(args []string) {
+	flags := flag.NewFlagSet("alias", flag.ExitOnError)
+	slug := flags.Bool("slug", false, "ALIAS is a DistroWatch slug like mint rather than a name")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: parser alias [-slug] ALIAS CANONICAL")
+		fmt.Fprintln(flags.Output(), "Makes the parser count distribution ALIAS as CANONICAL. Use merge for already counted distributions.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 2 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	kind := parser.AliasName
+	if *slug {
+		kind = parser.AliasSlug
+	}
+
+	lock, err := acquireLock()
+	check(err)
+	defer releaseLock(lock)
+	db, err := distrowatch.GetDB()
+	check(err)
+	defer closeCheck(db)
+
+	err = parser.AddAlias(context.Background(), db, kind, flags.Arg(0), flags.Arg(1))
+	check(err)
+	fmt.Printf("%s %q is an alias of %q.\n", kind, flags.Arg(0), flags.Arg(1))
+}
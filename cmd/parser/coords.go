@@ -0,0 +1,35 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// recomputeCoordsMain handles subcommand recompute-coords.
+func recomputeCoordsMain(args []string) {
+	flags := flag.NewFlagSet("recompute-coords", flag.ExitOnError)
+	sourceStr := flags.String("source", parser.DefaultSource.Name, sourceUsage)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: parser recompute-coords [-source S]")
+		fmt.Fprintln(flags.Output(), "Recomputes coords of the source from the stored diffs and trends and their places.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	source, err := parser.ParseSource(*sourceStr)
+	check(err)
+
+	lock, err := acquireLock()
+	check(err)
+	defer releaseLock(lock)
+	db, err := distrowatch.GetDB()
+	check(err)
+	defer closeCheck(db)
+
+	count, maxChange, err := parser.RecomputeCoords(context.Background(), db, source)
+	check(err)
+	fmt.Printf("Recomputed %d rows, the biggest change is %.4f°.\n", count, maxChange)
+}
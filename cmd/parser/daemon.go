@@ -0,0 +1,134 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// parseClock parses local time of day in format HH:MM.
+func parseClock(s string) (hour, minute int, err error) {
+	t, err := time.Parse("15:04", s)
+	if err != nil {
+		return 0, 0, fmt.Errorf("invalid time of day %q, expected HH:MM", s)
+	}
+	return t.Hour(), t.Minute(), nil
+}
+
+// sleepUntil sleeps until t or until ctx is done.
+func sleepUntil(ctx context.Context, t time.Time) error {
+	timer := time.NewTimer(time.Until(t))
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// daemonMain runs the update of every source every day at hour:minute in opts.Location and retries failures
+// every retryInterval until deadline passes since the scheduled time.
+// If it is started within the window, the update runs immediately.
+func daemonMain(ctx context.Context, db *sql.DB, opts parser.Options, sources []parser.Source, hour, minute int, deadline, retryInterval time.Duration) {
+	now := time.Now().In(opts.Location)
+	next := time.Date(now.Year(), now.Month(), now.Day(), hour, minute, 0, 0, opts.Location)
+	if now.After(next.Add(deadline)) {
+		next = next.AddDate(0, 0, 1)
+	}
+
+	for {
+		recordMissedRuns(ctx, db, opts, sources)
+
+		if time.Now().Before(next) {
+			log.Printf("Next run at %s", next.Format("2006-01-02 15:04"))
+			if err := sleepUntil(ctx, next); err != nil {
+				return
+			}
+		}
+		runUntil(ctx, db, opts, sources, next.Add(deadline), retryInterval)
+		if ctx.Err() != nil {
+			return
+		}
+		next = next.AddDate(0, 0, 1)
+	}
+}
+
+// recordMissedRuns records missed runs of every source under the lock.
+// If the lock is held by another parser, they are recorded next time.
+func recordMissedRuns(ctx context.Context, db *sql.DB, opts parser.Options, sources []parser.Source) {
+	lock, err := acquireLock()
+	if err != nil {
+		log.Printf("ERROR: could not record missed runs: %v", err)
+		return
+	}
+	defer releaseLock(lock)
+
+	for _, source := range sources {
+		opts.Source = source
+		missed, err := parser.RecordMissedRuns(ctx, db, opts)
+		if err != nil {
+			log.Printf("ERROR: could not record missed runs of source %s: %v", source, err)
+		}
+		for _, day := range missed {
+			log.Printf("WARNING: run of source %s of %s was missed", source, day.Format("2006-01-02"))
+		}
+	}
+}
+
+// runUntil runs the update of every source and retries the failed ones until deadline.
+func runUntil(ctx context.Context, db *sql.DB, opts parser.Options, sources []parser.Source, deadline time.Time, retryInterval time.Duration) {
+	ctx, cancel := context.WithDeadline(ctx, deadline)
+	defer cancel()
+
+	pending := sources
+	for attempt := 1; ; attempt++ {
+		failed := runSources(ctx, db, opts, pending, attempt)
+		if len(failed) == 0 {
+			return
+		}
+		pending = failed
+		if time.Now().Add(retryInterval).After(deadline) {
+			log.Printf("ERROR: giving up, deadline %s is reached", deadline.Format("2006-01-02 15:04"))
+			return
+		}
+		if err := sleepUntil(ctx, time.Now().Add(retryInterval)); err != nil {
+			return
+		}
+	}
+}
+
+// runSources runs the update of the sources under the lock and returns the failed ones.
+// If the lock is held by another parser, all sources fail.
+func runSources(ctx context.Context, db *sql.DB, opts parser.Options, sources []parser.Source, attempt int) []parser.Source {
+	lock, err := acquireLock()
+	if err != nil {
+		log.Printf("ERROR: attempt %d failed: %v", attempt, err)
+		return sources
+	}
+	defer releaseLock(lock)
+
+	var failed []parser.Source
+	for _, source := range sources {
+		opts.Source = source
+		outcome, err := parser.Update(ctx, db, opts)
+		switch {
+		case err == nil:
+			log.Printf("Database is updated from source %s: %s", source, outcome.DistrName)
+		case errors.Is(err, parser.ErrAlreadyUpdated):
+			log.Printf("Database is already updated from source %s today.", source)
+		case errors.Is(err, parser.ErrStaleRanking):
+			log.Printf("WARNING: attempt %d of source %s: ranking is not refreshed yet, waiting: %v", attempt, source, err)
+			failed = append(failed, source)
+		default:
+			log.Printf("ERROR: attempt %d of source %s failed: %v", attempt, source, err)
+			failed = append(failed, source)
+		}
+	}
+	return failed
+}
@@ -0,0 +1,121 @@
+package main
+
+import (
+	"bytes"
+	"context"
+	"database/sql"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"log"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// distrPage is the page of the winner of the main page in parser/testdata.
+const distrPage = `<html><body><table class="Info"><tr><td class="TablesTitle">
+<a href="images/ktyxqzobhgijab/mxlinux.png"><img src="images/ktyxqzobhgijab/mxlinux-small.png" alt="MX Linux" /></a>
+<ul><li><b>OS Type:</b> <a href="search.php?ostype=Linux">Linux</a></li></ul>
+</td></tr></table></body></html>`
+
+// newTestServer serves the main page returned by index, the page of its winner from distrPage and screenshots.
+func newTestServer(t *testing.T, index func() []byte) *httptest.Server {
+	var screenshot bytes.Buffer
+	if err := png.Encode(&screenshot, image.NewNRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatal(err)
+	}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch {
+		case r.URL.Path == "/":
+			w.Write(index())
+		case r.URL.Path == "/table.php":
+			w.Write([]byte(distrPage))
+		case strings.HasPrefix(r.URL.Path, "/images/"):
+			w.Write(screenshot.Bytes())
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+}
+
+func TestRunUntilRetriesStaleRanking(t *testing.T) {
+	dir, err := ioutil.TempDir("", "distrowatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "db.sqlite3")
+	defer os.Setenv("DISTRS_DATABASE", os.Getenv("DISTRS_DATABASE"))
+	os.Setenv("DISTRS_DATABASE", path)
+	db, err := sql.Open("sqlite3", distrowatch.DSN(path, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	if err := distrowatch.Migrate(db, path); err != nil {
+		t.Fatal(err)
+	}
+
+	index, err := ioutil.ReadFile("../../parser/testdata/index.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The main page is refreshed after the first three requests: the first day and two attempts of the second one.
+	refreshed := bytes.Replace(index, []byte(">2950<"), []byte(">2951<"), 1)
+	var mu sync.Mutex
+	var indexRequests int
+	server := newTestServer(t, func() []byte {
+		mu.Lock()
+		defer mu.Unlock()
+		indexRequests++
+		if indexRequests > 3 {
+			return refreshed
+		}
+		return index
+	})
+	defer server.Close()
+
+	first := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	opts := parser.Options{
+		BaseURL:  server.URL + "/",
+		Dir:      dir,
+		Retries:  -1,
+		Clock:    parser.FixedClock(first),
+		Location: time.UTC,
+		Logger:   log.New(ioutil.Discard, "", 0),
+	}
+	if _, err := parser.Update(context.Background(), db, opts); err != nil {
+		t.Fatal(err)
+	}
+
+	opts.Clock = parser.FixedClock(first.AddDate(0, 0, 1))
+	runUntil(context.Background(), db, opts, []parser.Source{parser.DefaultSource}, time.Now().Add(time.Minute), 10*time.Millisecond)
+
+	if indexRequests != 4 {
+		t.Errorf("the main page is requested %d times, want 4", indexRequests)
+	}
+	var runs string
+	if err := db.QueryRow("SELECT group_concat(date || ':' || status, ' ') FROM (SELECT * FROM runs ORDER BY id)").Scan(&runs); err != nil {
+		t.Fatal(err)
+	}
+	want := "20240301:success 20240302:stale 20240302:stale 20240302:success"
+	if runs != want {
+		t.Errorf("runs are %s, want %s", runs, want)
+	}
+	pending, err := parser.PendingSteps(context.Background(), db, parser.DefaultSource, first.AddDate(0, 0, 1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 0 {
+		t.Errorf("steps %v of the second day are pending", pending)
+	}
+}
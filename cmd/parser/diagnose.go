@@ -0,0 +1,64 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// diagnoseMain handles subcommand diagnose.
+func diagnoseMain(args []string) {
+	flags := flag.NewFlagSet("diagnose", flag.ExitOnError)
+	strategyName := flags.String("strategy", parser.DefaultStrategy.Name(), "winner selection strategy, see parser -h")
+	format := flags.String("format", "text", "output format: text or json")
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: parser diagnose [-strategy S] [-format text|json] FILE")
+		fmt.Fprintln(flags.Output(), "Runs the extraction of the ranking against a saved main page, e.g. from the diagnostics directory,")
+		fmt.Fprintln(flags.Output(), "and reports which element is missing, in which row and what is found instead. Exits with 1 if it fails.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if flags.NArg() != 1 || (*format != "text" && *format != "json") {
+		flags.Usage()
+		os.Exit(2)
+	}
+	strategy, err := parser.ParseStrategy(*strategyName)
+	check(err)
+
+	page, err := ioutil.ReadFile(flags.Arg(0))
+	check(err)
+	report, err := parser.Diagnose(page, parser.Options{Strategy: strategy})
+	check(err)
+	report.HTMLPath = flags.Arg(0)
+
+	if *format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetEscapeHTML(false)
+		encoder.SetIndent("", "  ")
+		check(encoder.Encode(report))
+	} else {
+		fmt.Printf("Page:     %s\n", report.HTMLPath)
+		fmt.Printf("Cells:    %d td.phr3\n", report.Cells)
+		if report.Error == "" {
+			fmt.Printf("Result:   OK, %d rows, winner %s\n", report.Rows, report.Winner)
+		} else {
+			fmt.Printf("Error:    %s\n", report.Error)
+			if report.Selector != "" {
+				fmt.Printf("Selector: %s\n", report.Selector)
+			}
+			if report.Row >= 0 {
+				fmt.Printf("Row:      %d (%d rows before it are parsed)\n", report.Row, report.Rows)
+			}
+			if report.Found != "" {
+				fmt.Printf("Found:    %s\n", report.Found)
+			}
+		}
+	}
+	if report.Error != "" {
+		os.Exit(1)
+	}
+}
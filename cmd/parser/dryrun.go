@@ -0,0 +1,144 @@
+package main
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/geo"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// openDryRunDB opens the database read-only and returns it with the number of pending migrations.
+// If migrations are pending, they are applied to a temporary copy of the database, which is returned instead,
+// so that the plan is made against the schema of a real run. The returned function closes the database
+// and removes the copy.
+func openDryRunDB() (*sql.DB, int, func(), error) {
+	database := distrowatch.DatabasePath()
+	if _, err := os.Stat(database); err != nil {
+		return nil, 0, nil, err
+	}
+	db, err := sql.Open("sqlite3", distrowatch.DSN(database, true))
+	if err != nil {
+		return nil, 0, nil, err
+	}
+	version, err := distrowatch.SchemaVersion(db)
+	if err != nil {
+		db.Close()
+		return nil, 0, nil, err
+	}
+	pending := distrowatch.LatestSchemaVersion() - version
+	if pending <= 0 {
+		return db, 0, func() { closeCheck(db) }, nil
+	}
+
+	dir, err := ioutil.TempDir("", "distrowatch-dry-run-")
+	if err != nil {
+		db.Close()
+		return nil, 0, nil, err
+	}
+	copyPath := filepath.Join(dir, "db.sqlite3")
+	_, err = db.Exec("VACUUM INTO ?", copyPath)
+	db.Close()
+	if err != nil {
+		os.RemoveAll(dir)
+		return nil, 0, nil, err
+	}
+	migrated, err := sql.Open("sqlite3", distrowatch.DSN(copyPath, false))
+	if err == nil {
+		err = distrowatch.Migrate(migrated, copyPath)
+	}
+	if err != nil {
+		if migrated != nil {
+			migrated.Close()
+		}
+		os.RemoveAll(dir)
+		return nil, 0, nil, err
+	}
+	return migrated, pending, func() {
+		closeCheck(migrated)
+		os.RemoveAll(dir)
+	}, nil
+}
+
+// dryRunMain fetches the outcome and prints what would be done with it.
+// The database is opened read-only, pending migrations are applied to its temporary copy,
+// and nothing is downloaded except pages.
+func dryRunMain(ctx context.Context, opts parser.Options, format string) {
+	db, pendingMigrations, closeDB, err := openDryRunDB()
+	check(err)
+	defer closeDB()
+	if pendingMigrations > 0 {
+		log.Printf("NOTE: %d migrations pending, a real run applies them first; the plan is made against a migrated temporary copy of the database.", pendingMigrations)
+	}
+
+	pending, err := parser.PendingSteps(ctx, db, opts.Source, opts.Today())
+	check(err)
+	updated := len(pending) == 0
+
+	// Nothing is written to disk, even if parsing fails.
+	opts.DiagnosticsDir = ""
+	outcome, err := parser.FetchOutcome(ctx, opts)
+	check(err)
+	plan, err := parser.MakePlan(ctx, db, outcome)
+	check(err)
+	page, err := parser.FetchDistrPage(ctx, opts, outcome.DistrURL)
+	check(err)
+	plan.ScreenshotURL = page.ScreenshotURL
+	plan.Info = &page.Info
+
+	if format == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(struct {
+			*parser.Plan
+			UpdatedToday      bool     `json:"updatedToday"`
+			PendingSteps      []string `json:"pendingSteps"`
+			PendingMigrations int      `json:"pendingMigrations"`
+		}{plan, updated, pending, pendingMigrations})
+		check(err)
+		return
+	}
+
+	if updated {
+		fmt.Println("NOTE: database is already updated from the source for the date, a real run would skip it.")
+	} else if pending[0] != parser.StepRanking {
+		fmt.Printf("NOTE: the ranking is already counted for the date, a real run would resume steps %s.\n", strings.Join(pending, ", "))
+	}
+	fmt.Printf("Source:      %s\n", plan.Source)
+	fmt.Printf("Date:        %s\n", plan.Date)
+	fmt.Printf("Winner:      %s (%s), HPD %d, strategy %s\n", plan.DistrName, plan.DistrURL, plan.HPD, plan.Strategy)
+	if prev := plan.ReactivatedFrom; prev != nil {
+		fmt.Printf("Comes back:  stint %d, stint %d had count %d and dropped out on %d\n", plan.Stint, prev.Stint, prev.Count, prev.DropDate)
+	}
+	if plan.StaleSince != "" {
+		fmt.Printf("Stale:       ranking is identical to %s, a real run would refuse to count it\n", plan.StaleSince)
+	}
+	fmt.Printf("Next 1:      HPD %d, trend %+d\n", plan.Next1HPD, plan.Next1Trend)
+	fmt.Printf("Next 2:      HPD %d, trend %+d\n", plan.Next2HPD, plan.Next2Trend)
+	delta := geo.Delta(geo.Point{Latitude: plan.PrevLatitude, Longitude: plan.PrevLongitude}, geo.Point{Latitude: plan.Latitude, Longitude: plan.Longitude})
+	fmt.Printf("Coordinates: %.4f, %.4f -> %.4f (%+.4f), %.4f (%+.4f)\n",
+		plan.PrevLatitude, plan.PrevLongitude, plan.Latitude, delta.Latitude, plan.Longitude, delta.Longitude)
+	fmt.Printf("Place:       %s\n", plan.Place)
+	if len(plan.Dropout) == 0 {
+		fmt.Println("Dropout:     none")
+	} else {
+		fmt.Println("Dropout:")
+		for _, distr := range plan.Dropout {
+			fmt.Printf("  %s: stint %d, count %d, last update %d\n", distr.Name, distr.Stint, distr.Count, distr.LastUpdate)
+		}
+	}
+	fmt.Printf("Screenshot:  %s\n", plan.ScreenshotURL)
+	info := plan.Info
+	fmt.Printf("Info:        %s, based on %s, origin %s, status %s, latest version %s\n",
+		info.OSType, strings.Join(info.BasedOn, ", "), info.Origin, info.Status, info.LatestVersion)
+	fmt.Printf("             architectures %s; desktops %s; categories %s\n",
+		strings.Join(info.Architectures, ", "), strings.Join(info.Desktops, ", "), strings.Join(info.Categories, ", "))
+}
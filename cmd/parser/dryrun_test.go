@@ -0,0 +1,129 @@
+package main
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// snapshot returns SHA-256 of every file under dir by path.
+func snapshot(t *testing.T, dir string) map[string]string {
+	files := make(map[string]string)
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		data, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		files[path] = fmt.Sprintf("%x", sha256.Sum256(data))
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func TestDryRunWritesNothing(t *testing.T) {
+	dir, err := ioutil.TempDir("", "distrowatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "db.sqlite3")
+	defer os.Setenv("DISTRS_DATABASE", os.Getenv("DISTRS_DATABASE"))
+	os.Setenv("DISTRS_DATABASE", path)
+
+	index, err := ioutil.ReadFile("../../parser/testdata/index.html")
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := newTestServer(t, func() []byte { return index })
+	defer server.Close()
+	first := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	opts := parser.Options{
+		BaseURL:  server.URL + "/",
+		Dir:      filepath.Join(dir, "screenshots"),
+		Retries:  -1,
+		Clock:    parser.FixedClock(first),
+		Location: time.UTC,
+		Logger:   log.New(ioutil.Discard, "", 0),
+		// A dry run ignores it.
+		DiagnosticsDir: filepath.Join(dir, "diagnostics"),
+	}
+	db, err := sql.Open("sqlite3", distrowatch.DSN(path, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = distrowatch.Migrate(db, path)
+	if err == nil {
+		_, err = parser.Update(context.Background(), db, opts)
+	}
+	db.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := snapshot(t, dir)
+
+	// The ranking of the second day is identical, so a real run would refuse it and save nothing either way.
+	stdout := os.Stdout
+	out, err := ioutil.TempFile("", "distrowatch-dry-run-")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.Remove(out.Name())
+	os.Stdout = out
+	opts.Clock = parser.FixedClock(first.AddDate(0, 0, 1))
+	dryRunMain(context.Background(), opts, "json")
+	os.Stdout = stdout
+	out.Close()
+
+	data, err := ioutil.ReadFile(out.Name())
+	if err != nil {
+		t.Fatal(err)
+	}
+	var plan struct {
+		Date         string   `json:"date"`
+		DistrName    string   `json:"distrName"`
+		StaleSince   string   `json:"staleSince"`
+		PendingSteps []string `json:"pendingSteps"`
+	}
+	if err := json.Unmarshal(data, &plan); err != nil {
+		t.Fatalf("%v:\n%s", err, data)
+	}
+	if plan.Date != "20240302" || plan.DistrName != "MX Linux" || plan.StaleSince != "20240301" || len(plan.PendingSteps) != len(parser.Steps) {
+		t.Errorf("the plan is %+v", plan)
+	}
+
+	// Readers of a database in WAL journal mode create its -shm index and an empty -wal,
+	// sqlite can't remove them when the last connection is read-only.
+	after := snapshot(t, dir)
+	if info, err := os.Stat(path + "-wal"); err == nil && info.Size() > 0 {
+		t.Errorf("the dry run wrote %d bytes to the journal", info.Size())
+	}
+	delete(after, path+"-wal")
+	delete(after, path+"-shm")
+	for path, sum := range after {
+		if before[path] != sum {
+			t.Errorf("the dry run wrote %s", path)
+		}
+	}
+	for path := range before {
+		if _, ok := after[path]; !ok {
+			t.Errorf("the dry run removed %s", path)
+		}
+	}
+}
@@ -0,0 +1,51 @@
+package main
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"strings"
+	"syscall"
+
+	"github.com/andbar-ru/distrowatch"
+)
+
+// lockPath returns the path of the lock file next to the database.
+func lockPath() string {
+	return distrowatch.DatabasePath() + ".lock"
+}
+
+// acquireLock takes the exclusive lock of the database, so that two parser runs never change it at once.
+// The lock file keeps pid of the holder. The lock is released by releaseLock or when the process exits,
+// even if it crashes.
+func acquireLock() (*os.File, error) {
+	f, err := os.OpenFile(lockPath(), os.O_RDWR|os.O_CREATE, 0644)
+	if err != nil {
+		return nil, err
+	}
+	if err := syscall.Flock(int(f.Fd()), syscall.LOCK_EX|syscall.LOCK_NB); err != nil {
+		f.Close()
+		if err == syscall.EWOULDBLOCK {
+			pid, _ := ioutil.ReadFile(lockPath())
+			return nil, fmt.Errorf("another parser (pid %s) holds the lock %s", strings.TrimSpace(string(pid)), lockPath())
+		}
+		return nil, err
+	}
+	if err := f.Truncate(0); err != nil {
+		releaseLock(f)
+		return nil, err
+	}
+	if _, err := f.WriteAt([]byte(strconv.Itoa(os.Getpid())+"\n"), 0); err != nil {
+		releaseLock(f)
+		return nil, err
+	}
+	return f, nil
+}
+
+// releaseLock releases the lock taken by acquireLock. The file is left in place,
+// removing it would let two processes lock different files.
+func releaseLock(f *os.File) {
+	syscall.Flock(int(f.Fd()), syscall.LOCK_UN)
+	f.Close()
+}
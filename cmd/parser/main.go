@@ -0,0 +1,195 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+func check(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func closeCheck(c io.Closer) {
+	err := c.Close()
+	check(err)
+}
+
+const sourceUsage = "ranking table: default (of the main page, the last 6 months), 1m, 3m, 6m, 12m (last months) or a year"
+
+func usage() {
+	out := flag.CommandLine.Output()
+	fmt.Fprintln(out, "Usage: parser [flags]")
+	fmt.Fprintln(out, "       parser recompute-coords [-source S]")
+	fmt.Fprintln(out, "       parser merge FROM INTO")
+	fmt.Fprintln(out, "       parser alias [-slug] ALIAS CANONICAL")
+	fmt.Fprintln(out, "       parser preview-dropout [-source S] [-retention R]")
+	fmt.Fprintln(out, "       parser undo -date YYYYMMDD [-source S]")
+	fmt.Fprintln(out, "       parser diagnose [-strategy S] [-format text|json] FILE")
+	fmt.Fprintln(out, "Without subcommand counts the distribution of the day in every source.")
+	flag.PrintDefaults()
+}
+
+func main() {
+	if len(os.Args) > 1 {
+		switch os.Args[1] {
+		case "recompute-coords":
+			recomputeCoordsMain(os.Args[2:])
+			return
+		case "merge":
+			mergeMain(os.Args[2:])
+			return
+		case "alias":
+			aliasMain(os.Args[2:])
+			return
+		case "preview-dropout":
+			previewDropoutMain(os.Args[2:])
+			return
+		case "undo":
+			undoMain(os.Args[2:])
+			return
+		case "diagnose":
+			diagnoseMain(os.Args[2:])
+			return
+		}
+	}
+
+	baseURL := flag.String("base-url", parser.DefaultBaseURL, "address of the DistroWatch main page or its mirror")
+	fixturesDir := flag.String("fixtures", "", "directory with saved pages and images to use instead of network")
+	timeout := flag.Duration("timeout", parser.DefaultTimeout, "overall timeout of fetching the main page or screenshot, including retries")
+	requestTimeout := flag.Duration("request-timeout", parser.DefaultRequestTimeout, "timeout of a single request")
+	retries := flag.Int("retries", parser.DefaultRetries, "number of retries after network errors and 5xx responses, 0 means default, negative disables retries")
+	strategyName := flag.String("strategy", parser.DefaultStrategy.Name(), "winner selection strategy: first-equal, first-rising, first-falling, nth-equal:N or random:SEED")
+	dryRun := flag.Bool("dry-run", false, "print what would be done without writing to the database and disk")
+	format := flag.String("format", "text", "output format of -dry-run: text or json")
+	dateStr := flag.String("date", "", "count the outcome for the date in format YYYYMMDD instead of today")
+	timezone := flag.String("timezone", "Local", "timezone of the DistroWatch day, e.g. UTC or Europe/Moscow")
+	daemon := flag.Bool("daemon", false, "run every day at -at instead of once")
+	at := flag.String("at", "06:00", "time of day in -timezone in format HH:MM to run at in -daemon mode")
+	deadline := flag.Duration("deadline", 6*time.Hour, "how long after -at to retry failed runs in -daemon mode")
+	retryInterval := flag.Duration("retry-interval", 30*time.Minute, "interval between retries of failed runs in -daemon mode")
+	retentionStr := flag.String("retention", defaultRetention(), retentionUsage)
+	diagnosticsDir := flag.String("diagnostics-dir", filepath.Join(distrowatch.DistrsDir, "diagnostics"), "directory where the main page and the report are saved if parsing fails")
+	allowStale := flag.Bool("allow-stale", false, "count the ranking even if it is identical to the previous day's one, i.e. not refreshed; tables of past years are always counted")
+	force := flag.Bool("force", false, "undo the day (see subcommand undo) and update it again")
+	sourcesStr := flag.String("sources", parser.DefaultSource.Name, "comma-separated list of ranking tables to track, each one is "+sourceUsage)
+	flag.Usage = usage
+	flag.Parse()
+
+	strategy, err := parser.ParseStrategy(*strategyName)
+	check(err)
+	retention, err := parser.ParseRetention(*retentionStr)
+	check(err)
+	sources, err := parser.ParseSources(*sourcesStr)
+	check(err)
+	location, err := time.LoadLocation(*timezone)
+	check(err)
+	if *force && (*daemon || *dryRun) {
+		log.Fatal("-force is mutually exclusive with -daemon and -dry-run")
+	}
+	var date time.Time
+	if *dateStr != "" {
+		if *daemon {
+			log.Fatal("-date and -daemon are mutually exclusive")
+		}
+		date, err = time.Parse("20060102", *dateStr)
+		check(err)
+	}
+
+	ctx := context.Background()
+	opts := parser.Options{
+		Dir:            distrowatch.DistrsDir,
+		BaseURL:        *baseURL,
+		FixturesDir:    *fixturesDir,
+		Timeout:        *timeout,
+		RequestTimeout: *requestTimeout,
+		Retries:        *retries,
+		Strategy:       strategy,
+		Retention:      retention,
+		Location:       location,
+		Date:           date,
+		AllowStale:     *allowStale,
+		DiagnosticsDir: *diagnosticsDir,
+	}
+
+	if *dryRun {
+		if *format != "text" && *format != "json" {
+			log.Fatalf("Invalid format %q, expected text or json", *format)
+		}
+		for _, source := range sources {
+			opts.Source = source
+			dryRunMain(ctx, opts, *format)
+		}
+		return
+	}
+
+	// Create directory if it doesn't exist.
+	_, err = os.Stat(distrowatch.DistrsDir)
+	if os.IsNotExist(err) {
+		err = os.MkdirAll(distrowatch.DistrsDir, 0755)
+		check(err)
+	}
+
+	// Open database, migrations create tables if they don't exist.
+	// Only one parser at a time changes the database.
+	lock, err := acquireLock()
+	check(err)
+	db, err := distrowatch.GetDB()
+	check(err)
+	defer closeCheck(db)
+
+	if *daemon {
+		// The daemon takes the lock only for runs, so that other commands work in between.
+		releaseLock(lock)
+		hour, minute, err := parseClock(*at)
+		check(err)
+		ctx, cancel := context.WithCancel(ctx)
+		signals := make(chan os.Signal, 1)
+		signal.Notify(signals, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-signals
+			cancel()
+		}()
+		daemonMain(ctx, db, opts, sources, hour, minute, *deadline, *retryInterval)
+		return
+	}
+
+	defer releaseLock(lock)
+
+	// A failure of one source doesn't prevent updating the others.
+	failed := false
+	for _, source := range sources {
+		opts.Source = source
+		if *force {
+			var undone *parser.Undone
+			undone, _, err = parser.Redo(ctx, db, opts)
+			if undone != nil {
+				printUndone(undone)
+			}
+		} else {
+			_, err = parser.Update(ctx, db, opts)
+		}
+		if errors.Is(err, parser.ErrAlreadyUpdated) {
+			fmt.Printf("Database is already updated from source %s for %s.\n", source, opts.Today().Format("2006-01-02"))
+		} else if err != nil {
+			log.Printf("ERROR: source %s: %v", source, err)
+			failed = true
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
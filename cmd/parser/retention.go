@@ -0,0 +1,63 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+const retentionUsage = "how long distrs stay without wins before they drop out: Nd (days), Nm (months), Ny (years) or never; environment variable DISTRS_RETENTION sets the default"
+
+// defaultRetention returns environment variable DISTRS_RETENTION or parser.DefaultRetention,
+// so that the parser and its subcommands share the policy.
+func defaultRetention() string {
+	if retention := os.Getenv("DISTRS_RETENTION"); retention != "" {
+		return retention
+	}
+	return parser.DefaultRetention.String()
+}
+
+// formatDate converts date in format YYYYMMDD to YYYY-MM-DD.
+func formatDate(date int) string {
+	s := fmt.Sprint(date)
+	if len(s) != 8 {
+		return s
+	}
+	return s[:4] + "-" + s[4:6] + "-" + s[6:]
+}
+
+// previewDropoutMain handles subcommand preview-dropout.
+func previewDropoutMain(args []string) {
+	flags := flag.NewFlagSet("preview-dropout", flag.ExitOnError)
+	retentionStr := flags.String("retention", defaultRetention(), retentionUsage)
+	sourceStr := flags.String("source", parser.DefaultSource.Name, sourceUsage)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: parser preview-dropout [-source S] [-retention R]")
+		fmt.Fprintln(flags.Output(), "Lists the dates on which distrs drop out if they don't win again.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	retention, err := parser.ParseRetention(*retentionStr)
+	check(err)
+	source, err := parser.ParseSource(*sourceStr)
+	check(err)
+
+	db, err := distrowatch.GetReadOnlyDB()
+	check(err)
+	defer closeCheck(db)
+
+	distrs, err := parser.PreviewDropout(context.Background(), db, source, retention)
+	check(err)
+	if retention.Never {
+		fmt.Println("Retention is never, distrs don't drop out.")
+		return
+	}
+	fmt.Printf("Retention is %s.\n", retention)
+	for _, distr := range distrs {
+		fmt.Printf("%s  %s (count %d, last update %s)\n", formatDate(distr.DropDate), distr.Name, distr.Count, formatDate(distr.LastUpdate))
+	}
+}
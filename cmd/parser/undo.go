@@ -0,0 +1,61 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/parser"
+)
+
+// undoMain handles subcommand undo.
+func undoMain(args []string) {
+	flags := flag.NewFlagSet("undo", flag.ExitOnError)
+	dateStr := flags.String("date", "", "date to undo in format YYYYMMDD")
+	sourceStr := flags.String("source", parser.DefaultSource.Name, sourceUsage)
+	flags.Usage = func() {
+		fmt.Fprintln(flags.Output(), "Usage: parser undo -date YYYYMMDD [-source S]")
+		fmt.Fprintln(flags.Output(), "Reverts the update of the latest day: the winner's count, daily rows, coordinates, dropout and the screenshot.")
+		flags.PrintDefaults()
+	}
+	flags.Parse(args)
+	if *dateStr == "" || flags.NArg() != 0 {
+		flags.Usage()
+		os.Exit(2)
+	}
+	date, err := time.Parse("20060102", *dateStr)
+	check(err)
+	source, err := parser.ParseSource(*sourceStr)
+	check(err)
+
+	lock, err := acquireLock()
+	check(err)
+	defer releaseLock(lock)
+	db, err := distrowatch.GetDB()
+	check(err)
+	defer closeCheck(db)
+
+	undone, err := parser.Undo(context.Background(), db, source, date, time.Now())
+	check(err)
+	printUndone(undone)
+}
+
+// printUndone prints what has been undone.
+func printUndone(undone *parser.Undone) {
+	if undone.Removed {
+		fmt.Printf("Undone %s of source %s: %s is removed from distrs.\n", undone.Date, undone.Source, undone.DistrName)
+	} else {
+		fmt.Printf("Undone %s of source %s: count of %s is decremented.\n", undone.Date, undone.Source, undone.DistrName)
+	}
+	for _, distr := range undone.Restored {
+		fmt.Printf("  %s is restored from dropout: stint %d, count %d, last update %s\n", distr.Name, distr.Stint, distr.Count, formatDate(distr.LastUpdate))
+	}
+	if undone.ScreenshotRemoved {
+		fmt.Printf("  screenshot %s is removed\n", undone.ScreenshotPath)
+	} else if undone.ScreenshotPath != "" {
+		fmt.Printf("  screenshot %s is kept, other screenshots refer to it\n", undone.ScreenshotPath)
+	}
+}
@@ -0,0 +1,14 @@
+package distrowatch
+
+import (
+	"fmt"
+	"image/color"
+)
+
+// FormatColor formats color as "#rrggbb" or, if it is not opaque, as "#rrggbbaa".
+func FormatColor(c color.NRGBA) string {
+	if c.A == 0xff {
+		return fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)
+	}
+	return fmt.Sprintf("#%02x%02x%02x%02x", c.R, c.G, c.B, c.A)
+}
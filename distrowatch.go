@@ -2,33 +2,103 @@ package distrowatch
 
 import (
 	"database/sql"
+	"fmt"
+	"net/url"
 	"os"
 	"path"
+	"strconv"
 
 	// Register sqlite3.
 	_ "github.com/mattn/go-sqlite3"
 )
 
+const (
+	// SiteURL is the address of the DistroWatch main page.
+	SiteURL = "https://distrowatch.com/"
+	// DefaultSource is the name of the ranking table which is shown on the main page by default.
+	DefaultSource = "default"
+	// BusyTimeout is how long in milliseconds a connection waits for a lock held by another one.
+	BusyTimeout = 5000
+)
+
 var (
 	// DistrsDir is directory where distrs images and sqlite database store.
 	DistrsDir = path.Join(os.Getenv("HOME"), "Images/distrs")
 )
 
-// GetDB opens and returns sqlite database from the predefined place.
-// Consumers have to close the database.
-func GetDB() (*sql.DB, error) {
+// DistrPageURL returns the address of the DistroWatch page of the distribution with the slug.
+func DistrPageURL(slug string) string {
+	return SiteURL + "table.php?distribution=" + url.QueryEscape(slug)
+}
+
+// DatabasePath returns path to the sqlite database:
+// environment variable DISTRS_DATABASE or db.sqlite3 in DistrsDir.
+func DatabasePath() string {
 	database := os.Getenv("DISTRS_DATABASE")
 	if database == "" {
 		database = path.Join(DistrsDir, "db.sqlite3")
 	}
+	return database
+}
+
+// DSN returns the data source name of the sqlite database at path with the settings shared by all programs:
+// connections wait BusyTimeout for locks, the writer switches the database to WAL journal mode,
+// so that readers don't block it and it doesn't block them. The journal mode is stored in the database,
+// so read-only connections use WAL once the writer has set it.
+func DSN(path string, readOnly bool) string {
+	params := url.Values{}
+	params.Set("_busy_timeout", strconv.Itoa(BusyTimeout))
+	if readOnly {
+		params.Set("mode", "ro")
+	} else {
+		params.Set("_journal_mode", "WAL")
+	}
+	return "file:" + path + "?" + params.Encode()
+}
+
+// GetDB opens and returns sqlite database from the predefined place for writing
+// and applies pending migrations to it.
+// Consumers have to close the database.
+func GetDB() (*sql.DB, error) {
+	database := DatabasePath()
 	// Check if database exists.
 	if _, err := os.Stat(database); os.IsNotExist(err) {
 		return nil, err
 	}
 	// Open database.
-	db, err := sql.Open("sqlite3", database)
+	db, err := sql.Open("sqlite3", DSN(database, false))
 	if err != nil {
 		return nil, err
 	}
+	if err := Migrate(db, database); err != nil {
+		db.Close()
+		return nil, err
+	}
+	return db, nil
+}
+
+// GetReadOnlyDB opens and returns sqlite database from the predefined place in read-only mode.
+// Unlike GetDB, it doesn't migrate the database, but fails if the schema is outdated.
+// Consumers have to close the database.
+func GetReadOnlyDB() (*sql.DB, error) {
+	database := DatabasePath()
+	// Check if database exists.
+	if _, err := os.Stat(database); os.IsNotExist(err) {
+		return nil, err
+	}
+	// Open database.
+	db, err := sql.Open("sqlite3", DSN(database, true))
+	if err != nil {
+		return nil, err
+	}
+	version, err := SchemaVersion(db)
+	if err != nil {
+		db.Close()
+		return nil, err
+	}
+	if version < LatestSchemaVersion() {
+		db.Close()
+		return nil, fmt.Errorf("database schema version is %d, expected %d; run the parser to migrate it", version, LatestSchemaVersion())
+	}
 	return db, nil
 }
@@ -0,0 +1,3692 @@
+// Code generated by gen.go from Natural Earth ne_10m_urban_areas_landscan.geojson.gz; DO NOT EDIT.
+
+package geo
+
+var cities = []city{
+	{"Aalborg", 57.036, 9.933},
+	{"Aarhus", 56.166, 10.180},
+	{"Aba", 5.084, 7.339},
+	{"Abadan", 30.342, 48.279},
+	{"Abaetetuba", -1.723, -48.877},
+	{"Abakan", 53.718, 91.438},
+	{"Abancay", -13.635, -72.885},
+	{"Abbottabad", 34.068, 73.087},
+	{"Abeche", 13.835, 20.833},
+	{"Abengourou", 6.733, -3.490},
+	{"Abeokuta", 7.120, 3.334},
+	{"Aberdeen", 57.155, -2.135},
+	{"Abha", 18.217, 42.509},
+	{"Abidjan", 5.374, -4.020},
+	{"Abilene", 32.436, -99.761},
+	{"Abohar", 30.327, 74.336},
+	{"Abomey", 6.893, 1.908},
+	{"Abu Dhabi", 24.444, 54.411},
+	{"Abu Kamal", 34.497, 40.920},
+	{"Abuja", 9.063, 7.484},
+	{"Acapulco", 16.883, -99.839},
+	{"Acarigua", 9.565, -69.208},
+	{"Accra", 5.637, -0.189},
+	{"Achinsk", 56.260, 90.478},
+	{"Ad Dakhla", 23.698, -15.939},
+	{"Ad Damman", 26.402, 50.105},
+	{"Ad Diwaniyah", 31.989, 44.926},
+	{"Ad Nabk", 34.028, 36.732},
+	{"Adana", 37.002, 35.326},
+	{"Adapazari", 40.746, 30.393},
+	{"Addis Ababa", 8.826, 38.919},
+	{"Adelaide", -34.924, 138.591},
+	{"Aden", 12.797, 45.020},
+	{"Adi Ugri", 14.870, 38.819},
+	{"Adigrat", 14.258, 39.483},
+	{"Adiyaman", 37.756, 38.272},
+	{"Ado Ekiti", 7.707, 5.119},
+	{"Adrar", 27.866, -0.290},
+	{"Afyon", 38.758, 30.564},
+	{"Agadez", 16.983, 7.990},
+	{"Agadir", 30.393, -9.550},
+	{"Agana", 13.484, 144.792},
+	{"Agartala", 24.047, 91.191},
+	{"Agboville", 5.935, -4.207},
+	{"Agen", 44.195, 0.633},
+	{"Agra", 27.128, 78.055},
+	{"Agri", 39.722, 43.044},
+	{"Agrinio", 38.625, 21.401},
+	{"Agua Prieta", 31.325, -109.546},
+	{"Aguascalientes", 21.901, -102.295},
+	{"Ahar", 38.479, 47.069},
+	{"Ahmadabad", 22.866, 72.715},
+	{"Ahmednagar", 19.104, 74.751},
+	{"Ahwaz", 31.310, 48.671},
+	{"Aix-en-Provence", 43.530, 5.423},
+	{"Aizawl", 23.706, 92.717},
+	{"Ajdabiya", 30.759, 20.225},
+	{"Ajmer", 26.462, 74.663},
+	{"Aketi", 2.736, 23.781},
+	{"Akita", 39.730, 140.099},
+	{"Akola", 20.701, 76.992},
+	{"Akron", 41.102, -81.494},
+	{"Aksu", 41.170, 80.270},
+	{"Al Ahmadi", 29.091, 48.070},
+	{"Al Amarah", 31.846, 47.152},
+	{"Al Ayn", 24.192, 55.729},
+	{"Al Fallujah", 33.350, 43.782},
+	{"Al Fujayrah", 25.100, 56.345},
+	{"Al Hasakah", 36.504, 40.747},
+	{"Al Hillah", 32.482, 44.409},
+	{"Al Hudaydah", 14.798, 42.991},
+	{"Al Hufuf", 25.374, 49.581},
+	{"Al Jahra", 29.327, 47.684},
+	{"Al Jubayl", 26.991, 49.649},
+	{"Al Karak", 31.145, 35.722},
+	{"Al Khalil", 31.525, 35.109},
+	{"Al Kharj", 24.149, 47.321},
+	{"Al Khums", 32.646, 14.264},
+	{"Al Kut", 32.513, 45.822},
+	{"Al Ladhiqiyah", 35.559, 35.821},
+	{"Al Marj", 32.489, 20.833},
+	{"Al Mubarraz", 25.427, 49.566},
+	{"Al Mukalla", 14.549, 49.132},
+	{"Al Musayyib", 32.782, 44.293},
+	{"Al Qamishli", 37.042, 41.241},
+	{"Al-Qatif", 26.523, 50.007},
+	{"Alagoinhas", -12.132, -38.429},
+	{"Alajuela", 9.989, -84.220},
+	{"Albacete", 38.994, -1.874},
+	{"Albany", 31.589, -84.168},
+	{"Albany", 42.708, -73.754},
+	{"Albany", 44.630, -123.091},
+	{"Albuquerque", 35.137, -106.630},
+	{"Aleksin", 54.509, 37.066},
+	{"Aleppo", 36.209, 37.165},
+	{"Alexandria", 31.192, 30.016},
+	{"Alexandria", 31.311, -92.449},
+	{"Alexandria", 38.874, -77.264},
+	{"Algeciras", 36.151, -5.448},
+	{"Algiers", 36.674, 3.102},
+	{"Ali Bayramli", 39.945, 48.928},
+	{"Alicante", 38.388, -0.487},
+	{"Aligarh", 27.985, 78.153},
+	{"Alipur Duar", 26.391, 89.809},
+	{"Allahabad", 25.329, 81.704},
+	{"Allappey", 9.529, 76.532},
+	{"Allentown", 40.631, -75.408},
+	{"Almaty", 43.280, 76.911},
+	{"Almeria", 36.856, -2.442},
+	{"Almetyevsk", 54.903, 52.319},
+	{"Alor Setar", 6.112, 100.375},
+	{"Alton", 38.899, -90.127},
+	{"Altoona", 40.484, -78.403},
+	{"Alwar", 27.543, 76.854},
+	{"Alxa Zuoqi", 38.832, 105.669},
+	{"Amarillo", 35.193, -101.856},
+	{"Amasya", 40.657, 35.827},
+	{"Ambala", 30.292, 76.922},
+	{"Ambato", -1.253, -78.600},
+	{"Ambon", -3.684, 128.193},
+	{"Americana", -22.778, -47.324},
+	{"Ames", 42.026, -93.642},
+	{"Amiens", 49.890, 2.301},
+	{"Amman", 31.949, 35.929},
+	{"Amol", 36.474, 52.353},
+	{"Amravati", 20.929, 77.753},
+	{"Amritsar", 31.796, 75.105},
+	{"Amsterdam", 52.349, 4.871},
+	{"An Najaf", 32.014, 44.384},
+	{"An Nasiriyah", 31.052, 46.250},
+	{"Anaco", 9.434, -64.464},
+	{"Anapolis", -16.334, -48.948},
+	{"Anchorage", 61.169, -149.846},
+	{"Ancona", 43.546, 13.517},
+	{"Anda", 46.428, 125.263},
+	{"Anderson", 34.519, -82.666},
+	{"Andijon", 40.749, 72.230},
+	{"Andkhvoy", 36.931, 65.101},
+	{"Andong", 36.566, 128.729},
+	{"Andorra", 42.518, 1.535},
+	{"Angarsk", 52.553, 103.895},
+	{"Angeles", 15.066, 120.674},
+	{"Angers", 47.462, -0.548},
+	{"Angoche", -16.203, 39.927},
+	{"Angren", 41.014, 70.089},
+	{"Ankang", 32.785, 108.791},
+	{"Ankara", 39.958, 32.754},
+	{"Anlu", 31.237, 113.689},
+	{"Ann Arbor", 42.247, -83.685},
+	{"Annaba", 36.846, 7.746},
+	{"Annapolis", 38.974, -76.523},
+	{"Annecy", 45.921, 6.104},
+	{"Anqing", 30.556, 117.040},
+	{"Ansan", 37.383, 126.847},
+	{"Anshan", 41.183, 122.990},
+	{"Anshun", 26.230, 105.802},
+	{"Antalya", 36.900, 30.715},
+	{"Antananarivo", -18.875, 47.477},
+	{"Antofagasta", -23.627, -70.393},
+	{"Antsirabe", -19.871, 47.150},
+	{"Antsiranana", -12.300, 49.292},
+	{"Antwerpen", 51.142, 4.534},
+	{"Anuradhapura", 8.320, 80.416},
+	{"Anyang", 36.121, 114.457},
+	{"Anzhero Sudzhensk", 56.086, 86.031},
+	{"Aomori", 40.830, 140.739},
+	{"Apatity", 67.580, 33.406},
+	{"Apatzingan", 19.090, -102.354},
+	{"Apia", -13.838, -171.781},
+	{"Appleton", 44.250, -88.407},
+	{"Apucarana", -23.547, -51.451},
+	{"Aqtobe", 50.290, 57.190},
+	{"Ar Ramadi", 33.429, 43.297},
+	{"Ar Raqqah", 35.952, 39.013},
+	{"Aracaju", -10.903, -37.106},
+	{"Aracatuba", -21.206, -50.443},
+	{"Arad", 46.180, 21.316},
+	{"Araguaina", -7.188, -48.205},
+	{"Araguari", -18.649, -48.190},
+	{"Arak", 34.085, 49.693},
+	{"Arapiraca", -9.742, -36.667},
+	{"Arapongas", -23.407, -51.433},
+	{"Arar", 30.978, 41.027},
+	{"Araxa", -19.592, -46.938},
+	{"Archangel", 64.551, 40.583},
+	{"Ardabil", 38.256, 48.285},
+	{"Arecibo", 18.441, -66.761},
+	{"Arequipa", -16.397, -71.529},
+	{"Arezzo", 43.468, 11.863},
+	{"Arica", -18.477, -70.291},
+	{"Armavir", 45.005, 41.119},
+	{"Armenia", 4.543, -75.677},
+	{"Arqalyq", 50.255, 66.907},
+	{"Arras", 50.290, 2.773},
+	{"Arsenyev", 44.157, 133.274},
+	{"Arua", 3.133, 30.879},
+	{"Arusha", -3.371, 36.686},
+	{"Arzamas", 55.406, 43.823},
+	{"As Salt", 32.047, 35.726},
+	{"As Samawah", 31.319, 45.279},
+	{"As Sulaymaniyah", 35.565, 45.423},
+	{"As Suwayda", 32.708, 36.575},
+	{"Asahikawa", 43.783, 142.385},
+	{"Asbest", 57.009, 61.469},
+	{"Asela", 7.959, 39.136},
+	{"Ash Shatrah", 31.422, 46.166},
+	{"Ash Shihr", 14.764, 49.608},
+	{"Asheville", 35.566, -82.562},
+	{"Ashgabat", 37.946, 58.371},
+	{"Asmara", 15.327, 38.927},
+	{"Assab", 13.009, 42.739},
+	{"Assis", -22.653, -50.419},
+	{"Astana", 51.164, 71.433},
+	{"Asti", 44.905, 8.214},
+	{"Astrakhan", 46.351, 48.022},
+	{"Asuncion", -25.307, -57.535},
+	{"Aswan", 24.086, 32.891},
+	{"Asyut", 27.305, 31.055},
+	{"At Taif", 21.289, 40.423},
+	{"Atakpame", 7.531, 1.122},
+	{"Atbara", 17.713, 33.990},
+	{"Athens", 33.937, -83.394},
+	{"Athens", 38.032, 23.742},
+	{"Atlanta", 33.852, -84.329},
+	{"Atlantic City", 39.354, -74.461},
+	{"Atlixco", 18.913, -98.434},
+	{"Atyrau", 47.103, 51.919},
+	{"Auburn", 32.621, -85.436},
+	{"Auckland", -36.897, 174.755},
+	{"Augsburg", 48.369, 10.891},
+	{"Augusta", 33.466, -82.053},
+	{"Aurangabad", 19.872, 75.303},
+	{"Aurangabad", 24.743, 84.399},
+	{"Aurora", 39.660, -104.831},
+	{"Aurora", 41.815, -88.205},
+	{"Austin", 30.360, -97.751},
+	{"Avare", -23.097, -48.926},
+	{"Awka", 6.128, 7.073},
+	{"Ayacucho", -13.163, -74.218},
+	{"Aydin", 37.847, 27.810},
+	{"Ayr", 55.463, -4.620},
+	{"Ayutthaya", 14.357, 100.587},
+	{"Az Aubayr", 30.383, 47.706},
+	{"Az Zahran", 26.314, 50.169},
+	{"Az Zarqa", 32.066, 36.085},
+	{"Az Zawiyah", 32.763, 12.705},
+	{"Azare", 11.676, 10.196},
+	{"Azogues", -2.745, -78.865},
+	{"Babruysk", 53.146, 29.209},
+	{"Bac Giang", 21.192, 106.194},
+	{"Bac Lieu", 9.406, 105.709},
+	{"Bacau", 46.563, 26.894},
+	{"Bacolod", 10.698, 122.993},
+	{"Badajoz", 38.858, -6.967},
+	{"Badulla", 6.921, 80.934},
+	{"Bafang", 5.161, 10.189},
+	{"Bafoussam", 5.502, 10.325},
+	{"Bafra", 41.574, 35.886},
+	{"Bage", -31.322, -54.105},
+	{"Baghdad", 33.333, 44.402},
+	{"Baghlan", 36.200, 68.767},
+	{"Bago", 17.341, 96.489},
+	{"Baguio City", 16.421, 120.599},
+	{"Bahawalpur", 29.598, 71.737},
+	{"Bahia Blanca", -38.723, -62.260},
+	{"Bahir Dar", 11.543, 37.427},
+	{"Bahraich", 27.515, 81.849},
+	{"Baia Mare", 47.657, 23.578},
+	{"Baicheng", 45.637, 122.829},
+	{"Bakersfield", 35.363, -119.042},
+	{"Baku", 40.416, 49.881},
+	{"Balakhna", 56.506, 43.575},
+	{"Balakovo", 52.011, 47.797},
+	{"Balashov", 51.541, 43.176},
+	{"Balboa", 8.972, -79.566},
+	{"Baleshwar", 21.348, 86.792},
+	{"Balikesir", 39.644, 27.908},
+	{"Balikpapan", -1.249, 116.846},
+	{"Balkanabat", 39.511, 54.363},
+	{"Balkh", 36.723, 66.862},
+	{"Ballarat", -37.562, 143.849},
+	{"Balqash", 46.842, 74.974},
+	{"Balti", 47.757, 27.903},
+	{"Baltimore", 39.269, -76.656},
+	{"Bama", 11.516, 13.691},
+	{"Bamako", 12.626, -7.987},
+	{"Bambari", 5.763, 20.672},
+	{"Bamenda", 6.005, 10.072},
+	{"Banda Aceh", 5.529, 95.368},
+	{"Bandar Lampung", -5.400, 105.261},
+	{"Bandar Seri Begawan", 4.910, 114.909},
+	{"Bandar-e Bushehr", 28.938, 50.838},
+	{"Bandjarmasin", -3.333, 114.604},
+	{"Bandundu", -3.308, 17.382},
+	{"Bandung", -6.832, 107.849},
+	{"Bangalore", 12.842, 77.703},
+	{"Banghazi", 32.109, 20.107},
+	{"Bangkok", 13.761, 100.545},
+	{"Bangor", 44.807, -68.781},
+	{"Banguela", -12.579, 13.417},
+	{"Bangui", 4.388, 18.546},
+	{"Bani Walid", 31.752, 13.996},
+	{"Banja Luka", 44.798, 17.207},
+	{"Bannu", 32.890, 70.604},
+	{"Banska Bystrica", 48.737, 19.148},
+	{"Banyuwangi", -8.208, 114.342},
+	{"Baoding", 38.808, 115.712},
+	{"Baoji", 34.380, 107.614},
+	{"Baoshan", 25.120, 99.197},
+	{"Baotou", 40.587, 109.995},
+	{"Baqubah", 33.745, 44.626},
+	{"Barahona", 18.217, -71.110},
+	{"Baramula", 34.211, 74.428},
+	{"Baranavichy", 53.129, 26.018},
+	{"Barbacena", -21.209, -43.772},
+	{"Barcelona", 10.130, -64.671},
+	{"Barcelona", 41.481, 2.102},
+	{"Barddhaman", 23.409, 87.707},
+	{"Bareilly", 28.346, 79.444},
+	{"Bari", 41.100, 16.832},
+	{"Barinas", 8.627, -70.221},
+	{"Barisal", 22.655, 90.289},
+	{"Barlett", 35.158, -89.830},
+	{"Barletta", 41.315, 16.283},
+	{"Barnaul", 53.348, 83.707},
+	{"Barquisimeto", 10.051, -69.331},
+	{"Barra Mansa", -22.544, -44.172},
+	{"Barrancabermeja", 7.068, -73.850},
+	{"Barranquilla", 10.938, -74.806},
+	{"Barretos", -20.554, -48.569},
+	{"Barrie", 44.374, -79.684},
+	{"Barysaw", 54.221, 28.507},
+	{"Basankusu", 1.212, 19.800},
+	{"Basel", 47.554, 7.618},
+	{"Basra", 30.506, 47.815},
+	{"Bata", 1.855, 9.776},
+	{"Batangas", 13.767, 121.058},
+	{"Bataysk", 47.139, 39.730},
+	{"Bath", 51.385, -2.359},
+	{"Batman", 37.864, 41.152},
+	{"Batna", 35.547, 6.168},
+	{"Baton Rouge", 30.391, -91.051},
+	{"Battambang", 13.108, 103.210},
+	{"Batticaloa", 7.707, 81.700},
+	{"Battle Creek", 42.315, -85.191},
+	{"Batu Pahat", 1.861, 102.947},
+	{"Batumi", 41.617, 41.633},
+	{"Bauchi", 10.315, 9.829},
+	{"Bauru", -22.319, -49.065},
+	{"Bawku", 11.065, -0.236},
+	{"Bayamo", 20.383, -76.641},
+	{"Baydhabo", 3.123, 43.652},
+	{"Baytown", 29.766, -94.985},
+	{"Beaumont", 30.087, -94.141},
+	{"Beaver Falls", 40.662, -80.284},
+	{"Bechar", 31.605, -2.230},
+	{"Beer Sheva", 31.251, 34.793},
+	{"Behbehan", 30.595, 50.246},
+	{"Beian", 48.258, 126.500},
+	{"Beihai", 21.540, 109.198},
+	{"Beijing", 38.838, 115.930},
+	{"Beipiao", 41.822, 120.755},
+	{"Beira", -19.790, 34.867},
+	{"Beirut", 33.893, 35.601},
+	{"Beja", 36.731, 9.194},
+	{"Bejaia", 36.651, 4.832},
+	{"Bekasi", -6.246, 107.210},
+	{"Belebey", 54.108, 54.123},
+	{"Beledweyne", 4.739, 45.208},
+	{"Belem", -1.382, -48.429},
+	{"Belfast", 54.593, -5.949},
+	{"Belgaum", 15.868, 74.495},
+	{"Belgorod", 50.605, 36.591},
+	{"Belgrade", 44.795, 20.450},
+	{"Belize City", 17.503, -88.203},
+	{"Bellary", 15.163, 76.937},
+	{"Belleville", 38.566, -89.996},
+	{"Bellingham", 48.758, -122.467},
+	{"Bello", 6.329, -75.549},
+	{"Belo Horizonte", -19.885, -43.993},
+	{"Belogorsk", 50.905, 128.500},
+	{"Bend", 44.055, -121.302},
+	{"Bendigo", -36.756, 144.272},
+	{"Benevento", 41.134, 14.793},
+	{"Bengbu", 33.286, 117.393},
+	{"Bengkulu", -3.814, 102.297},
+	{"Beni", 0.615, 29.443},
+	{"Beni Mazar", 28.546, 30.754},
+	{"Beni Suef", 29.105, 31.077},
+	{"Benin City", 6.350, 5.618},
+	{"Benoni", -26.142, 28.246},
+	{"Bento Goncalves", -29.155, -51.515},
+	{"Benton Harbor", 42.076, -86.468},
+	{"Benxi", 41.310, 123.780},
+	{"Berbera", 10.438, 45.010},
+	{"Berberati", 4.247, 15.794},
+	{"Berdyansk", 46.766, 36.792},
+	{"Berezniki", 59.421, 56.795},
+	{"Bergamo", 45.667, 9.654},
+	{"Bergen", 60.367, 5.320},
+	{"Berkeley", 37.906, -122.299},
+	{"Berlin", 52.504, 13.418},
+	{"Bern", 46.958, 7.453},
+	{"Besancon", 47.247, 6.009},
+	{"Bethal", -26.456, 29.474},
+	{"Beziers", 43.341, 3.244},
+	{"Bhagalpur", 24.854, 86.863},
+	{"Bharatpur", 27.327, 77.391},
+	{"Bhatpara", 22.859, 88.542},
+	{"Bhavnagar", 21.740, 72.127},
+	{"Bhilwara", 25.349, 74.631},
+	{"Bhisho", -32.864, 27.366},
+	{"Bhiwandi", 19.341, 73.083},
+	{"Bhiwani", 28.495, 76.023},
+	{"Bhopal", 23.229, 77.440},
+	{"Bhubaneshwar", 20.204, 85.572},
+	{"Bhusawal", 21.033, 75.689},
+	{"Biak", -1.177, 136.089},
+	{"Bialystok", 53.132, 23.155},
+	{"Biarritz", 43.483, -1.519},
+	{"Bida", 9.084, 6.026},
+	{"Bidar", 17.918, 77.499},
+	{"Biel", 47.134, 7.260},
+	{"Bielefeld", 52.022, 8.542},
+	{"Bien Hoa", 10.931, 106.864},
+	{"Bijapur", 16.831, 75.717},
+	{"Bikaner", 28.017, 73.315},
+	{"Bila Tserkva", 49.801, 30.117},
+	{"Bilaspur", 22.050, 82.028},
+	{"Bilbao", 43.293, -2.944},
+	{"Billings", 45.787, -108.541},
+	{"Binghamton", 42.109, -75.952},
+	{"Binjai", 3.731, 98.518},
+	{"Bintulu", 3.176, 113.067},
+	{"Biratnagar", 26.375, 87.237},
+	{"Birganj", 26.770, 84.773},
+	{"Birjand", 32.864, 59.215},
+	{"Birmingham", 33.473, -86.802},
+	{"Birmingham", 52.526, -1.965},
+	{"Birni Nkonni", 13.803, 5.252},
+	{"Birnin Kebbi", 12.464, 4.214},
+	{"Birobidzhan", 48.781, 132.922},
+	{"Bishkek", 42.873, 74.604},
+	{"Biskra", 34.843, 5.733},
+	{"Bismarck", 46.815, -100.777},
+	{"Bissau", 11.871, -15.613},
+	{"Bitola", 41.030, 21.335},
+	{"Biysk", 52.530, 85.179},
+	{"Bizerte", 37.256, 9.867},
+	{"Blackpool", 53.829, -3.014},
+	{"Blacksburg", 37.187, -80.413},
+	{"Blagoveshchensk", 50.286, 127.541},
+	{"Blantyre", -15.880, 35.238},
+	{"Blitar", -8.156, 112.091},
+	{"Bloemfontein", -29.128, 26.226},
+	{"Bloomington", 39.156, -86.534},
+	{"Bloomington", 40.494, -88.975},
+	{"Blumenau", -26.896, -49.092},
+	{"Bo", 7.961, -11.737},
+	{"Boa Vista", 2.814, -60.712},
+	{"Bobo Dioulasso", 11.174, -4.292},
+	{"Bogor", -6.488, 106.776},
+	{"Bogota", 4.643, -74.117},
+	{"Boise", 43.612, -116.282},
+	{"Bojnurd", 37.474, 57.327},
+	{"Bolgatanga", 10.798, -0.852},
+	{"Boli", 45.752, 130.560},
+	{"Bologna", 44.509, 11.344},
+	{"Bolu", 40.741, 31.650},
+	{"Bolzano", 46.476, 11.333},
+	{"Boma", -5.835, 13.056},
+	{"Bongor", 10.278, 15.373},
+	{"Bonn", 50.743, 7.136},
+	{"Boorama", 9.958, 43.169},
+	{"Boras", 57.728, 12.947},
+	{"Bordeaux", 44.839, -0.603},
+	{"Borisoglebsk", 51.365, 42.093},
+	{"Borovichi", 58.386, 33.926},
+	{"Bose", 23.876, 106.630},
+	{"Bossangoa", 6.489, 17.457},
+	{"Boston", 42.278, -71.177},
+	{"Botosani", 47.751, 26.653},
+	{"Botucatu", -22.890, -48.448},
+	{"Bouake", 7.699, -5.034},
+	{"Bouira", 36.393, 3.889},
+	{"Boulder", 40.016, -105.255},
+	{"Bourges", 47.089, 2.406},
+	{"Bournemouth", 50.761, -1.867},
+	{"Bowling Green", 36.966, -86.447},
+	{"Bradford", 53.735, -1.787},
+	{"Braga", 41.408, -8.352},
+	{"Braganca", -1.054, -46.771},
+	{"Braganca Paulista", -22.942, -46.543},
+	{"Brahmapur", 19.376, 84.730},
+	{"Brasilia", -15.825, -47.971},
+	{"Brasov", 45.643, 25.635},
+	{"Bratislava", 48.159, 17.131},
+	{"Braunschweig", 52.274, 10.528},
+	{"Brazzaville", -4.251, 15.245},
+	{"Bremen", 53.092, 8.777},
+	{"Bremerhaven", 53.562, 8.597},
+	{"Bremerton", 47.589, -122.650},
+	{"Brest", 48.401, -4.473},
+	{"Brest", 52.108, 23.710},
+	{"Bridgeport", 41.229, -73.198},
+	{"Bridgetown", 13.129, -59.590},
+	{"Brighton", 50.829, -0.252},
+	{"Brikama", 13.344, -16.698},
+	{"Brindisi", 40.638, 17.955},
+	{"Brisbane", -27.483, 152.990},
+	{"Bristol", 51.480, -2.582},
+	{"Brive", 45.163, 1.520},
+	{"Brno", 49.196, 16.602},
+	{"Brovary", 50.505, 30.771},
+	{"Brownsville", 25.939, -97.480},
+	{"Brugge", 51.185, 3.205},
+	{"Brusque", -27.098, -48.908},
+	{"Brussels", 50.920, 4.329},
+	{"Bryan", 30.640, -96.343},
+	{"Bryansk", 53.269, 34.366},
+	{"Bucaramanga", 7.059, -73.126},
+	{"Bucharest", 44.444, 26.082},
+	{"Budapest", 47.479, 19.107},
+	{"Budaun", 27.881, 79.004},
+	{"Buenaventura", 3.879, -77.005},
+	{"Buenos Aires", -34.681, -58.508},
+	{"Buffalo", 42.927, -78.816},
+	{"Bugulma", 54.543, 52.798},
+	{"Buguruslan", 53.641, 52.431},
+	{"Buizhou", 37.475, 117.691},
+	{"Bujumbura", -3.228, 29.650},
+	{"Bukavu", -2.407, 28.829},
+	{"Bukittinggi", -0.195, 100.533},
+	{"Bukoba", -1.305, 31.772},
+	{"Bulandshahr", 28.341, 77.871},
+	{"Bulawayo", -20.155, 28.578},
+	{"Bumba", 2.186, 22.473},
+	{"Bungoma", 0.564, 34.479},
+	{"Buon Me Thuot", 12.669, 108.018},
+	{"Bur Said", 31.252, 32.296},
+	{"Buraydah", 26.349, 43.963},
+	{"Burco", 9.521, 45.539},
+	{"Burgas", 42.496, 27.445},
+	{"Burgos", 42.354, -3.695},
+	{"Burhanpur", 21.311, 76.221},
+	{"Burlington", 44.471, -73.155},
+	{"Bursa", 40.231, 29.073},
+	{"Busan", 35.180, 129.017},
+	{"Butare", -2.718, 29.742},
+	{"Butembo", 0.137, 29.340},
+	{"Butterworth", 5.363, 100.470},
+	{"Butuan", 8.946, 125.534},
+	{"Buxoro", 39.773, 64.421},
+	{"Buynaksk", 42.823, 47.127},
+	{"Buzau", 45.159, 26.818},
+	{"Buzuluk", 52.780, 52.263},
+	{"Bydgoszcz", 53.131, 18.033},
+	{"Bytom", 50.348, 18.881},
+	{"Byumba", -1.615, 30.000},
+	{"Ca Mau", 9.328, 105.187},
+	{"Cabanatuan", 15.529, 120.941},
+	{"Cabimas", 10.274, -71.355},
+	{"Cabinda", -5.577, 12.192},
+	{"Cabo Frio", -22.853, -42.171},
+	{"Cabo de Santo Agostinho", -8.292, -35.036},
+	{"Caborca", 30.718, -112.159},
+	{"Cacador", -26.780, -51.015},
+	{"Cachoeiro de Itapemirim", -20.841, -41.130},
+	{"Cadiz", 23.711, 58.568},
+	{"Caen", 49.204, -0.345},
+	{"Cagayan de Oro", 8.465, 124.636},
+	{"Cagliari", 39.243, 9.163},
+	{"Cahul", 45.905, 28.197},
+	{"Cairns", -16.928, 145.740},
+	{"Cairo", 30.354, 31.274},
+	{"Cajamarca", -7.157, -78.493},
+	{"Calabar", 4.964, 8.325},
+	{"Calabozo", 8.922, -67.423},
+	{"Calais", 50.943, 1.867},
+	{"Calama", -22.453, -68.923},
+	{"Calarasi", 44.206, 27.358},
+	{"Calcutta", 22.617, 88.040},
+	{"Caldwell", 43.600, -116.599},
+	{"Calgary", 51.039, -114.071},
+	{"Cali", 3.437, -76.514},
+	{"Callao", -12.034, -77.118},
+	{"Camaguey", 21.388, -77.914},
+	{"Campana", -34.179, -58.952},
+	{"Campeche", 19.839, -90.520},
+	{"Campina Grande", -7.180, -35.883},
+	{"Campinas", -22.921, -47.104},
+	{"Campo Grande", -20.471, -54.618},
+	{"Campo Murao", -24.033, -52.377},
+	{"Campos", -21.759, -41.318},
+	{"Can Tho", 9.974, 105.682},
+	{"Canakkale", 40.152, 26.422},
+	{"Canberra", -35.310, 149.094},
+	{"Cancun", 21.149, -86.842},
+	{"Canela", -29.364, -50.839},
+	{"Cangzhou", 38.488, 116.709},
+	{"Canoas", -29.918, -51.106},
+	{"Canton", 40.832, -81.412},
+	{"Cap-Haitien", 19.689, -72.213},
+	{"Cape Coast", 5.141, -1.263},
+	{"Cape Coral", 26.623, -81.983},
+	{"Cape Town", -33.955, 18.557},
+	{"Capitol Hill", 15.169, 145.723},
+	{"Caracas", 10.452, -66.918},
+	{"Cardiff", 51.572, -3.307},
+	{"Carlisle", 54.895, -2.936},
+	{"Carora", 10.170, -70.075},
+	{"Carpina", -7.828, -35.300},
+	{"Carson City", 39.169, -119.753},
+	{"Cartagena", 10.383, -75.481},
+	{"Cartagena", 37.622, -0.995},
+	{"Cartago", 4.759, -75.928},
+	{"Cartago", 9.865, -83.926},
+	{"Caruaru", -8.273, -35.976},
+	{"Carupano", 10.655, -63.255},
+	{"Casablanca", 33.558, -7.519},
+	{"Cascavel", -24.957, -53.456},
+	{"Caserta", 40.996, 14.371},
+	{"Casper", 42.838, -106.337},
+	{"Castanhal", -1.291, -47.913},
+	{"Castello", 39.966, -0.122},
+	{"Castries", 14.006, -60.988},
+	{"Catamarca", -28.451, -65.755},
+	{"Catanduva", -21.138, -48.967},
+	{"Catania", 37.542, 15.063},
+	{"Catanzaro", 38.889, 16.606},
+	{"Caxias", -4.869, -43.359},
+	{"Caxias do Sul", -29.161, -51.178},
+	{"Cayenne", 4.922, -52.299},
+	{"Cebu", 10.307, 123.901},
+	{"Cedar Rapids", 41.995, -91.662},
+	{"Celaya", 20.531, -100.805},
+	{"Cerro de Pasco", -10.678, -76.258},
+	{"Ceske Budejovice", 48.978, 14.481},
+	{"Chabahar", 25.296, 60.643},
+	{"Chaiyaphum", 15.819, 102.044},
+	{"Chalkida", 38.448, 23.628},
+	{"Chandigarh", 30.733, 76.731},
+	{"Chandrapur", 19.944, 79.302},
+	{"Changchun", 43.888, 125.293},
+	{"Changde", 28.990, 111.756},
+	{"Changhua", 24.069, 120.480},
+	{"Changping", 40.139, 116.221},
+	{"Changsha", 28.333, 112.714},
+	{"Changting", 25.851, 116.367},
+	{"Changzhi", 36.261, 113.029},
+	{"Changzhou", 31.741, 119.868},
+	{"Chanthaburi", 12.602, 102.115},
+	{"Chaoyang", 41.578, 120.417},
+	{"Chaozhou", 23.600, 116.464},
+	{"Chapayevsk", 52.979, 49.719},
+	{"Charikar", 34.943, 69.298},
+	{"Charleroi", 50.423, 4.474},
+	{"Charleston", 32.909, -80.060},
+	{"Charleston", 38.356, -81.702},
+	{"Charlotte", 35.242, -80.792},
+	{"Charlottesville", 38.041, -78.490},
+	{"Chattanooga", 35.041, -85.233},
+	{"Chau Doc", 10.625, 105.113},
+	{"Chauk", 20.895, 94.830},
+	{"Cheboksary", 56.130, 47.250},
+	{"Chelyabinsk", 55.160, 61.474},
+	{"Chemnitz", 50.840, 12.866},
+	{"Chengde", 40.951, 117.926},
+	{"Chengdu", 30.486, 104.039},
+	{"Chennai", 13.003, 79.897},
+	{"Chenzhou", 25.803, 113.020},
+	{"Cheongju", 36.660, 127.443},
+	{"Cherbourg", 49.636, -1.627},
+	{"Cherepovets", 59.143, 37.899},
+	{"Cherkasy", 49.416, 32.067},
+	{"Cherkessk", 44.231, 42.046},
+	{"Chernihiv", 51.499, 31.281},
+	{"Chernivtsi", 48.295, 25.961},
+	{"Chester", 53.195, -2.885},
+	{"Chetumal", 18.515, -88.303},
+	{"Cheyenne", 41.141, -104.806},
+	{"Chiai", 23.560, 120.420},
+	{"Chiang Mai", 18.811, 98.992},
+	{"Chiang Rai", 19.911, 99.837},
+	{"Chicago", 41.833, -87.859},
+	{"Chiclayo", -6.766, -79.845},
+	{"Chico", 39.744, -121.836},
+	{"Chicoutimi", 48.425, -71.067},
+	{"Chifeng", 42.289, 118.898},
+	{"Chihuahua", 28.658, -106.082},
+	{"Chililabombwe", -12.367, 27.841},
+	{"Chillan", -36.612, -72.108},
+	{"Chilpancingo", 17.558, -99.501},
+	{"Chimbote", -9.083, -78.563},
+	{"Chimoio", -19.105, 33.471},
+	{"Chinandega", 12.647, -87.149},
+	{"Chincha Alta", -13.419, -76.139},
+	{"Chingola", -12.541, 27.869},
+	{"Chiniot", 31.811, 73.081},
+	{"Chipata", -13.636, 32.645},
+	{"Chiquinquira", 5.612, -73.817},
+	{"Chirala", 15.890, 80.414},
+	{"Chirchiq", 41.448, 69.557},
+	{"Chisinau", 47.017, 28.840},
+	{"Chita", 52.042, 113.479},
+	{"Chittagong", 22.471, 91.736},
+	{"Chitungwiza", -18.011, 31.072},
+	{"Chlef", 36.153, 1.333},
+	{"Choluteca", 13.306, -87.176},
+	{"Chon Buri", 13.369, 100.995},
+	{"Chongjin", 41.769, 129.748},
+	{"Chongju", 39.684, 125.207},
+	{"Chongqing", 29.824, 106.024},
+	{"Christchurch", -43.527, 172.618},
+	{"Chulucanas", -5.096, -80.162},
+	{"Chumphon", 10.501, 99.159},
+	{"Chuncheon", 37.899, 127.742},
+	{"Chungli", 24.967, 121.211},
+	{"Chusovoy", 58.289, 57.824},
+	{"Chuxiong", 25.053, 101.537},
+	{"Ciego de Avila", 21.843, -78.762},
+	{"Cienaga", 11.012, -74.240},
+	{"Cienfuegos", 22.156, -80.431},
+	{"Cilacap", -7.547, 109.241},
+	{"Cincinnati", 39.235, -84.457},
+	{"Cirebon", -6.809, 108.486},
+	{"Ciudad Bolivar", 8.101, -63.545},
+	{"Ciudad Guayana", 8.348, -62.645},
+	{"Ciudad Guzman", 19.707, -103.467},
+	{"Ciudad Hidalgo", 19.689, -100.538},
+	{"Ciudad JuÌÁrez", 31.682, -106.362},
+	{"Ciudad Madero", 22.297, -97.830},
+	{"Ciudad Mante", 22.744, -98.978},
+	{"Ciudad Obregon", 27.488, -109.937},
+	{"Ciudad Valles", 21.992, -99.007},
+	{"Ciudad Victoria", 23.740, -99.148},
+	{"Ciudad del Carmen", 18.650, -91.811},
+	{"Ciudad del Este", -25.405, -54.645},
+	{"Civitavecchia", 42.106, 11.790},
+	{"Clarksville", 36.570, -87.364},
+	{"Clermont-Ferrand", 45.814, 3.096},
+	{"Cleveland", 41.376, -81.656},
+	{"Cluj-Napoca", 46.777, 23.603},
+	{"Coari", -4.093, -63.143},
+	{"Coatzacoalcos", 18.133, -94.429},
+	{"Coban", 15.467, -90.380},
+	{"Coburg", 50.261, 10.980},
+	{"Cochabamba", -17.388, -66.171},
+	{"Cochin", 10.286, 76.271},
+	{"Coimbatore", 11.058, 77.100},
+	{"Coimbra", 40.221, -8.433},
+	{"Colima", 19.254, -103.724},
+	{"Colombo", 7.223, 79.997},
+	{"Colon", 9.349, -79.880},
+	{"Colorado Springs", 38.851, -104.784},
+	{"Columbia", 34.037, -81.032},
+	{"Columbia", 36.492, -86.682},
+	{"Columbus", 32.487, -84.964},
+	{"Columbus", 40.018, -82.986},
+	{"Comayagua", 14.457, -87.640},
+	{"Comilla", 23.376, 91.144},
+	{"Como", 45.728, 9.054},
+	{"Comodoro Rivadavia", -45.859, -67.521},
+	{"Conakry", 9.633, -13.589},
+	{"Concepcion", -36.811, -73.066},
+	{"Concepcion", -23.405, -57.438},
+	{"Concordia", -31.380, -58.028},
+	{"Conselheiro Lafaiete", -20.662, -43.790},
+	{"Constanta", 44.180, 28.617},
+	{"Constantine", 36.368, 6.620},
+	{"Conway", 35.087, -92.452},
+	{"Copenhagen", 55.716, 12.437},
+	{"Copiapo", -27.371, -70.325},
+	{"Coquimbo", -29.968, -71.340},
+	{"Coral Gables", 25.698, -80.357},
+	{"Coral Springs", 26.295, -80.208},
+	{"Cordoba", -31.388, -64.201},
+	{"Cordoba", 18.895, -96.952},
+	{"Cordoba", 37.880, -4.809},
+	{"Cork", 51.891, -8.461},
+	{"Coro", 11.411, -69.663},
+	{"Coronel", -36.993, -73.159},
+	{"Coronel Oviedo", -25.453, -56.440},
+	{"Corpus Christi", 27.743, -97.409},
+	{"Corrientes", -27.486, -58.800},
+	{"Corum", 40.555, 34.947},
+	{"Corvallis", 44.576, -123.270},
+	{"Cotabato", 7.218, 124.248},
+	{"Cotonou", 6.417, 2.392},
+	{"Cottbus", 51.757, 14.336},
+	{"Council Bluffs", 41.261, -95.874},
+	{"Coventry", 52.439, -1.498},
+	{"Covington", 39.067, -84.590},
+	{"Cozumel", 20.509, -86.941},
+	{"Craiova", 44.317, 23.795},
+	{"Cranbourne", -38.046, 145.203},
+	{"Crato", -7.232, -39.389},
+	{"Criciuma", -28.690, -49.366},
+	{"Crotone", 39.086, 17.106},
+	{"Cruzeiro do Sul", -7.624, -72.678},
+	{"Cuamba", -14.796, 36.532},
+	{"Cuauhtemoc", 28.407, -106.858},
+	{"Cucuta", 7.887, -72.497},
+	{"Cuddalore", 11.623, 79.540},
+	{"Cuenca", -2.887, -78.991},
+	{"Cuernavaca", 18.840, -99.223},
+	{"Cuiaba", -15.608, -56.072},
+	{"Culiacan", 24.803, -107.404},
+	{"Cumana", 10.451, -64.162},
+	{"Curepipe", -20.288, 57.502},
+	{"Curico", -34.974, -71.225},
+	{"Curitiba", -25.443, -49.249},
+	{"Cusco", -13.522, -71.960},
+	{"Cuttack", 20.593, 86.065},
+	{"Cyangugu", -2.626, 29.043},
+	{"Da Lat", 11.963, 108.442},
+	{"Da Nang", 15.950, 108.203},
+	{"Daan", 45.493, 124.283},
+	{"Dabou", 5.326, -4.375},
+	{"Daegu", 35.866, 128.646},
+	{"Daejeon", 36.367, 127.379},
+	{"Dagupan", 15.954, 120.487},
+	{"Dakar", 14.743, -17.344},
+	{"Dali", 25.758, 100.150},
+	{"Dali", 34.743, 109.897},
+	{"Dalian", 38.995, 121.653},
+	{"Dallas", 32.858, -96.838},
+	{"Daloa", 6.880, -6.445},
+	{"Dalton", 34.759, -84.969},
+	{"DamanhÌÈr", 31.069, 30.399},
+	{"Damascus", 33.474, 36.275},
+	{"Dandong", 40.158, 124.368},
+	{"Daqing", 46.587, 124.965},
+	{"Dar es Salaam", -6.833, 39.239},
+	{"Darnah", 32.757, 22.642},
+	{"Darwin", -12.407, 130.874},
+	{"Dasoguz", 41.852, 59.939},
+	{"Datong", 40.060, 113.252},
+	{"Daugavpils", 55.879, 26.522},
+	{"Davangere", 14.469, 75.927},
+	{"Davao", 7.118, 125.564},
+	{"Davenport", 41.534, -90.559},
+	{"David", 8.420, -82.430},
+	{"Dawei", 14.088, 98.206},
+	{"Dayr az Azwr", 35.331, 40.160},
+	{"Dayton", 39.746, -84.170},
+	{"Daytona Beach", 29.200, -81.042},
+	{"Debre Birhan", 9.625, 39.306},
+	{"Debre Markos", 10.339, 37.731},
+	{"Debrecen", 47.526, 21.640},
+	{"Decatur", 39.869, -88.951},
+	{"Dehra Dun", 30.378, 77.894},
+	{"Delhi", 28.906, 77.511},
+	{"Delicias", 28.198, -105.468},
+	{"Dengzhou", 32.740, 112.036},
+	{"Denizli", 37.788, 29.093},
+	{"Denow", 38.266, 67.891},
+	{"Denpasar", -8.653, 115.235},
+	{"Denton", 33.189, -97.105},
+	{"Denver", 39.730, -104.994},
+	{"Dera Ghazi Khan", 29.906, 70.625},
+	{"Dera Ismail Khan", 31.952, 70.931},
+	{"Derbent", 42.063, 48.292},
+	{"Des Moines", 41.614, -93.659},
+	{"Dese", 11.172, 39.666},
+	{"Detroit", 42.410, -83.173},
+	{"Deyang", 31.020, 104.519},
+	{"Dezful", 32.409, 48.383},
+	{"Dezhou", 37.597, 116.294},
+	{"Dhaka", 24.105, 90.401},
+	{"Dhamar", 14.627, 44.365},
+	{"Dhanbad", 23.921, 86.545},
+	{"Dhule", 20.929, 74.789},
+	{"Dibrugarh", 27.468, 94.939},
+	{"Dijon", 47.315, 5.053},
+	{"Dila", 6.469, 38.405},
+	{"Dili", -8.559, 125.565},
+	{"Dimitrovgrad", 54.227, 49.596},
+	{"Dindigul", 10.535, 77.830},
+	{"Dingzhou", 38.409, 115.034},
+	{"Diourbel", 14.654, -16.234},
+	{"Dire Dawa", 9.619, 41.852},
+	{"Divinopolis", -20.145, -44.888},
+	{"Diyarbakir", 37.932, 40.186},
+	{"Djelfa", 34.673, 3.261},
+	{"Djibouti", 11.572, 43.129},
+	{"Djougou", 9.703, 1.665},
+	{"Dnipropetrovsk", 48.471, 35.007},
+	{"Dobrich", 43.574, 27.829},
+	{"Dodoma", -6.162, 35.748},
+	{"Doha", 25.281, 51.468},
+	{"Dondo", -19.614, 34.739},
+	{"Donetsk", 47.999, 37.807},
+	{"Dong Hoi", 17.503, 106.550},
+	{"Dortmund", 51.504, 7.490},
+	{"Dothan", 31.227, -85.412},
+	{"Douala", 4.055, 9.725},
+	{"Dourados", -22.232, -54.797},
+	{"Dover", 39.151, -75.530},
+	{"Drammen", 59.754, 10.081},
+	{"Dresden", 51.058, 13.722},
+	{"Drobeta-Turnu Severin", 44.626, 22.648},
+	{"Drohobych", 49.325, 23.523},
+	{"Drummondville", 45.870, -72.493},
+	{"Dubayy", 25.259, 55.362},
+	{"Dublin", 53.330, -6.279},
+	{"Dubuque", 42.505, -90.699},
+	{"Duisburg", 51.447, 6.705},
+	{"Duitama", 5.820, -73.027},
+	{"Duluth", 46.806, -92.115},
+	{"Duma", 33.548, 36.430},
+	{"Dumyat", 31.349, 31.733},
+	{"Dundee", 56.476, -2.954},
+	{"Dunedin", -45.889, 170.495},
+	{"Dunhua", 43.360, 128.233},
+	{"Dunhuang", 40.131, 94.630},
+	{"Duque de Caxias", -22.766, -43.325},
+	{"Durango", 24.034, -104.648},
+	{"Durban", -29.836, 30.938},
+	{"Durham", 35.967, -78.934},
+	{"Durres", 41.322, 19.479},
+	{"Dushanbe", 38.543, 68.865},
+	{"Dzerzhinsk", 56.249, 43.505},
+	{"DÌùsseldorf", 51.294, 6.749},
+	{"Eagle Pass", 28.710, -100.487},
+	{"East London", -32.970, 27.831},
+	{"Eau Claire", 44.810, -91.483},
+	{"EdDamer", 17.591, 33.968},
+	{"Edinburg", 26.233, -98.148},
+	{"Edinburgh", 55.922, -3.181},
+	{"Edirne", 41.673, 26.562},
+	{"Edmonton", 53.544, -113.504},
+	{"Eindhoven", 51.452, 5.474},
+	{"Ekibastuz", 51.726, 75.323},
+	{"El Arish", 31.120, 33.818},
+	{"El Bayadh", 33.682, 1.014},
+	{"El Carmen de Bolivar", 9.719, -75.120},
+	{"El Faiyum", 29.328, 30.847},
+	{"El Fasher", 13.619, 25.352},
+	{"El Giza", 29.917, 31.198},
+	{"El Jadida", 33.232, -8.499},
+	{"El Manaqil", 14.255, 32.991},
+	{"El Mansura", 31.035, 31.435},
+	{"El Minya", 28.017, 30.791},
+	{"El Obeid", 13.184, 30.226},
+	{"El Oued", 33.348, 6.874},
+	{"El Paso", 31.800, -106.452},
+	{"El Suweis", 29.970, 32.521},
+	{"El Tigre", 8.895, -64.223},
+	{"Elazig", 38.667, 39.208},
+	{"Elbasan", 41.112, 20.100},
+	{"Elblag", 54.168, 19.412},
+	{"Eldoret", 0.518, 35.278},
+	{"Elgin", 42.109, -88.301},
+	{"Elista", 46.311, 44.259},
+	{"Elkhart", 41.664, -85.949},
+	{"Elmira", 42.122, -76.820},
+	{"Embu", -0.541, 37.456},
+	{"En Nuhud", 12.687, 28.424},
+	{"Encarnacion", -27.350, -55.885},
+	{"Engels", 51.479, 46.124},
+	{"Ensenada", 31.846, -116.597},
+	{"Entebbe", 0.114, 32.509},
+	{"Enugu", 6.411, 7.748},
+	{"Er Rachidia", 31.934, -4.426},
+	{"Erechim", -27.643, -52.267},
+	{"Eregli", 37.507, 34.057},
+	{"Erfurt", 50.983, 11.034},
+	{"Erie", 42.105, -80.082},
+	{"Erzincan", 39.747, 39.487},
+	{"Erzurum", 39.911, 41.260},
+	{"Esbjerg", 55.497, 8.443},
+	{"Escuintla", 14.287, -90.782},
+	{"Eskisehir", 39.775, 30.536},
+	{"Esmeraldas", 0.943, -79.665},
+	{"Essen", 51.517, 7.063},
+	{"Esteli", 13.090, -86.356},
+	{"Etawah", 27.004, 79.223},
+	{"Eugene", 44.061, -123.098},
+	{"Evanston", 42.049, -87.815},
+	{"Evansville", 37.987, -87.512},
+	{"Everett", 47.843, -122.224},
+	{"Exeter", 50.719, -3.508},
+	{"Fairbanks", 64.836, -147.743},
+	{"Faisalabad", 31.358, 73.164},
+	{"Faizabad", 26.663, 82.129},
+	{"Farah", 32.377, 62.110},
+	{"Fargo", 46.864, -96.820},
+	{"Fargona", 40.435, 71.803},
+	{"Fasa", 28.942, 53.641},
+	{"Fatehpur", 25.846, 80.927},
+	{"Fayetteville", 35.066, -78.948},
+	{"Fayetteville", 36.132, -94.153},
+	{"Feira de Santana", -12.238, -38.944},
+	{"Fengcheng", 28.193, 115.609},
+	{"Fengshan", 22.646, 120.360},
+	{"Fengzhen", 40.451, 113.155},
+	{"Ferkessedougou", 9.592, -5.200},
+	{"Ferrara", 44.853, 11.605},
+	{"Ferrenafe", -6.633, -79.794},
+	{"Feyzabad", 37.106, 70.581},
+	{"Fez", 34.030, -4.999},
+	{"Fianarantsoa", -21.472, 47.059},
+	{"Firozabad", 27.232, 78.544},
+	{"Flagstaff", 35.200, -111.626},
+	{"Flensburg", 54.787, 9.432},
+	{"Flint", 43.022, -83.693},
+	{"Florence", 34.178, -79.798},
+	{"Florence", 43.838, 11.113},
+	{"Florianopolis", -27.583, -48.518},
+	{"Focsani", 45.685, 27.179},
+	{"Foggia", 41.453, 15.550},
+	{"Fond du Lac", 43.778, -88.449},
+	{"Formosa", -26.171, -58.183},
+	{"Fort Collins", 40.505, -105.076},
+	{"Fort Lauderdale", 26.144, -80.203},
+	{"Fort Pierce", 27.321, -80.338},
+	{"Fort Smith", 35.355, -94.387},
+	{"Fort Wayne", 41.092, -85.138},
+	{"Fort-de-France", 14.625, -61.048},
+	{"Fortaleza", -3.826, -38.560},
+	{"Foshan", 23.018, 113.073},
+	{"Foz do Iguacu", -25.537, -54.574},
+	{"Franca", -20.536, -47.397},
+	{"Francistown", -21.175, 27.520},
+	{"Frankfurt", 50.102, 8.616},
+	{"Fredericksburg", 38.286, -77.498},
+	{"Freeport", 29.017, -95.407},
+	{"Freetown", 8.463, -13.230},
+	{"Freiburg", 48.021, 7.840},
+	{"Fresnillo", 23.186, -102.863},
+	{"Fresno", 36.788, -119.773},
+	{"Ft. Myers", 26.563, -81.843},
+	{"Ft. Worth", 32.767, -97.276},
+	{"Fuan", 27.103, 119.638},
+	{"Fujin", 47.251, 132.036},
+	{"Fukui", 36.078, 136.225},
+	{"Fukuoka", 33.511, 130.469},
+	{"Fukushima", 37.777, 140.472},
+	{"Funchal", 32.657, -16.926},
+	{"Funtua", 11.494, 7.335},
+	{"Furth", 49.469, 10.987},
+	{"Fushun", 41.852, 123.875},
+	{"Fuxin", 42.030, 121.696},
+	{"Fuyang", 32.844, 115.856},
+	{"Fuyu", 45.171, 124.821},
+	{"Fuzhou", 26.075, 119.295},
+	{"Gaalkacyo", 6.775, 47.425},
+	{"Gabes", 33.904, 10.073},
+	{"Gaborone", -24.657, 25.925},
+	{"Gafsa", 34.413, 8.791},
+	{"Gagnoa", 6.143, -5.959},
+	{"Gainesville", 29.658, -82.374},
+	{"Galati", 45.440, 28.015},
+	{"Galle", 6.185, 80.178},
+	{"Galveston", 29.285, -94.819},
+	{"Galway", 53.280, -9.056},
+	{"Ganca", 40.682, 46.363},
+	{"Gandajika", -6.733, 23.953},
+	{"Gandhinagar", 23.471, 72.497},
+	{"Gangneung", 37.754, 128.897},
+	{"Gangtok", 27.299, 88.598},
+	{"Ganzhou", 25.785, 114.818},
+	{"Gao", 16.271, -0.042},
+	{"Garanhuns", -8.891, -36.491},
+	{"Gardiz", 33.592, 69.232},
+	{"Garissa", -0.459, 39.648},
+	{"Garoua", 9.315, 13.393},
+	{"Gary", 41.554, -87.411},
+	{"Garzon", 2.218, -75.646},
+	{"Gatchina", 59.565, 30.114},
+	{"Gavle", 60.671, 17.123},
+	{"Gaya", 24.889, 85.205},
+	{"Gaza", 31.524, 34.478},
+	{"Gaziantep", 37.065, 37.386},
+	{"Gdansk", 54.356, 18.614},
+	{"Gdynia", 54.476, 18.472},
+	{"Gedaref", 14.036, 35.384},
+	{"Geelong", -38.140, 144.347},
+	{"Gejiu", 23.368, 103.153},
+	{"Gelendzhik", 44.572, 38.070},
+	{"Gemena", 3.244, 19.785},
+	{"Geneina", 13.441, 22.442},
+	{"General Santos", 6.156, 125.163},
+	{"Geneva", 46.209, 6.142},
+	{"Genoa", 44.436, 8.924},
+	{"Gent", 51.021, 3.787},
+	{"George", -33.967, 22.469},
+	{"George Town", 5.364, 100.291},
+	{"Georgetown", 6.797, -58.154},
+	{"Gera", 50.881, 12.071},
+	{"Ghardaia", 32.487, 3.677},
+	{"Gharyan", 32.169, 13.015},
+	{"Ghaziabad", 28.612, 77.449},
+	{"Ghazni", 33.567, 68.409},
+	{"Gibraltar", 36.163, -5.389},
+	{"Giessen", 50.594, 8.682},
+	{"Gifu", 35.370, 136.749},
+	{"Gijon", 43.530, -5.685},
+	{"Girardot", 4.324, -74.803},
+	{"Girga", 26.204, 32.019},
+	{"Gitarama", -2.023, 29.690},
+	{"Gitega", -3.378, 29.863},
+	{"Giyon", 8.614, 38.106},
+	{"Glasgow", 55.844, -4.225},
+	{"Glazov", 58.138, 52.658},
+	{"Glendale", 33.580, -112.251},
+	{"Gliwice", 50.307, 18.724},
+	{"Gogrial", 8.542, 28.104},
+	{"Goiana", -7.526, -35.018},
+	{"Goiania", -16.698, -49.297},
+	{"Gold Coast", -28.014, 153.396},
+	{"Golmud", 36.406, 94.903},
+	{"Goma", -1.752, 29.446},
+	{"Gombe", 10.293, 11.168},
+	{"Gomez Palacio", 25.566, -103.497},
+	{"Gonaives", 19.451, -72.665},
+	{"Gonbad-e Kavus", 37.251, 55.174},
+	{"Gonder", 12.588, 37.441},
+	{"Gorakhpur", 26.760, 83.469},
+	{"Gorgan", 36.853, 54.457},
+	{"Gorno Altaysk", 51.955, 85.942},
+	{"Gorontalo", 0.607, 122.959},
+	{"Gorzow Wielkopolski", 52.736, 15.235},
+	{"Goteborg", 57.694, 11.971},
+	{"Gottingen", 51.549, 9.921},
+	{"Goulimine", 28.987, -10.058},
+	{"Governador Valadares", -18.868, -41.965},
+	{"Goya", -29.150, -59.260},
+	{"Granada", 11.931, -85.957},
+	{"Granada", 37.175, -3.625},
+	{"Grand Forks", 47.914, -97.053},
+	{"Grand Junction", 39.079, -108.541},
+	{"Grand Prairie", 32.673, -97.023},
+	{"Grand Rapids", 42.937, -85.667},
+	{"Graz", 47.036, 15.452},
+	{"Great Falls", 47.504, -111.283},
+	{"Greeley", 40.410, -104.724},
+	{"Green Bay", 44.497, -88.030},
+	{"Greeneville", 35.580, -77.377},
+	{"Greenock", 55.940, -4.759},
+	{"Greensboro", 36.041, -79.888},
+	{"Greenville", 34.837, -82.325},
+	{"Grenoble", 45.214, 5.707},
+	{"Groningen", 53.217, 6.573},
+	{"Groznyy", 43.297, 45.675},
+	{"Grudziadz", 53.488, 18.777},
+	{"Guadalajara", 20.649, -103.348},
+	{"Guajara-Miram", -10.799, -65.338},
+	{"Guamuchil", 25.460, -108.079},
+	{"Guanajuato", 21.022, -101.264},
+	{"Guanare", 9.049, -69.756},
+	{"Guangshui", 31.506, 114.070},
+	{"Guangyuan", 32.389, 105.821},
+	{"Guangzhou", 23.036, 113.386},
+	{"Guantanamo", 20.148, -75.204},
+	{"Guarapuava", -25.383, -51.472},
+	{"Guaratingueta", -22.792, -45.193},
+	{"Guasave", 25.585, -108.445},
+	{"Guatemala", 14.603, -90.544},
+	{"Guayaquil", -2.157, -79.914},
+	{"Guaynas", 27.924, -110.903},
+	{"Guider", 9.947, 13.923},
+	{"Guilin", 25.297, 110.259},
+	{"Guiyang", 26.590, 106.716},
+	{"Gujranwala", 32.145, 74.126},
+	{"Gujrat", 32.773, 73.851},
+	{"Gulbarga", 17.345, 76.830},
+	{"Gulfport", 30.402, -89.089},
+	{"Guliston", 40.495, 68.776},
+	{"Gulu", 2.764, 32.289},
+	{"Gunsan", 35.973, 126.714},
+	{"Guntur", 16.287, 80.322},
+	{"Gurgaon", 28.280, 76.973},
+	{"Gusau", 12.179, 6.680},
+	{"Guwahati", 26.090, 91.602},
+	{"Gwalior", 26.254, 78.170},
+	{"Gwangju", 35.150, 126.847},
+	{"Gweru", -19.453, 29.808},
+	{"Gyeongju", 35.854, 129.218},
+	{"Gyor", 47.687, 17.652},
+	{"Gyumri", 40.791, 43.851},
+	{"Ha Tinh", 18.366, 105.867},
+	{"Haarlem", 52.429, 4.644},
+	{"Hachinohe", 40.520, 141.501},
+	{"Hachioji", 35.613, 139.370},
+	{"Haeju", 38.035, 125.695},
+	{"Hafar al Batin", 28.422, 45.967},
+	{"Hagerstown", 39.642, -77.723},
+	{"Haifa", 32.824, 35.043},
+	{"Haikou", 20.006, 110.357},
+	{"Hail", 27.521, 41.696},
+	{"Hailar", 49.222, 119.760},
+	{"Hailun", 47.467, 126.941},
+	{"Haiphong", 20.739, 106.467},
+	{"Hajjah", 15.640, 43.594},
+	{"Hakodate", 41.800, 140.747},
+	{"Haldia", 22.014, 87.781},
+	{"Halifax", 44.667, -63.585},
+	{"Halmstad", 56.671, 12.874},
+	{"Hamah", 35.129, 36.764},
+	{"Hamamatsu", 34.747, 137.798},
+	{"Hamburg", 53.568, 10.015},
+	{"Hamhung", 39.915, 127.537},
+	{"Hami", 42.829, 93.513},
+	{"Hamilton", -37.782, 175.276},
+	{"Hamilton", 32.297, -64.779},
+	{"Hamilton", 43.335, -79.813},
+	{"Hampton", 37.087, -76.442},
+	{"Hancheng", 35.485, 110.443},
+	{"Handan", 36.467, 114.716},
+	{"Hangu", 39.306, 117.798},
+	{"Hangzhou", 30.529, 120.234},
+	{"Hania", 35.509, 24.037},
+	{"Hannover", 52.389, 9.738},
+	{"Hanoi", 20.873, 105.893},
+	{"Hanzhong", 33.122, 107.122},
+	{"Haora", 22.677, 88.159},
+	{"Hapur", 28.778, 77.930},
+	{"Harar", 9.336, 42.091},
+	{"Harare", -17.832, 31.045},
+	{"Harbin", 45.746, 126.637},
+	{"Hargeysa", 9.557, 44.064},
+	{"Harlingen", 26.181, -97.694},
+	{"Harrisburg", 40.289, -76.775},
+	{"Hartford", 41.770, -72.651},
+	{"Hat Yai", 7.012, 100.468},
+	{"Hatay", 36.232, 36.159},
+	{"Hathras", 27.597, 78.156},
+	{"Hattiesburg", 31.322, -89.328},
+	{"Havana", 23.077, -82.354},
+	{"Hebi", 35.854, 114.328},
+	{"Hechi", 23.120, 109.599},
+	{"Hefei", 31.832, 117.432},
+	{"Hegang", 47.299, 130.273},
+	{"Heidelberg", 49.407, 8.660},
+	{"Helong", 42.537, 128.985},
+	{"Helsingborg", 56.056, 12.723},
+	{"Helsinki", 60.255, 24.910},
+	{"Hengshui", 37.955, 115.831},
+	{"Hengyang", 27.014, 112.420},
+	{"Herat", 34.326, 62.223},
+	{"Hermosillo", 29.090, -110.964},
+	{"Hetauda", 27.424, 84.990},
+	{"Heyuan", 23.627, 114.631},
+	{"Heze", 35.202, 115.444},
+	{"Hickory", 35.730, -81.288},
+	{"Hidalgo del Parral", 26.933, -105.669},
+	{"Hilo", 19.704, -155.085},
+	{"Hims", 34.723, 36.704},
+	{"Hindupur", 13.837, 77.491},
+	{"Hinthada", 17.655, 95.449},
+	{"Hirosaki", 40.607, 140.472},
+	{"Hiroshima", 34.407, 132.445},
+	{"Hisar", 29.146, 75.811},
+	{"Ho", 6.610, 0.472},
+	{"Ho Chi Minh City", 10.815, 106.747},
+	{"Hoa Binh", 20.828, 105.343},
+	{"Hobart", -42.864, 147.296},
+	{"Hof", 50.302, 11.918},
+	{"Hohhot", 40.828, 111.709},
+	{"Holguin", 20.877, -76.254},
+	{"Homestead", 25.491, -80.444},
+	{"Homyel", 52.431, 30.981},
+	{"Hong Gai", 20.962, 107.113},
+	{"Hong Kong", 22.680, 114.035},
+	{"Honiara", -9.430, 159.967},
+	{"Honolulu", 21.348, -157.892},
+	{"Horlivka", 48.297, 38.097},
+	{"Hosaina", 7.592, 37.898},
+	{"Hoshiarpur", 31.465, 75.916},
+	{"Hospet", 15.280, 76.398},
+	{"Hotan", 37.171, 79.863},
+	{"Houma", 29.592, -90.717},
+	{"Houma", 35.629, 111.311},
+	{"Houston", 29.810, -95.432},
+	{"Hrodna", 53.672, 23.824},
+	{"Hsinchu", 24.753, 120.953},
+	{"Huacho", -11.103, -77.607},
+	{"Huaibei", 33.875, 116.579},
+	{"Huainan", 33.258, 116.576},
+	{"Huaiyin", 33.638, 119.423},
+	{"Hualien", 23.986, 121.591},
+	{"Huambo", -12.774, 15.745},
+	{"Huancayo", -12.061, -75.216},
+	{"Huangshi", 30.338, 115.102},
+	{"Huangyan", 28.643, 121.260},
+	{"Huanren", 41.282, 125.350},
+	{"Huanuco", -9.927, -76.242},
+	{"Huaraz", -9.529, -77.527},
+	{"Hubli", 15.408, 75.079},
+	{"Hue", 16.456, 107.598},
+	{"Huehuetenango", 15.335, -91.477},
+	{"Huelva", 37.265, -6.937},
+	{"Huizhou", 23.049, 114.212},
+	{"Hulan Ergi", 47.220, 123.617},
+	{"Hunanghua", 38.375, 117.316},
+	{"Hungnam", 39.863, 127.591},
+	{"Huntington", 38.416, -82.413},
+	{"Huntsville", 34.712, -86.635},
+	{"Hurghada", 27.238, 33.824},
+	{"Huzhou", 30.862, 119.975},
+	{"Hyderabad", 17.459, 78.398},
+	{"Hyderabad", 25.245, 68.588},
+	{"Hyeson", 41.401, 128.194},
+	{"Iasi", 47.144, 27.595},
+	{"Ibadan", 7.333, 3.895},
+	{"Ibague", 4.442, -75.199},
+	{"Ibarra", 0.347, -78.151},
+	{"Ibb", 13.961, 44.197},
+	{"Ica", -14.056, -75.728},
+	{"Icel", 36.795, 34.597},
+	{"Idah", 7.111, 6.743},
+	{"Idaho Falls", 43.492, -112.014},
+	{"Idlib", 35.943, 36.641},
+	{"Ife", 7.408, 4.597},
+	{"Iguala", 18.352, -99.534},
+	{"Ijebu Ode", 6.827, 3.950},
+	{"Ikare", 7.583, 5.922},
+	{"Iksan", 35.954, 126.952},
+	{"Ilam", 33.631, 46.406},
+	{"Ilheus", -14.811, -39.043},
+	{"Iligan", 8.200, 124.235},
+	{"Illichivsk", 46.329, 30.639},
+	{"Ilo", -17.644, -71.332},
+	{"Iloilo", 10.920, 122.602},
+	{"Ilorin", 8.453, 4.651},
+	{"Imperatriz", -5.509, -47.463},
+	{"Imphal", 24.824, 93.920},
+	{"Incheon", 37.515, 126.698},
+	{"Independence", 39.055, -94.417},
+	{"Indianapolis", 39.827, -86.136},
+	{"Indore", 22.737, 75.837},
+	{"Indramayu", -6.467, 108.124},
+	{"Ingolstadt", 48.758, 11.442},
+	{"Innsbruck", 47.265, 11.397},
+	{"Inowroclaw", 52.784, 18.259},
+	{"Ioanina", 39.652, 20.843},
+	{"Iowa City", 41.664, -91.544},
+	{"Ipatinga", -19.496, -42.588},
+	{"Ipiales", 0.839, -77.645},
+	{"Ipoh", 4.615, 101.099},
+	{"Ipswich", 52.058, 1.172},
+	{"Iquique", -20.247, -70.120},
+	{"Iquitos", -3.756, -73.269},
+	{"Iraklio", 35.327, 25.130},
+	{"Irbid", 32.580, 35.900},
+	{"Irbil", 36.188, 44.017},
+	{"Iringa", -7.767, 35.698},
+	{"Irkutsk", 52.299, 104.261},
+	{"Irvine", 33.706, -117.816},
+	{"Iseyin", 7.975, 3.588},
+	{"Isfahan", 32.646, 51.636},
+	{"Ishim", 56.112, 69.486},
+	{"Isiro", 2.757, 27.590},
+	{"Iskandar", 41.552, 69.707},
+	{"Iskenderun", 36.637, 36.188},
+	{"Iskitim", 54.631, 83.302},
+	{"Islamabad", 33.558, 73.183},
+	{"Ismailia", 30.544, 32.276},
+	{"Isna", 25.313, 32.564},
+	{"Isparta", 37.769, 30.556},
+	{"Istanbul", 41.005, 29.009},
+	{"Itabuna", -14.793, -39.266},
+	{"Itacoatiara", -3.138, -58.437},
+	{"Itaituba", -4.259, -55.996},
+	{"Itajai", -26.936, -48.657},
+	{"Itanhaem", -24.197, -46.823},
+	{"Itapetininga", -23.587, -48.048},
+	{"Itauna", -20.073, -44.590},
+	{"Ithaca", 42.454, -76.489},
+	{"Itu", -23.181, -47.275},
+	{"Itumbiara", -18.409, -49.223},
+	{"Ivano-Frankivsk", 48.915, 24.717},
+	{"Ivanovo", 57.005, 40.969},
+	{"Iwaki", 37.010, 140.881},
+	{"Iwo", 7.630, 4.184},
+	{"Izhevsk", 56.863, 53.230},
+	{"Izmayil", 45.351, 28.832},
+	{"Izmir", 38.400, 27.143},
+	{"Jabalpur", 23.186, 79.944},
+	{"Jaboatao", -8.092, -35.036},
+	{"Jaboticabal", -21.253, -48.321},
+	{"Jackson", 32.344, -90.192},
+	{"Jackson", 35.657, -88.830},
+	{"Jacksonville", 30.270, -81.644},
+	{"Jacksonville", 34.751, -77.399},
+	{"Jaffna", 9.732, 80.027},
+	{"Jaipur", 27.112, 75.739},
+	{"Jakarta", -6.314, 106.989},
+	{"Jalal Abad", 40.902, 72.971},
+	{"Jalalabad", 34.372, 70.310},
+	{"Jalingo", 8.898, 11.359},
+	{"Jaltipan", 17.978, -94.685},
+	{"Jamaame", 0.072, 42.744},
+	{"Jamalpur", 24.940, 89.941},
+	{"Jambi", -1.609, 103.616},
+	{"Jammu", 32.581, 74.835},
+	{"Jamshedpur", 22.746, 86.254},
+	{"Janesville", 42.688, -89.008},
+	{"Jaragua do Sul", -26.489, -49.073},
+	{"Jau", -22.287, -48.549},
+	{"Jawhar", 2.774, 45.502},
+	{"Jayapura", -2.538, 140.719},
+	{"JaÌ©n", 37.776, -3.789},
+	{"Jeddah", 21.526, 39.213},
+	{"Jefferson City", 38.572, -92.210},
+	{"Jeju", 33.500, 126.519},
+	{"Jelgava", 56.647, 23.724},
+	{"Jember", -8.091, 113.705},
+	{"Jena", 50.916, 11.596},
+	{"Jeonju", 35.847, 127.119},
+	{"Jequie", -13.863, -40.086},
+	{"Jerusalem", 31.810, 35.211},
+	{"Jessore", 23.252, 89.236},
+	{"Jhang", 31.002, 72.505},
+	{"Jhansi", 25.551, 78.533},
+	{"Ji-Parana", -10.880, -61.934},
+	{"Jiamusi", 46.796, 130.347},
+	{"Jian", 27.107, 115.003},
+	{"Jiangmen", 22.529, 113.059},
+	{"Jiaohe", 43.726, 127.346},
+	{"Jiaojing", 28.559, 121.455},
+	{"Jiaozuo", 35.114, 113.179},
+	{"Jiaxing", 30.715, 120.771},
+	{"Jiayuguan", 39.803, 98.273},
+	{"Jieshou", 33.239, 115.280},
+	{"Jiexiu", 37.239, 111.984},
+	{"Jihlava", 49.405, 15.587},
+	{"Jilin", 43.907, 126.517},
+	{"Jima", 7.748, 36.816},
+	{"Jinan", 36.697, 116.947},
+	{"Jinchang", 38.507, 102.189},
+	{"Jincheng", 35.646, 112.883},
+	{"Jingdezhen", 29.312, 117.200},
+	{"Jingmen", 30.898, 112.225},
+	{"Jinhua", 29.161, 119.496},
+	{"Jining", 35.517, 116.543},
+	{"Jining", 41.038, 113.109},
+	{"Jinja", 0.430, 33.215},
+	{"Jinshi", 29.692, 111.670},
+	{"Jinxi", 40.755, 120.851},
+	{"Jinzhou", 41.120, 121.104},
+	{"Jiujiang", 29.674, 115.986},
+	{"Jiutai", 44.160, 125.861},
+	{"Jixi", 45.327, 131.013},
+	{"Jizan", 16.899, 42.570},
+	{"Jizzax", 40.123, 67.852},
+	{"Joao Pessoa", -7.143, -34.889},
+	{"Jodhpur", 26.321, 73.245},
+	{"Johannesburg", -26.187, 28.064},
+	{"Johnson City", 36.328, -82.367},
+	{"Johnstown", 40.299, -78.900},
+	{"Johor Bahru", 1.520, 103.756},
+	{"Joinville", -26.304, -48.840},
+	{"Joliet", 41.593, -88.039},
+	{"Jonesboro", 35.823, -90.690},
+	{"Jonkoping", 57.760, 14.177},
+	{"Joplin", 37.087, -94.497},
+	{"Jorhat", 26.686, 94.197},
+	{"Jos", 9.788, 8.843},
+	{"Juazeiro", -9.417, -40.499},
+	{"Juazeiro do Norte", -7.245, -39.317},
+	{"Juba", 4.845, 31.602},
+	{"Juchitan", 16.445, -95.014},
+	{"Juifang", 25.121, 121.790},
+	{"Juiz de Fora", -21.747, -43.371},
+	{"Juliaca", -15.486, -70.131},
+	{"Jullundur", 31.271, 75.506},
+	{"Jundiai", -23.195, -46.849},
+	{"Jutiapa", 14.301, -89.913},
+	{"Jyvaskyla", 62.253, 25.766},
+	{"Kabale", -1.251, 30.011},
+	{"Kabul", 34.688, 69.144},
+	{"Kabwe", -14.434, 28.442},
+	{"Kadugli", 11.001, 29.725},
+	{"Kaduna", 10.510, 7.450},
+	{"Kaesong", 37.934, 126.503},
+	{"Kagoshima", 31.577, 130.534},
+	{"Kahramanmaras", 37.574, 36.936},
+	{"Kaifeng", 34.596, 114.572},
+	{"Kakamega", 0.218, 34.596},
+	{"Kakinada", 16.954, 82.013},
+	{"Kalamata", 37.047, 22.069},
+	{"Kalamazoo", 42.262, -85.593},
+	{"Kalemie", -5.925, 29.190},
+	{"Kaliningrad", 54.714, 20.501},
+	{"Kalisz", 51.757, 18.124},
+	{"Kaluga", 54.546, 36.278},
+	{"Kalyan", 19.184, 73.101},
+	{"Kamensk Shakhtinskiy", 48.314, 40.262},
+	{"Kamensk Uralskiy", 56.409, 61.927},
+	{"Kamina", -8.738, 24.999},
+	{"Kamloops", 50.692, -120.351},
+	{"Kampala", 0.324, 32.615},
+	{"Kampong Cham", 12.025, 105.445},
+	{"Kampot", 10.615, 104.180},
+	{"Kamyanets-Podilskyy", 48.680, 26.575},
+	{"Kananga", -5.897, 22.421},
+	{"Kanazawa", 36.567, 136.630},
+	{"Kanchipuram", 12.813, 79.671},
+	{"Kandahar", 31.618, 65.668},
+	{"Kandalaksha", 67.172, 32.410},
+	{"Kandy", 7.352, 80.456},
+	{"Kanggye", 40.970, 126.614},
+	{"Kankakee", 41.138, -87.866},
+	{"Kankan", 10.381, -9.303},
+	{"Kano", 11.914, 8.509},
+	{"Kanoya", 31.384, 130.851},
+	{"Kanpur", 26.769, 80.212},
+	{"Kansas City", 38.986, -94.730},
+	{"Kansas City", 39.034, -94.589},
+	{"Kansk", 56.210, 95.716},
+	{"Kaohsiung", 22.735, 120.338},
+	{"Kaolack", 14.156, -16.082},
+	{"Kaposvar", 46.366, 17.800},
+	{"Kara Balta", 42.826, 73.875},
+	{"Karabuk", 41.224, 32.654},
+	{"Karachi", 24.905, 67.090},
+	{"Karaj", 35.738, 50.997},
+	{"Karakol", 42.489, 78.386},
+	{"Karaman", 37.183, 33.230},
+	{"Karamay", 45.601, 84.864},
+	{"Karbala", 32.609, 44.011},
+	{"Karimnagar", 18.463, 79.131},
+	{"Karlovac", 45.490, 15.545},
+	{"Karlsruhe", 49.004, 8.413},
+	{"Karlstad", 59.378, 13.497},
+	{"Karnal", 29.789, 76.831},
+	{"Karur", 10.974, 77.937},
+	{"Kashi", 39.472, 76.054},
+	{"Kashmar", 35.227, 58.466},
+	{"Kassala", 15.458, 36.385},
+	{"Kassel", 51.308, 9.487},
+	{"Kasur", 31.080, 74.236},
+	{"Kathmandu", 27.698, 85.356},
+	{"Kati", 12.739, -8.067},
+	{"Katowice", 50.300, 19.092},
+	{"Katsina", 12.803, 7.893},
+	{"Kattaqorgon", 39.944, 66.205},
+	{"Kaunas", 54.904, 23.930},
+	{"Kavala", 40.938, 24.403},
+	{"Kawagoe", 35.966, 139.531},
+	{"Kawasaki", 35.545, 139.634},
+	{"Kayes", -4.179, 13.283},
+	{"Kayes", 14.443, -11.433},
+	{"Kayseri", 38.689, 35.480},
+	{"Kazan", 55.826, 49.102},
+	{"Kecskemet", 46.906, 19.684},
+	{"Kediri", -7.696, 111.926},
+	{"Keelung", 25.101, 121.693},
+	{"Keffi", 8.852, 7.851},
+	{"Kelang", 3.015, 101.514},
+	{"Kelo", 9.305, 15.807},
+	{"Keluang", 2.042, 103.337},
+	{"Kemerovo", 55.367, 86.060},
+	{"Kendari", -3.989, 122.537},
+	{"Kendu Bay", -0.398, 34.717},
+	{"Kenema", 7.872, -11.186},
+	{"Kenitra", 34.272, -6.565},
+	{"Kennewick", 46.212, -119.144},
+	{"Kentau", 43.521, 68.515},
+	{"Kerch", 45.366, 36.458},
+	{"Kericho", -0.359, 35.278},
+	{"Kerman", 30.283, 57.069},
+	{"Kermanshah", 34.345, 47.086},
+	{"Keshan", 48.027, 125.865},
+	{"Khabarovsk", 48.462, 135.101},
+	{"Khammam", 17.237, 80.167},
+	{"Kharkiv", 49.976, 36.257},
+	{"Khartoum", 15.559, 32.550},
+	{"Khaskovo", 41.936, 25.561},
+	{"Kherson", 46.663, 32.613},
+	{"Khiwa", 41.397, 60.392},
+	{"Khmelnytskyy", 49.419, 26.988},
+	{"Khomeini Shahr", 32.586, 51.529},
+	{"Khon Kaen", 16.433, 102.822},
+	{"Khoramabad", 33.470, 48.335},
+	{"Khujand", 40.252, 69.698},
+	{"Khujayli", 42.418, 59.452},
+	{"Khulna", 23.086, 89.615},
+	{"Khvoy", 38.555, 44.960},
+	{"Kiel", 54.323, 10.137},
+	{"Kielce", 50.852, 20.612},
+	{"Kiev", 50.451, 30.453},
+	{"Kiffa", 16.617, -11.394},
+	{"Kigali", -2.034, 29.914},
+	{"Kikwit", -5.037, 18.813},
+	{"Kilchu", 40.955, 129.319},
+	{"Kilifi", -3.572, 39.832},
+	{"Kilinochchi", 9.429, 80.394},
+	{"Kilis", 36.715, 37.123},
+	{"Killeen", 31.111, -97.738},
+	{"Kilowna", 49.875, -119.432},
+	{"Kimberley", -28.730, 24.752},
+	{"Kimchaek", 40.700, 129.195},
+	{"Kindia", 10.046, -12.859},
+	{"Kindu", -2.947, 25.926},
+	{"Kineshma", 57.449, 42.139},
+	{"Kingsport", 36.552, -82.536},
+	{"Kingston", 18.019, -76.798},
+	{"Kingston", 44.253, -76.534},
+	{"Kingston upon Hull", 53.763, -0.364},
+	{"Kinshasa", -4.384, 15.334},
+	{"Kipushi", -11.767, 27.240},
+	{"Kirikkale", 39.847, 33.529},
+	{"Kirkuk", 35.440, 44.381},
+	{"Kirov", 58.609, 49.640},
+	{"Kirovo-Chepetsk", 58.549, 50.015},
+	{"Kirovohrad", 48.515, 32.256},
+	{"Kisangani", 0.522, 25.216},
+	{"Kiselevsk", 53.997, 86.666},
+	{"Kisii", -0.709, 34.745},
+	{"Kislovodsk", 43.919, 42.715},
+	{"Kismaayo", -0.351, 42.545},
+	{"Kissidougou", 9.195, -10.101},
+	{"Kissimmee", 28.309, -81.388},
+	{"Kisumu", -0.088, 34.763},
+	{"Kitakyushu", 33.786, 130.785},
+	{"Kitale", 1.034, 34.995},
+	{"Kitami", 43.809, 143.880},
+	{"Kitchener", 43.431, -80.438},
+	{"Kitwe", -12.813, 28.221},
+	{"Klagenfurt", 46.626, 14.317},
+	{"Klaipeda", 55.704, 21.165},
+	{"Klerksdorp", -26.866, 26.647},
+	{"Klin", 56.333, 36.727},
+	{"Klintsy", 52.754, 32.240},
+	{"Knoxville", 35.979, -83.985},
+	{"Kobe", 34.749, 134.904},
+	{"Koblenz", 50.397, 7.520},
+	{"Kocaeli", 40.764, 29.911},
+	{"Kochi", 33.564, 133.533},
+	{"Koforidua", 6.099, -0.259},
+	{"Kofu", 35.656, 138.588},
+	{"Kogon", 39.746, 64.540},
+	{"Kohat", 33.539, 71.474},
+	{"Kokomo", 40.472, -86.131},
+	{"Kokshetau", 53.285, 69.401},
+	{"Kolar", 13.138, 78.135},
+	{"Kolda", 12.897, -14.939},
+	{"Kolhapur", 16.656, 74.255},
+	{"Koln", 50.996, 7.000},
+	{"Kolomna", 55.084, 38.799},
+	{"Kolpino", 59.794, 30.565},
+	{"Kom Ombo", 24.442, 32.946},
+	{"Kompong Chhnang", 12.240, 104.662},
+	{"Komsomolsk na Amure", 50.577, 137.019},
+	{"Kon Tum", 14.361, 107.995},
+	{"Kondoz", 36.709, 68.821},
+	{"Kongolo", -5.386, 27.001},
+	{"Konibodom", 40.360, 70.501},
+	{"Konotop", 51.233, 33.185},
+	{"Konya", 37.861, 32.499},
+	{"Korhogo", 9.458, -5.630},
+	{"Koriyama", 37.404, 140.373},
+	{"Korla", 41.765, 86.136},
+	{"Korosten", 50.962, 28.639},
+	{"Kosice", 48.710, 21.263},
+	{"Kosti", 13.149, 32.664},
+	{"Kostroma", 57.772, 40.946},
+	{"Koszalin", 54.194, 16.187},
+	{"Kota", 25.184, 75.873},
+	{"Kota Baharu", 6.096, 102.290},
+	{"Kota Kinabalu", 5.943, 116.095},
+	{"Kotabumi", -4.699, 105.190},
+	{"Kotlas", 61.260, 46.666},
+	{"Kotte", 6.842, 80.098},
+	{"Koudougou", 12.259, -2.361},
+	{"Koutiala", 12.389, -5.464},
+	{"Kovel", 51.225, 24.703},
+	{"Kovrov", 56.365, 41.313},
+	{"Kozhikode", 11.363, 75.890},
+	{"Kpalime", 6.901, 0.624},
+	{"Kragujevac", 44.012, 20.901},
+	{"Krakow", 50.056, 20.047},
+	{"Kramatorsk", 48.728, 37.552},
+	{"Krasnodar", 45.054, 38.991},
+	{"Krasnokamensk", 50.100, 118.037},
+	{"Krasnoyarsk", 56.001, 92.955},
+	{"Kremenchuk", 49.100, 33.425},
+	{"Krishnanagar", 23.519, 88.388},
+	{"Kristiansand", 58.156, 7.990},
+	{"Kroonstad", -27.654, 27.220},
+	{"Kropotkin", 45.435, 40.571},
+	{"Kryvyy Rih", 47.916, 33.398},
+	{"Ksar El Kebir", 35.003, -5.906},
+	{"Kuala Lumpur", 3.131, 101.645},
+	{"Kuala Terengganu", 5.308, 103.128},
+	{"Kuantan", 3.817, 103.322},
+	{"Kuching", 1.544, 110.352},
+	{"Kuga", 41.713, 82.964},
+	{"Kuito", -12.393, 16.944},
+	{"Kulob", 37.919, 69.787},
+	{"Kumamoto", 32.819, 130.739},
+	{"Kumasi", 6.711, -1.617},
+	{"Kumba", 4.622, 9.463},
+	{"Kumbakonam", 10.934, 79.564},
+	{"Kumbo", 6.264, 10.684},
+	{"Kundian", 32.332, 71.585},
+	{"Kungur", 57.430, 56.966},
+	{"Kunming", 25.006, 102.734},
+	{"Kuopio", 62.886, 27.655},
+	{"Kupang", -10.191, 123.621},
+	{"Kupyansk", 49.696, 37.632},
+	{"Kure", 34.232, 132.579},
+	{"Kurgan", 55.453, 65.326},
+	{"Kurnool", 15.826, 78.045},
+	{"Kursk", 51.718, 36.169},
+	{"Kushiro", 42.998, 144.375},
+	{"Kuta", -8.735, 115.193},
+	{"Kutahya", 39.428, 29.989},
+	{"Kutaisi", 42.240, 42.629},
+	{"Kuwait", 29.277, 47.994},
+	{"Kuznetsk", 53.117, 46.604},
+	{"Kwekwe", -18.936, 29.813},
+	{"Kyoto", 34.913, 135.743},
+	{"Kyshtym", 55.716, 60.547},
+	{"Kyustendil", 42.286, 22.690},
+	{"Kyzyl", 51.705, 94.442},
+	{"L'Aquila", 42.359, 13.380},
+	{"La Ceiba", 15.773, -86.788},
+	{"La Coruna", 43.341, -8.367},
+	{"La Crosse", 43.856, -91.234},
+	{"La Paz", -16.506, -68.158},
+	{"La Paz", 24.142, -110.310},
+	{"La Plata", -34.890, -58.050},
+	{"La Rioja", -29.408, -66.848},
+	{"La Rochelle", 46.163, -1.136},
+	{"La Romana", 18.434, -68.980},
+	{"La Serena", -29.928, -71.250},
+	{"La Vega", 19.249, -70.512},
+	{"Laayoune", 27.146, -13.195},
+	{"Labe", 11.321, -12.277},
+	{"Lae", -6.701, 147.001},
+	{"Lafayette", 30.199, -92.031},
+	{"Lafayette", 40.417, -86.886},
+	{"Laghouat", 33.803, 2.871},
+	{"Lagos", 6.586, 3.231},
+	{"Lagos de Moreno", 21.366, -101.914},
+	{"Lahad Datu", 5.032, 118.326},
+	{"Lahij", 13.064, 44.880},
+	{"Lahore", 31.523, 73.969},
+	{"Lahti", 60.989, 25.648},
+	{"Laiwu", 36.105, 117.562},
+	{"Laiyang", 36.967, 120.690},
+	{"Lajes", -27.810, -50.322},
+	{"Lake Charles", 30.199, -93.215},
+	{"Lake Havasu City", 34.487, -114.308},
+	{"Lakeville", 44.738, -93.274},
+	{"Lalitpur", 27.642, 85.453},
+	{"Lampang", 18.304, 99.500},
+	{"Lancaster", 34.633, -118.137},
+	{"Lancaster", 40.051, -76.328},
+	{"Langfang", 39.571, 116.702},
+	{"Langzhong", 31.544, 106.231},
+	{"Lankaran", 38.770, 48.831},
+	{"Lansing", 42.718, -84.530},
+	{"Lanxi", 46.242, 126.281},
+	{"Lanzhou", 36.078, 103.765},
+	{"Lao Chi", 22.491, 103.971},
+	{"Laoag", 18.120, 120.558},
+	{"Larache", 35.181, -6.147},
+	{"Laredo", 27.519, -99.487},
+	{"Larissa", 39.633, 22.435},
+	{"Larkana", 27.423, 68.031},
+	{"Las Cruces", 32.320, -106.776},
+	{"Las Heras", -32.843, -68.758},
+	{"Las Palmas", 28.107, -15.443},
+	{"Las Tunas", 20.964, -76.954},
+	{"Las Vegas", 36.146, -115.171},
+	{"Latacunga", -0.922, -78.619},
+	{"Latur", 18.411, 76.576},
+	{"Launceston", -41.437, 147.146},
+	{"Lausanne", 46.534, 6.598},
+	{"Lautoka", -17.627, 177.450},
+	{"Lavras", -21.239, -45.005},
+	{"Lawrence", 38.958, -95.259},
+	{"Lawton", 34.620, -98.427},
+	{"Lazaro Cardenas", 17.969, -102.203},
+	{"Le Havre", 49.507, 0.186},
+	{"Le Mans", 47.987, 0.192},
+	{"Lecce", 40.360, 18.156},
+	{"Leeds", 53.761, -1.551},
+	{"Leeuwarden", 53.212, 5.799},
+	{"Legazpi", 13.177, 123.727},
+	{"Legnica", 51.204, 16.179},
+	{"Leicester", 52.643, -1.137},
+	{"Leipzig", 51.337, 12.378},
+	{"Lemosos", 34.691, 33.027},
+	{"Leninsk Kuznetsky", 54.666, 86.186},
+	{"Leon", 12.436, -86.879},
+	{"Leon", 21.129, -101.669},
+	{"Leon", 42.598, -5.575},
+	{"Les Cayes", 18.241, -73.818},
+	{"Leshan", 29.698, 103.995},
+	{"Lesosibirsk", 58.237, 92.478},
+	{"Lethbridge", 49.700, -112.811},
+	{"Leticia", -4.223, -69.935},
+	{"Letpadan", 17.723, 95.795},
+	{"Lewiston", 44.097, -70.213},
+	{"Lexington", 38.023, -84.501},
+	{"Lhasa", 29.662, 91.109},
+	{"Lhokseumawe", 5.211, 97.079},
+	{"Lianxian", 24.762, 112.344},
+	{"Lianyungang", 34.422, 119.407},
+	{"Liaocheng", 36.295, 115.974},
+	{"Liaoyang", 41.263, 123.192},
+	{"Liaoyuan", 42.918, 125.108},
+	{"Liberec", 50.759, 15.059},
+	{"Libreville", 0.395, 9.473},
+	{"Lichinga", -13.297, 35.254},
+	{"Lida", 53.893, 25.296},
+	{"Liege", 50.627, 5.634},
+	{"Liepaga", 56.529, 21.023},
+	{"Likasi", -10.988, 26.741},
+	{"Lille", 50.726, 3.153},
+	{"Lilongwe", -14.028, 33.889},
+	{"Lima", -12.041, -77.010},
+	{"Lima", 40.742, -84.123},
+	{"Limbe", 4.024, 9.200},
+	{"Limeira", -22.568, -47.406},
+	{"Limerick", 52.663, -8.620},
+	{"Limoges", 45.839, 1.269},
+	{"Linares", -35.845, -71.588},
+	{"Linchuan", 27.996, 116.356},
+	{"Lincoln", 40.805, -96.674},
+	{"Linfen", 36.142, 111.565},
+	{"Linhai", 28.883, 121.175},
+	{"Linhares", -19.370, -40.060},
+	{"Linjiang", 41.809, 126.910},
+	{"Linkoping", 58.413, 15.619},
+	{"Linkou", 45.282, 130.263},
+	{"Linqing", 36.783, 115.586},
+	{"Linxia", 35.594, 103.161},
+	{"Linyi", 34.988, 118.235},
+	{"Linz", 48.242, 14.210},
+	{"Lipetsk", 52.606, 39.599},
+	{"Lira", 2.215, 32.931},
+	{"Lisala", 2.168, 21.506},
+	{"Lisbon", 38.783, -9.233},
+	{"Lishui", 28.456, 119.925},
+	{"Little Rock", 34.757, -92.309},
+	{"Liuhe", 42.273, 125.734},
+	{"Liuzhou", 24.318, 109.378},
+	{"Liverpool", 53.443, -2.782},
+	{"Livingstone", -17.854, 25.862},
+	{"Livny", 52.421, 37.591},
+	{"Livorno", 43.557, 10.334},
+	{"Ljubljana", 46.092, 14.541},
+	{"Lobatse", -25.215, 25.682},
+	{"Lobito", -12.363, 13.562},
+	{"Lodz", 51.761, 19.440},
+	{"Logan", 41.732, -111.828},
+	{"Logrono", 42.459, -2.439},
+	{"Loja", -3.984, -79.217},
+	{"Lome", 6.154, 1.190},
+	{"Lomza", 53.173, 22.067},
+	{"London", 42.985, -81.248},
+	{"London", 51.490, -0.170},
+	{"Londonderry", 55.006, -7.319},
+	{"Londrina", -23.297, -51.171},
+	{"Long Beach", 33.811, -118.170},
+	{"Long Xuyen", 10.345, 105.374},
+	{"Longjiang", 47.336, 123.186},
+	{"Longview", 32.509, -94.756},
+	{"Longview", 46.149, -122.941},
+	{"Longxi", 35.037, 104.498},
+	{"Longyan", 25.083, 117.013},
+	{"Lop Buri", 14.810, 100.655},
+	{"Lorica", 9.236, -75.805},
+	{"Lorient", 47.749, -3.385},
+	{"Los Angeles", -37.466, -72.337},
+	{"Los Angeles", 33.981, -118.107},
+	{"Los Mochis", 25.803, -108.993},
+	{"Los Teques", 10.448, -66.997},
+	{"Louangphrabang", 19.882, 102.133},
+	{"Loubomo", -4.192, 12.667},
+	{"Louga", 15.620, -16.221},
+	{"Louisville", 38.212, -85.690},
+	{"Lowell", 42.649, -71.257},
+	{"Luan", 31.847, 116.607},
+	{"Luanda", -8.852, 13.283},
+	{"Luanshya", -13.135, 28.406},
+	{"Lubango", -14.914, 13.504},
+	{"Lubbock", 33.562, -101.879},
+	{"Lubeck", 53.879, 10.699},
+	{"Lublin", 51.247, 22.562},
+	{"Lubumbashi", -11.672, 27.486},
+	{"Lucknow", 27.031, 81.037},
+	{"Ludhiana", 30.765, 75.876},
+	{"Lugano", 45.995, 8.914},
+	{"Luhansk", 48.559, 39.318},
+	{"Lujan", -34.566, -59.108},
+	{"Luohe", 33.442, 114.037},
+	{"Luoyang", 34.539, 112.440},
+	{"Lupanshui", 26.506, 105.011},
+	{"Lusaka", -15.404, 28.309},
+	{"Luton", 51.892, -0.449},
+	{"Lutsk", 50.744, 25.337},
+	{"Luxembourg", 49.621, 6.125},
+	{"Luxor", 25.661, 32.619},
+	{"Luzern", 47.060, 8.302},
+	{"Luzhou", 28.937, 105.492},
+	{"Lvov", 49.842, 23.996},
+	{"Lynchburg", 37.388, -79.201},
+	{"Lyon", 45.751, 4.840},
+	{"Lysychansk", 48.913, 38.420},
+	{"Maanshan", 31.667, 118.525},
+	{"Macae", -22.370, -41.781},
+	{"Macau", 22.294, 113.463},
+	{"Maceio", -9.567, -35.787},
+	{"Machakos", -1.515, 37.265},
+	{"Machala", -3.259, -79.959},
+	{"Macheng", 30.875, 114.955},
+	{"Machilipatnam", 16.299, 81.111},
+	{"Mackay", -21.133, 149.173},
+	{"Macon", 32.848, -83.666},
+	{"Madang", -5.222, 145.790},
+	{"Madinat ath Thawrah", 35.836, 38.546},
+	{"Madison", 43.074, -89.399},
+	{"Madiun", -7.645, 111.470},
+	{"Madrid", 40.425, -3.749},
+	{"Madurai", 9.988, 78.472},
+	{"Maebashi", 36.345, 139.083},
+	{"Mafetang", -29.812, 27.234},
+	{"Magadan", 59.567, 150.809},
+	{"Magangue", 9.263, -74.778},
+	{"Magdeburg", 52.132, 11.632},
+	{"Magelang", -7.408, 110.125},
+	{"Magnitogorsk", 53.425, 58.971},
+	{"Magway", 20.165, 94.955},
+	{"Mahabad", 36.760, 45.726},
+	{"Mahalapye", -23.107, 26.819},
+	{"Mahilyow", 53.897, 30.334},
+	{"Maiduguri", 11.787, 13.229},
+	{"Maiquetia", 10.602, -66.958},
+	{"Majene", -3.431, 119.098},
+	{"Makeni", 8.886, -12.043},
+	{"Makhachkala", 42.976, 47.460},
+	{"Makiyivka", 48.054, 37.986},
+	{"Makkah", 21.427, 39.851},
+	{"Makurdi", 7.744, 8.564},
+	{"Malacca", 2.264, 102.252},
+	{"Maladzyechna", 54.312, 26.850},
+	{"Malaga", 36.719, -4.441},
+	{"Malang", -8.057, 112.468},
+	{"Malanje", -9.543, 16.348},
+	{"Malatya", 38.344, 38.277},
+	{"Male", 4.175, 73.508},
+	{"Malegaon", 20.556, 74.369},
+	{"Malindi", -3.201, 40.097},
+	{"Mallawi", 27.626, 30.814},
+	{"Malmo", 55.599, 13.039},
+	{"Mamou", 10.368, -12.090},
+	{"Man", 7.412, -7.546},
+	{"Manacapuru", -3.293, -60.624},
+	{"Manado", 1.472, 124.846},
+	{"Managua", 12.133, -86.263},
+	{"Manama", 26.165, 50.543},
+	{"Manaus", -3.065, -59.995},
+	{"Manbij", 36.528, 37.955},
+	{"Manchester", 42.932, -71.475},
+	{"Manchester", 53.518, -2.295},
+	{"Mandalay", 21.952, 96.098},
+	{"Mandera", 3.934, 41.860},
+	{"Mandya", 12.536, 76.889},
+	{"Mangalore", 12.965, 74.843},
+	{"Mangochi", -14.529, 35.216},
+	{"Manhattan", 39.195, -96.589},
+	{"Manila", 14.823, 120.915},
+	{"Manisa", 38.643, 27.417},
+	{"Manizales", 5.069, -75.497},
+	{"Mankato", 44.168, -94.004},
+	{"Mannheim", 49.463, 8.504},
+	{"Manokwari", -0.862, 134.069},
+	{"Manpo", 41.145, 126.250},
+	{"Mansehra", 34.358, 73.219},
+	{"Mansfield", 40.760, -82.527},
+	{"Manta", -0.973, -80.709},
+	{"Manukau", -36.972, 174.884},
+	{"Manzanillo", 19.070, -104.294},
+	{"Manzanillo", 20.337, -77.122},
+	{"Manzhouli", 49.583, 117.453},
+	{"Maoming", 21.759, 110.950},
+	{"Maputo", -25.881, 32.544},
+	{"Mar del Plata", -38.001, -57.591},
+	{"Maracaibo", 10.650, -71.660},
+	{"Maradi", 13.505, 7.117},
+	{"Maragheh", 37.391, 46.247},
+	{"Marbella", 36.499, -4.905},
+	{"Mardan", 34.208, 72.111},
+	{"Mardin", 37.317, 40.732},
+	{"Maribor", 46.533, 15.646},
+	{"Marietta", 33.959, -84.590},
+	{"Marilia", -22.213, -49.946},
+	{"Maringa", -23.425, -51.922},
+	{"Mariupol", 47.118, 37.570},
+	{"Maroua", 10.601, 14.328},
+	{"Marrakesh", 31.645, -7.989},
+	{"Marseille", 43.351, 5.398},
+	{"Martapura", -3.428, 114.805},
+	{"Mary", 37.606, 61.849},
+	{"Marzuq", 25.927, 13.908},
+	{"Masaka", -0.282, 31.717},
+	{"Masan", 35.207, 128.641},
+	{"Mascara", 35.392, 0.132},
+	{"Maseru", -29.350, 27.537},
+	{"Mashhad", 36.313, 59.581},
+	{"Masjed Soleyman", 31.951, 49.290},
+	{"Massawa", 15.615, 39.454},
+	{"Masvingo", -20.067, 30.825},
+	{"Matagalpa", 12.929, -85.921},
+	{"Matamoros", 25.869, -97.497},
+	{"Matanzas", 23.045, -81.562},
+	{"Matara", 6.059, 80.427},
+	{"Mataram", -8.617, 116.130},
+	{"Mataro", 41.524, 2.384},
+	{"Matehuala", 23.655, -100.641},
+	{"Mathura", 27.681, 77.543},
+	{"Matola", -25.889, 32.472},
+	{"Matruh", 31.345, 27.241},
+	{"Matsue", 35.455, 133.085},
+	{"Matsumoto", 36.189, 137.964},
+	{"Matsuyama", 33.823, 132.771},
+	{"Maturin", 9.754, -63.180},
+	{"Maumere", -8.641, 122.215},
+	{"Mawlamyine", 16.484, 97.652},
+	{"Maxixe", -23.916, 35.304},
+	{"May Pen", 17.961, -77.260},
+	{"Mayaguez", 18.336, -67.136},
+	{"Maykop", 44.605, 40.104},
+	{"Mazabuka", -15.856, 27.755},
+	{"Mazar-e Sharif", 36.717, 67.031},
+	{"Mazatenango", 14.532, -91.506},
+	{"Mazatlan", 23.254, -106.410},
+	{"Mazyr", 52.041, 29.230},
+	{"Mbabane", -26.315, 31.130},
+	{"Mbale", 1.011, 34.144},
+	{"Mbandaka", 0.037, 18.269},
+	{"Mbanza-Congo", -6.269, 14.239},
+	{"Mbanza-Ngungu", -5.242, 14.867},
+	{"Mbarara", -0.593, 30.648},
+	{"Mbeya", -8.908, 33.454},
+	{"Mbuji-Mayi", -6.131, 23.597},
+	{"McAllen", 26.232, -98.268},
+	{"Medan", 3.636, 98.678},
+	{"Medani", 14.399, 33.543},
+	{"Medea", 36.268, 2.762},
+	{"Medellin", 6.229, -75.586},
+	{"Medford", 42.335, -122.868},
+	{"Medicine Hat", 50.027, -110.675},
+	{"Medina", 24.466, 39.610},
+	{"Medinipur", 22.400, 87.264},
+	{"Meerut", 29.059, 77.780},
+	{"Meizhou", 24.299, 116.088},
+	{"Mekele", 13.493, 39.467},
+	{"Meknes", 33.889, -5.549},
+	{"Melbourne", -37.835, 145.054},
+	{"Melbourne", 28.082, -80.669},
+	{"Melilla", 35.289, -2.957},
+	{"Melitopol", 46.844, 35.393},
+	{"Melo", -32.367, -54.164},
+	{"Melun", 48.608, 2.620},
+	{"Memphis", 35.101, -89.932},
+	{"Mendoza", -32.917, -68.790},
+	{"Mengzi", 23.382, 103.370},
+	{"Merced", 37.311, -120.474},
+	{"Merida", 20.975, -89.616},
+	{"Meru", 0.019, 37.801},
+	{"Mesa", 33.368, -111.775},
+	{"Messina", 38.193, 15.548},
+	{"Metairie", 29.962, -90.175},
+	{"Metz", 49.210, 6.139},
+	{"Mexicali", 32.626, -115.449},
+	{"Mexico City", 19.474, -99.117},
+	{"Meymaneh", 35.957, 64.859},
+	{"Miami", 26.067, -80.236},
+	{"Miami Beach", 25.889, -80.163},
+	{"Mianyang", 31.344, 105.029},
+	{"Miass", 55.053, 60.105},
+	{"Michurinsk", 52.900, 40.495},
+	{"Middelburg", -25.767, 29.462},
+	{"Middlesbrough", 54.568, -1.217},
+	{"Midland", 32.007, -102.109},
+	{"Mikhaylovka", 50.063, 43.234},
+	{"Milan", 45.600, 9.205},
+	{"Milwaukee", 43.045, -88.020},
+	{"Minatitlan", 18.001, -94.566},
+	{"Mindelo", 16.887, -24.984},
+	{"Minna", 9.630, 6.541},
+	{"Minneapolis", 44.991, -93.328},
+	{"Minsk", 53.893, 27.562},
+	{"Minxian", 34.431, 103.988},
+	{"Miri", 4.405, 113.999},
+	{"Mirput Khas", 25.543, 68.980},
+	{"Mirzapur", 24.963, 82.720},
+	{"Mishan", 45.551, 131.876},
+	{"Miskolc", 48.098, 20.779},
+	{"Misratah", 32.373, 15.090},
+	{"Missoula", 46.856, -114.031},
+	{"Mito", 36.388, 140.483},
+	{"Miyazaki", 31.931, 131.430},
+	{"Mmabatho", -25.845, 25.635},
+	{"Moanda", -5.873, 12.361},
+	{"Mobile", 30.680, -88.133},
+	{"Modena", 44.651, 10.924},
+	{"Modesto", 37.650, -120.988},
+	{"Mogadishu", 2.054, 45.331},
+	{"Mojokerto", -7.425, 112.370},
+	{"Mokpo", 34.815, 126.407},
+	{"Molepolole", -24.400, 25.516},
+	{"Mombasa", -3.933, 39.632},
+	{"Monaco", 43.754, 7.443},
+	{"Monclova", 26.911, -101.429},
+	{"Moncton", 46.096, -64.788},
+	{"Monroe", 32.509, -92.123},
+	{"Monrovia", 6.318, -10.735},
+	{"Montego Bay", 18.475, -77.896},
+	{"Monterey", 36.610, -121.866},
+	{"Monterey", 36.990, -121.977},
+	{"Monteria", 8.756, -75.878},
+	{"Montero", -17.335, -63.253},
+	{"Monterrey", 25.716, -100.291},
+	{"Montes Claros", -16.724, -43.854},
+	{"Montevideo", -34.828, -56.123},
+	{"Montgomery", 32.362, -86.254},
+	{"Montpellier", 43.625, 3.877},
+	{"Montreal", 45.573, -73.703},
+	{"Monywa", 22.143, 95.126},
+	{"Mopti", 14.496, -4.187},
+	{"Moradabad", 28.887, 78.576},
+	{"Moratuwa", 6.650, 80.000},
+	{"Morelia", 19.708, -101.193},
+	{"Morgantown", 39.640, -79.959},
+	{"Morioka", 39.712, 141.133},
+	{"Morogoro", -6.812, 37.664},
+	{"Moroni", -11.640, 43.264},
+	{"Moscow", 55.755, 37.644},
+	{"Moshi", -3.312, 37.543},
+	{"Mossoro", -5.184, -37.348},
+	{"Mostaganem", 35.906, 0.091},
+	{"Mostar", 43.343, 17.823},
+	{"Mosul", 36.347, 43.157},
+	{"Moundou", 8.568, 16.071},
+	{"Mt. Hagen", -5.838, 144.246},
+	{"Mtwara", -10.309, 40.183},
+	{"Muar", 2.046, 102.609},
+	{"Mubi", 10.197, 13.375},
+	{"Mudangiang", 44.587, 129.623},
+	{"Mudon", 16.288, 97.699},
+	{"Mufulira", -12.553, 28.253},
+	{"Mulhouse", 47.775, 7.331},
+	{"Multan", 30.251, 71.995},
+	{"Mumbai", 19.189, 72.960},
+	{"Munchon", 39.375, 127.253},
+	{"Muncie", 40.196, -85.411},
+	{"Munich", 48.137, 11.550},
+	{"Murcia", 38.007, -1.177},
+	{"Murfreesboro", 35.851, -86.394},
+	{"Muriae", -21.130, -42.369},
+	{"Murmansk", 68.964, 33.078},
+	{"Murom", 55.572, 42.034},
+	{"Muroran", 42.376, 141.043},
+	{"Mus", 38.748, 41.511},
+	{"Musan", 42.224, 129.218},
+	{"Muscat", 23.599, 58.475},
+	{"Muskegon", 43.206, -86.225},
+	{"Musoma", -1.534, 33.794},
+	{"Mutare", -18.976, 32.652},
+	{"Muyinga", -2.805, 30.107},
+	{"Muzaffarnagar", 29.500, 77.705},
+	{"Muzaffarpur", 26.239, 85.671},
+	{"Mwanza", -2.531, 32.960},
+	{"Mwene-Ditu", -7.004, 23.453},
+	{"My Tho", 10.367, 106.359},
+	{"Myeik", 12.443, 98.610},
+	{"Myingyan", 21.463, 95.404},
+	{"Myitkyina", 25.396, 97.392},
+	{"Mykolayiv", 46.957, 32.019},
+	{"Mymensingh", 24.690, 90.575},
+	{"Mysore", 12.340, 76.643},
+	{"Mzuzu", -11.447, 34.005},
+	{"MÌùnster", 51.948, 7.639},
+	{"Naberezhnyye Chelny", 55.713, 52.383},
+	{"Nabeul", 36.455, 10.737},
+	{"Nablus", 32.237, 35.252},
+	{"Nacala", -14.547, 40.701},
+	{"Naga", 13.607, 123.209},
+	{"Nagano", 36.557, 138.202},
+	{"Nagaoka", 37.454, 138.833},
+	{"Nagasaki", 32.758, 129.861},
+	{"Nagercoil", 8.230, 77.467},
+	{"Nagoya", 35.086, 136.981},
+	{"Nagpur", 21.180, 79.150},
+	{"Naha", 26.288, 127.758},
+	{"Nairobi", -1.250, 36.804},
+	{"Najran", 17.537, 44.204},
+	{"Nakhodka", 42.822, 132.902},
+	{"Nakhon Phanom", 17.402, 104.772},
+	{"Nakhon Ratchasima", 14.964, 102.087},
+	{"Nakhon Sawan", 15.697, 100.116},
+	{"Nakhon Si Thammarat", 8.434, 99.965},
+	{"Nakuru", -0.281, 36.097},
+	{"Naltchik", 43.510, 43.615},
+	{"Nalut", 31.866, 10.980},
+	{"Nam Dinh", 20.485, 106.252},
+	{"Namangan", 40.993, 71.666},
+	{"Namibe", -15.196, 12.151},
+	{"Nampo", 38.742, 125.398},
+	{"Nampula", -15.109, 39.265},
+	{"Namur", 50.451, 4.847},
+	{"Nan", 18.784, 100.776},
+	{"Nanaimo", 49.193, -123.986},
+	{"Nancha", 47.138, 129.260},
+	{"Nanchang", 28.489, 115.869},
+	{"Nanchong", 30.672, 106.224},
+	{"Nancy", 48.682, 6.171},
+	{"Nanded", 19.191, 77.320},
+	{"Nandyal", 15.487, 78.484},
+	{"Nangong", 37.331, 115.139},
+	{"Nanjing", 32.030, 118.825},
+	{"Nanning", 22.808, 108.342},
+	{"Nanping", 26.644, 118.167},
+	{"Nantes", 47.225, -1.567},
+	{"Nantong", 32.249, 120.937},
+	{"Nanyang", 32.914, 112.733},
+	{"Naples", 26.219, -81.763},
+	{"Naples", 40.879, 14.352},
+	{"Narathiwat", 6.422, 101.813},
+	{"Narayanganj", 23.643, 90.620},
+	{"Narva", 59.376, 28.176},
+	{"Nashville", 36.124, -86.744},
+	{"Nasik", 20.018, 73.928},
+	{"Nassau", 25.045, -77.336},
+	{"Natal", -5.812, -35.272},
+	{"National City", 32.725, -117.020},
+	{"Navajoa", 27.079, -109.446},
+	{"Navoi", 40.124, 65.363},
+	{"Nawabganj", 24.688, 88.158},
+	{"Nawabshah", 26.080, 68.421},
+	{"Naxcivan", 39.205, 45.408},
+	{"Naypyidaw", 19.721, 96.206},
+	{"Nazareth", 32.713, 35.313},
+	{"Nazret", 8.607, 39.195},
+	{"Ndjamena", 12.120, 15.079},
+	{"Ndola", -12.977, 28.634},
+	{"Necochea", -38.554, -58.729},
+	{"Neftekamsk", 56.098, 54.259},
+	{"Neijiang", 29.804, 105.115},
+	{"Neiva", 2.957, -75.273},
+	{"Nekemte", 9.076, 36.544},
+	{"Nellore", 14.565, 79.925},
+	{"Nelspruit", -25.477, 30.978},
+	{"Nenjiang", 49.169, 125.231},
+	{"Nepalganj", 27.999, 81.526},
+	{"Neuquen", -38.945, -68.127},
+	{"Nevinnomyssk", 44.620, 41.926},
+	{"New Albany", 38.322, -85.790},
+	{"New Bedford", 41.650, -70.941},
+	{"New Delhi", 28.383, 77.273},
+	{"New Haven", 41.332, -72.975},
+	{"New London", 41.352, -72.133},
+	{"New Orleans", 29.944, -90.094},
+	{"New York", 40.813, -73.816},
+	{"Newark", 40.546, -74.286},
+	{"Newcastle", 11.007, 75.049},
+	{"Neyshabur", 36.202, 58.793},
+	{"Nezahualcoyotl", 19.391, -98.983},
+	{"Nguru", 12.883, 10.450},
+	{"Nha Trang", 12.256, 109.166},
+	{"Niagara Falls", 43.105, -79.027},
+	{"Niamey", 13.523, 2.126},
+	{"Nice", 43.651, 7.086},
+	{"Nicosia", 35.150, 33.352},
+	{"Niigata", 37.885, 139.072},
+	{"Nikopol", 47.584, 34.368},
+	{"Nimes", 43.836, 4.359},
+	{"Ninde", 26.687, 119.554},
+	{"Ningan", 44.347, 129.462},
+	{"Ningbo", 29.844, 121.521},
+	{"Ninh Binh", 20.237, 105.983},
+	{"Nis", 43.324, 21.908},
+	{"Niteroi", -22.836, -42.997},
+	{"Niyala", 12.053, 24.880},
+	{"Nizamabad", 18.700, 78.109},
+	{"Nizhnekamsk", 55.627, 51.875},
+	{"Nizhny Novgorod", 56.295, 43.887},
+	{"Nizhny Tagil", 57.928, 60.015},
+	{"Nizhnyaya Tura", 58.639, 59.823},
+	{"Nizhyn", 51.038, 31.883},
+	{"Nizwa", 22.941, 57.536},
+	{"Nkawkaw", 6.555, -0.776},
+	{"Nkhotakota", -12.749, 34.201},
+	{"Nkongsamba", 4.962, 9.938},
+	{"Nogales", 31.326, -110.946},
+	{"Noginsk", 55.832, 38.447},
+	{"Nong Khai", 17.879, 102.736},
+	{"Nongan", 44.424, 125.130},
+	{"Nonthaburi", 13.810, 100.409},
+	{"Norfolk", 36.826, -76.238},
+	{"Norilsk", 69.351, 88.173},
+	{"Norrkoping", 58.603, 16.182},
+	{"Norwich", 52.642, 1.276},
+	{"Nottingham", 53.018, -1.237},
+	{"Nouakchott", 18.093, -15.960},
+	{"Noumea", -22.251, 166.453},
+	{"Nova Friburgo", -22.271, -42.528},
+	{"Nova Iguacu", -22.825, -43.554},
+	{"Novara", 45.466, 8.634},
+	{"Novi Sad", 45.254, 19.810},
+	{"Novo Hamburgo", -29.711, -51.119},
+	{"Novoaltaysk", 53.415, 83.936},
+	{"Novocherkassk", 47.450, 40.090},
+	{"Novokuybishevsk", 53.099, 49.933},
+	{"Novokuznetsk", 53.772, 87.164},
+	{"Novorossiysk", 44.740, 37.752},
+	{"Novoshakhtinsk", 47.750, 39.934},
+	{"Novosibirsk", 55.020, 82.922},
+	{"Novotroitsk", 51.214, 58.331},
+	{"Nowy Sacz", 49.595, 20.668},
+	{"Nsukka", 6.925, 7.481},
+	{"Nuevo Casas Grandes", 30.416, -107.905},
+	{"Nuevo Laredo", 27.488, -99.557},
+	{"Nukus", 42.469, 59.602},
+	{"Nurnberg", 49.437, 11.099},
+	{"Nusaybin", 37.074, 41.230},
+	{"Nyanza", -2.323, 29.729},
+	{"Nyiregyhaza", 47.960, 21.742},
+	{"Nzerekore", 7.761, -8.816},
+	{"Oakland", 37.741, -122.163},
+	{"Oaxaca", 17.087, -96.751},
+	{"Obihiro", 42.919, 143.182},
+	{"Obuasi", 6.197, -1.671},
+	{"Ocala", 29.178, -82.112},
+	{"Ocana", 8.248, -73.349},
+	{"Oceanside", 33.154, -117.232},
+	{"Ocumare del Tuy", 10.126, -66.785},
+	{"Odense", 55.389, 10.385},
+	{"Odessa", 31.876, -102.370},
+	{"Odessa", 46.486, 30.706},
+	{"Ogbomosho", 8.090, 4.238},
+	{"Ogden", 41.158, -111.986},
+	{"Oita", 33.226, 131.646},
+	{"Okara", 30.842, 73.454},
+	{"Okayama", 34.618, 133.856},
+	{"Oklahoma City", 35.494, -97.526},
+	{"Oktyabrskiy", 54.485, 53.481},
+	{"Oldenburg", 53.145, 8.199},
+	{"Olinda", -7.961, -34.880},
+	{"Olmaliq", 40.852, 69.587},
+	{"Olomouc", 49.600, 17.251},
+	{"Olongapo", 14.929, 120.186},
+	{"Olsztyn", 53.779, 20.486},
+	{"Olympia", 47.025, -122.852},
+	{"Omaha", 41.236, -96.035},
+	{"Omdurman", 15.673, 32.471},
+	{"Omsk", 54.992, 73.355},
+	{"Ondo", 7.096, 4.857},
+	{"Ongjin", 37.935, 125.387},
+	{"Ongole", 15.532, 80.049},
+	{"Onitsha", 6.028, 6.837},
+	{"Oostanay", 53.213, 63.628},
+	{"Opole", 50.682, 17.923},
+	{"Oradea", 47.070, 21.915},
+	{"Oral", 51.218, 51.379},
+	{"Oran", 35.732, -0.498},
+	{"Oranjestad", 12.531, -70.017},
+	{"Ordu", 40.931, 37.853},
+	{"Orebro", 59.272, 15.197},
+	{"Orekhovo-Zuevo", 55.811, 38.976},
+	{"Orel", 52.966, 36.079},
+	{"Orenburg", 51.800, 55.114},
+	{"Orizaba", 18.859, -97.120},
+	{"Orlando", 28.568, -81.377},
+	{"Orleans", 47.895, 1.908},
+	{"Orlu", 5.791, 7.049},
+	{"Ormac", 11.049, 124.609},
+	{"Orsha", 54.511, 30.405},
+	{"Orsk", 51.216, 58.620},
+	{"Orumiyeh", 37.558, 45.065},
+	{"Oruro", -17.966, -67.105},
+	{"Osaka", 34.677, 135.475},
+	{"Osh", 40.547, 72.795},
+	{"Oshawa", 43.886, -78.926},
+	{"Oshkosh", 44.023, -88.564},
+	{"Oshogbo", 7.713, 4.495},
+	{"Osijek", 45.556, 18.663},
+	{"Oskemen", 49.973, 82.632},
+	{"Oslo", 59.906, 10.757},
+	{"OsnabrÌùck", 52.274, 8.040},
+	{"Osorno", -40.580, -73.142},
+	{"Ostrava", 49.836, 18.320},
+	{"Otaru", 43.192, 141.004},
+	{"Otsu", 35.036, 135.939},
+	{"Ottawa", 45.405, -75.718},
+	{"Oturkpo", 7.197, 8.150},
+	{"Ouagadougou", 12.366, -1.522},
+	{"Ouahigouya", 13.574, -2.420},
+	{"Ouargla", 31.949, 5.338},
+	{"Ouezzane", 34.826, -5.561},
+	{"Ouidah", 6.561, 1.954},
+	{"Oujda", 34.690, -1.905},
+	{"Oulu", 65.045, 25.469},
+	{"Ourense", 42.343, -7.875},
+	{"Ourinhos", -22.976, -49.876},
+	{"Ovalle", -30.597, -71.197},
+	{"Oviedo", 43.388, -5.807},
+	{"Owensboro", 37.754, -87.108},
+	{"Owo", 7.188, 5.592},
+	{"Oxford", 51.745, -1.255},
+	{"Oyo", 7.889, 3.999},
+	{"Ozamis", 8.158, 123.836},
+	{"Paarl", -33.709, 18.975},
+	{"Pabna", 24.232, 89.353},
+	{"Pachuca", 20.097, -98.748},
+	{"Padang", -0.920, 100.390},
+	{"Padangsidempuan", 1.378, 99.273},
+	{"Pagadian", 7.855, 123.474},
+	{"Pakalongan", -6.929, 109.572},
+	{"Pakanbaru", 0.560, 101.449},
+	{"Pakokku", 21.350, 95.095},
+	{"Pakxe", 15.124, 105.814},
+	{"Palangkaraya", -2.208, 113.912},
+	{"Palembang", -2.958, 104.756},
+	{"Palermo", 38.124, 13.321},
+	{"Pali", 25.783, 73.328},
+	{"Palm Springs", 33.752, -116.360},
+	{"Palma", 39.587, 2.649},
+	{"Palma Soriano", 20.223, -75.999},
+	{"Palmerston North", -40.353, 175.607},
+	{"Palu", -1.021, 119.891},
+	{"Pamplona", 7.380, -72.652},
+	{"Pamplona", 42.809, -1.644},
+	{"Panama City", 9.036, -79.495},
+	{"Panama City", 30.184, -85.639},
+	{"Panevezy", 55.736, 24.355},
+	{"Pangkalpinang", -2.165, 106.130},
+	{"Panipat", 29.301, 76.865},
+	{"Panshi", 42.948, 126.051},
+	{"Panzhihua", 26.565, 101.725},
+	{"Papeete", -17.559, -149.578},
+	{"Parachinar", 33.888, 70.107},
+	{"Parakou", 9.345, 2.624},
+	{"Paramaribo", 5.826, -55.189},
+	{"Parana", -31.744, -60.509},
+	{"Paranagua", -25.539, -48.539},
+	{"Parbhani", 19.277, 76.775},
+	{"Pardubice", 50.037, 15.766},
+	{"Parepare", -4.032, 119.634},
+	{"Paris", 48.839, 2.352},
+	{"Parkersburg", 39.278, -81.552},
+	{"Parma", 44.804, 10.335},
+	{"Parnaiba", -2.913, -41.766},
+	{"Pasadena", 29.614, -95.186},
+	{"Pasadena", 34.161, -118.207},
+	{"Pasay City", 14.364, 121.018},
+	{"Passo Fundo", -28.259, -52.411},
+	{"Passos", -20.722, -46.607},
+	{"Pasto", 1.209, -77.277},
+	{"Pasuruan", -7.650, 112.801},
+	{"Paterson", 40.967, -74.200},
+	{"Pathankot", 32.205, 75.580},
+	{"Pathein", 16.776, 94.726},
+	{"Pathum Thani", 14.000, 100.641},
+	{"Pati", -6.908, 111.120},
+	{"Patiala", 30.153, 76.165},
+	{"Patna", 25.212, 85.223},
+	{"Patos", -7.015, -37.275},
+	{"Patra", 38.239, 21.754},
+	{"Pavlodar", 52.276, 76.985},
+	{"Paysandu", -32.315, -58.077},
+	{"Pec", 42.670, 20.327},
+	{"Pec", 43.896, 20.362},
+	{"Pecs", 46.070, 18.235},
+	{"Pelotas", -31.740, -52.339},
+	{"Pematangsiantar", 2.977, 99.088},
+	{"Pemba", -12.992, 40.539},
+	{"Pensacola", 30.475, -87.256},
+	{"Penza", 53.207, 44.992},
+	{"Peoria", 40.705, -89.603},
+	{"Perabumulih", -3.423, 104.244},
+	{"Pereira", 4.819, -75.696},
+	{"Pergamino", -33.895, -60.572},
+	{"Perm", 57.996, 56.193},
+	{"Pernik", 42.601, 23.070},
+	{"Perpignan", 42.697, 2.880},
+	{"Perth", -31.940, 115.884},
+	{"Perugia", 43.097, 12.390},
+	{"Pervouralsk", 56.905, 59.963},
+	{"Pescara", 42.442, 14.173},
+	{"Peshawar", 34.147, 71.791},
+	{"Peterborough", 44.306, -78.325},
+	{"Petersburg", 37.286, -77.420},
+	{"Petrolina", -9.393, -40.502},
+	{"Petropavlovsk", 54.873, 69.148},
+	{"Petropavlovsk Kamchatskiy", 53.038, 158.649},
+	{"Petropolis", -22.479, -43.171},
+	{"Petrozavodsk", 61.802, 34.325},
+	{"Phan Rang", 11.581, 108.982},
+	{"Phan Thiet", 10.893, 107.994},
+	{"Phetchaburi", 13.126, 99.958},
+	{"Philadelphia", 40.009, -75.157},
+	{"Phitsanulok", 16.822, 100.260},
+	{"Phnom Penh", 11.488, 104.786},
+	{"Phoenix", 33.487, -112.036},
+	{"Phrae", 18.105, 100.131},
+	{"Phuket", 7.877, 98.382},
+	{"Phyarpon", 16.295, 95.682},
+	{"Piedras Negras", 28.677, -100.544},
+	{"Pietermaritzburg", -29.613, 30.365},
+	{"Pilibhit", 28.677, 79.819},
+	{"Pinar del Rio", 22.417, -83.688},
+	{"Pindamonhangaba", -22.934, -45.438},
+	{"Pingdingshan", 33.718, 113.274},
+	{"Pingdu", 37.034, 119.963},
+	{"Pingliang", 35.534, 106.702},
+	{"Pingtung", 22.670, 120.507},
+	{"Pingxiang", 27.659, 113.833},
+	{"Pingyi", 35.495, 117.582},
+	{"Pinrang", -3.789, 119.603},
+	{"Pinsk", 52.126, 26.101},
+	{"Piracicaba", -22.724, -47.642},
+	{"PiraiÌ©vs", 37.997, 23.629},
+	{"Pirapora", -17.347, -44.936},
+	{"Pisa", 43.692, 10.509},
+	{"Pisco", -13.711, -76.206},
+	{"Pitesti", 44.849, 24.879},
+	{"Pittsburgh", 40.446, -79.972},
+	{"Piura", -5.193, -80.638},
+	{"Pizen", 49.740, 13.386},
+	{"Play Ku", 13.994, 108.008},
+	{"Pleven", 43.423, 24.613},
+	{"Plock", 52.566, 19.699},
+	{"Ploiesti", 44.917, 26.026},
+	{"Plovdiv", 42.143, 24.756},
+	{"Plymouth", 50.393, -4.120},
+	{"Pocatello", 42.891, -112.451},
+	{"Pocos de Caldas", -21.808, -46.556},
+	{"Podgorica", 42.442, 19.263},
+	{"Podolsk", 55.456, 37.546},
+	{"Pohang", 36.027, 129.373},
+	{"Pointe-Noire", -4.788, 11.885},
+	{"Pointe-a-Pitre", 16.250, -61.533},
+	{"Poitier", 46.581, 0.339},
+	{"Polatli", 39.580, 32.142},
+	{"Polatsk", 55.488, 28.785},
+	{"Polokwane", -23.890, 29.453},
+	{"Poltava", 49.599, 34.536},
+	{"Ponce", 18.022, -66.626},
+	{"Pondicherry", 11.982, 79.563},
+	{"Ponta Delgada", 37.756, -25.662},
+	{"Ponta Grossa", -25.089, -50.157},
+	{"Ponta Pora", -22.535, -55.718},
+	{"Pontiac", 42.593, -83.278},
+	{"Pontianak", -0.059, 109.344},
+	{"Popayan", 2.458, -76.599},
+	{"Porbandar", 21.665, 69.628},
+	{"Pori", 61.475, 21.820},
+	{"Porlamar", 10.995, -63.843},
+	{"Port Arthur", 29.913, -93.928},
+	{"Port Blair", 11.638, 92.736},
+	{"Port Charlotte", 26.995, -82.109},
+	{"Port Elizabeth", -33.928, 25.561},
+	{"Port Harcourt", 4.851, 7.122},
+	{"Port Louis", -20.222, 57.492},
+	{"Port Moresby", -9.433, 147.185},
+	{"Port-Gentil", -0.724, 8.777},
+	{"Port-au-Prince", 18.569, -72.222},
+	{"Port-of-Spain", 10.639, -61.383},
+	{"Portimao", 37.126, -8.517},
+	{"Portland", 43.655, -70.306},
+	{"Portland", 45.475, -122.695},
+	{"Porto", 41.194, -8.501},
+	{"Porto Alegre", -29.969, -51.127},
+	{"Porto Santana", -0.040, -51.168},
+	{"Porto Uniao", -26.237, -51.080},
+	{"Porto Velho", -8.764, -63.870},
+	{"Porto-Novo", 6.521, 2.708},
+	{"Portoviejo", -1.053, -80.464},
+	{"Portsmouth", 50.863, -1.143},
+	{"Posadas", -27.412, -55.901},
+	{"Potchefstroom", -26.709, 27.086},
+	{"Potiskum", 11.716, 11.091},
+	{"Potosi", -19.582, -65.755},
+	{"Potsdam", 52.401, 13.123},
+	{"Poughkeepsie", 41.626, -73.889},
+	{"Pouso Alegre", -22.238, -45.922},
+	{"Poza Rica de Hidalgo", 20.524, -97.459},
+	{"Poznan", 52.407, 16.915},
+	{"Prachin Buri", 14.065, 101.375},
+	{"Prague", 50.073, 14.446},
+	{"Praia", 14.938, -23.515},
+	{"Praya", -8.684, 116.426},
+	{"Prescott", 34.561, -112.471},
+	{"Presidencia Roque Saenz Pena", -26.788, -60.440},
+	{"Presidente Prudente", -22.113, -51.412},
+	{"Presov", 48.994, 21.252},
+	{"Pretoria", -25.756, 28.215},
+	{"Prijedor", 44.979, 16.715},
+	{"Prince George", 53.916, -122.780},
+	{"Pristina", 42.666, 21.146},
+	{"Prizren", 42.222, 20.734},
+	{"Probolinggo", -7.891, 113.201},
+	{"Proddatur", 14.762, 78.570},
+	{"Prokopyevsk", 53.914, 86.712},
+	{"Providence", 41.840, -71.392},
+	{"Provo", 40.338, -111.739},
+	{"Przemysl", 49.793, 22.782},
+	{"Pskov", 57.821, 28.336},
+	{"Pucallpa", -8.381, -74.565},
+	{"Puebla", 19.072, -98.192},
+	{"Pueblo", 38.265, -104.615},
+	{"Puerto Ayacucho", 5.653, -67.603},
+	{"Puerto Barrios", 15.717, -88.588},
+	{"Puerto Limon", 9.989, -83.041},
+	{"Puerto Madryn", -42.764, -65.044},
+	{"Puerto Maldonado", -12.598, -69.204},
+	{"Puerto Montt", -41.467, -72.953},
+	{"Puerto Princesa", 9.760, 118.748},
+	{"Puerto Vallarta", 20.661, -105.230},
+	{"Puerto la Cruz", 10.183, -64.647},
+	{"Pula", 44.868, 13.852},
+	{"Pune", 18.542, 74.109},
+	{"Puno", -15.835, -70.028},
+	{"Punta Alta", -38.876, -62.077},
+	{"Punta Arenas", -53.146, -70.915},
+	{"Puntarenas", 9.980, -84.767},
+	{"Punto Fijo", 11.691, -70.197},
+	{"Puqi", 29.744, 113.917},
+	{"Puri", 19.984, 85.869},
+	{"Purnia", 25.747, 87.651},
+	{"Putian", 25.361, 118.961},
+	{"Putrajaya", 2.916, 101.717},
+	{"Puyang", 35.762, 115.018},
+	{"Pyay", 18.823, 95.231},
+	{"Pyongsan", 38.334, 126.403},
+	{"Pyongyang", 38.997, 125.742},
+	{"Qairouan", 35.673, 10.093},
+	{"Qal at Bishah", 20.015, 42.611},
+	{"Qaraghandy", 49.808, 73.099},
+	{"Qarshi", 38.855, 65.762},
+	{"Qasserine", 35.170, 8.825},
+	{"Qazvin", 36.273, 50.015},
+	{"Qena", 26.140, 32.741},
+	{"Qingan", 46.877, 127.484},
+	{"Qingdao", 36.352, 120.319},
+	{"Qinggang", 46.688, 126.096},
+	{"Qingyuan", 23.662, 112.971},
+	{"Qinhuangdao", 39.850, 119.291},
+	{"Qinzhou", 21.958, 108.673},
+	{"Qiqihar", 47.339, 123.970},
+	{"Qitaihe", 45.795, 130.972},
+	{"Qom", 34.638, 50.870},
+	{"Qomsheh", 32.006, 51.859},
+	{"Qoqon", 40.499, 71.036},
+	{"Quang Ngai", 15.120, 108.790},
+	{"Quang Tri", 16.734, 107.193},
+	{"Quanzhou", 24.844, 118.570},
+	{"Quchan", 37.103, 58.514},
+	{"Quebec", 46.809, -71.280},
+	{"Queenstown", -31.902, 26.875},
+	{"Quelimane", -17.862, 36.896},
+	{"Queretaro", 20.603, -100.411},
+	{"Quetta", 30.185, 66.997},
+	{"Quetzaltenango", 14.901, -91.475},
+	{"Quezon City", 14.911, 120.958},
+	{"Qui Nhon", 13.877, 109.086},
+	{"Quibdo", 5.688, -76.647},
+	{"Quillacollo", -17.375, -66.274},
+	{"Quillota", -32.889, -71.247},
+	{"Quilon", 9.011, 76.732},
+	{"Quito", -0.198, -78.460},
+	{"Qunghirot", 43.051, 58.864},
+	{"Qurghonteppa", 37.813, 68.805},
+	{"Quzhou", 28.992, 118.965},
+	{"Qyzylorda", 44.855, 65.497},
+	{"Raba", -8.506, 118.805},
+	{"Rabat", 33.913, -6.875},
+	{"Rach Gia", 10.174, 105.106},
+	{"Racine", 42.736, -87.827},
+	{"Radom", 51.394, 21.160},
+	{"Rafaela", -31.253, -61.493},
+	{"Rafha", 29.629, 43.514},
+	{"Ragusa", 36.914, 14.708},
+	{"Rahimyar Khan", 28.690, 70.529},
+	{"Raichur", 16.221, 77.363},
+	{"Raipur", 21.180, 81.481},
+	{"Rajahmundry", 16.918, 81.616},
+	{"Rajapalaiyam", 9.459, 77.580},
+	{"Rajkot", 22.301, 70.803},
+	{"Rajshahi", 24.627, 88.830},
+	{"Raleigh", 35.811, -78.683},
+	{"Rampur", 28.855, 78.990},
+	{"Rancagua", -34.166, -70.729},
+	{"Ranchi", 23.336, 85.184},
+	{"Rangoon", 16.859, 96.145},
+	{"Rangpur", 25.577, 89.369},
+	{"Rapid City", 44.073, -103.227},
+	{"Ras al Khaymah", 25.780, 55.961},
+	{"Rashid", 31.418, 30.413},
+	{"Rasht", 37.287, 49.600},
+	{"Ratchaburi", 13.553, 99.820},
+	{"Ratlam", 23.355, 75.054},
+	{"Raurkela", 22.238, 84.842},
+	{"Ravenna", 44.437, 12.224},
+	{"Rawalpindi", 33.383, 72.989},
+	{"Reading", 51.349, -0.779},
+	{"Recife", -8.059, -34.955},
+	{"Reconquista", -29.143, -59.655},
+	{"Red Deer", 52.271, -113.807},
+	{"Redding", 40.576, -122.368},
+	{"Regensburg", 49.012, 12.120},
+	{"Reggio di Calabria", 38.120, 15.658},
+	{"Registro", -24.500, -47.849},
+	{"Reims", 49.247, 4.031},
+	{"Rennes", 48.118, -1.673},
+	{"Reno", 39.524, -119.792},
+	{"Resistencia", -27.453, -58.983},
+	{"Resita", 45.307, 21.892},
+	{"Reykjavik", 64.116, -21.883},
+	{"Reynosa", 26.058, -98.293},
+	{"Ribeirao Preto", -21.174, -47.795},
+	{"Richmond", 37.511, -77.493},
+	{"Ridder", 50.350, 83.518},
+	{"Riga", 56.954, 24.128},
+	{"Rijeka", 45.353, 14.389},
+	{"Rio Claro", -22.399, -47.565},
+	{"Rio Cuarto", -33.124, -64.347},
+	{"Rio Gallegos", -51.629, -69.251},
+	{"Rio Grande", -32.069, -52.155},
+	{"Rio Largo", -9.510, -35.859},
+	{"Rio Negro", -26.115, -49.802},
+	{"Rio Verde", 21.935, -100.013},
+	{"Rio de Janeiro", -22.856, -43.408},
+	{"Riobamba", -1.666, -78.657},
+	{"Riohacha", 11.533, -72.909},
+	{"Rivera", -30.900, -55.542},
+	{"Riverside", 33.991, -117.508},
+	{"Rivne", 50.629, 26.248},
+	{"Riyadh", 24.679, 46.740},
+	{"Rize", 40.975, 40.459},
+	{"Rizhao", 35.251, 119.285},
+	{"Roanne", 46.037, 4.056},
+	{"Roanoke", 37.285, -79.976},
+	{"Rochester", 43.151, -77.589},
+	{"Rochester", 44.031, -92.479},
+	{"Rock Hill", 34.945, -81.030},
+	{"Rock Island", 41.498, -90.500},
+	{"Rockford", 42.300, -89.043},
+	{"Rockhampton", -23.366, 150.517},
+	{"Rocky Mount", 35.958, -77.816},
+	{"Rodos", 36.430, 28.215},
+	{"Rohtak", 28.935, 76.551},
+	{"Rome", 41.864, 12.561},
+	{"Rondonopolis", -16.456, -54.636},
+	{"Rosario", -32.937, -60.707},
+	{"Rosenheim", 47.851, 12.109},
+	{"Roslavl", 53.952, 32.864},
+	{"Rostock", 54.111, 12.109},
+	{"Rostov", 47.262, 39.725},
+	{"Rotterdam", 51.925, 4.543},
+	{"Rouen", 49.426, 1.079},
+	{"Roxas", 11.587, 122.749},
+	{"Rubtsovsk", 51.523, 81.204},
+	{"Rudny", 52.970, 63.120},
+	{"Ruhengeri", -1.565, 29.645},
+	{"Ruse", 43.872, 25.974},
+	{"Rustavi", 41.549, 45.011},
+	{"Rustenburg", -25.636, 27.208},
+	{"Ruteng", -8.593, 120.459},
+	{"Ryazan", 54.621, 39.710},
+	{"Rybinsk", 58.060, 38.813},
+	{"Rzeszow", 50.033, 21.978},
+	{"Rzhev", 56.259, 34.324},
+	{"Saarbrucken", 49.253, 6.948},
+	{"Sabanalarga", 10.647, -74.920},
+	{"Sabha", 27.043, 14.426},
+	{"Sabzewar", 36.203, 57.682},
+	{"Sacramento", 38.618, -121.353},
+	{"Sadah", 16.963, 43.714},
+	{"Sadiqabad", 28.339, 70.031},
+	{"Safi", 32.299, -9.227},
+	{"Sagar", 23.846, 78.754},
+	{"Saginaw", 43.428, -83.959},
+	{"Saginaw", 43.607, -83.892},
+	{"Saharanpur", 29.844, 77.572},
+	{"Sahiwal", 30.531, 72.882},
+	{"Saida", 33.510, 35.424},
+	{"Saida", 34.849, 0.155},
+	{"Saidpur", 25.693, 88.731},
+	{"Saidu", 34.839, 72.331},
+	{"Saint-Etienne", 45.437, 4.369},
+	{"Saint-Louis", 16.022, -16.484},
+	{"Sakata", 38.924, 139.841},
+	{"Sakhon Nakhon", 17.182, 104.119},
+	{"Saki", 41.193, 47.174},
+	{"Salalah", 17.026, 54.101},
+	{"Salamanca", 20.571, -101.194},
+	{"Salamanca", 40.959, -5.654},
+	{"Salatiga", -7.342, 110.498},
+	{"Salavat", 53.382, 55.916},
+	{"Salem", 11.638, 78.049},
+	{"Salem", 42.534, -70.942},
+	{"Salem", 44.942, -123.020},
+	{"Salerno", 40.729, 14.723},
+	{"Salima", -13.764, 34.405},
+	{"Salina Cruz", 16.199, -95.197},
+	{"Salinas", 36.688, -121.640},
+	{"Salt Lake City", 40.744, -111.926},
+	{"Salta", -24.798, -65.413},
+	{"Saltillo", 25.433, -100.979},
+	{"Salto", -31.390, -57.964},
+	{"Salvador", -12.903, -38.415},
+	{"Salzburg", 47.805, 13.022},
+	{"Samalut", 28.272, 30.704},
+	{"Samandagi", 36.103, 35.985},
+	{"Samara", 53.186, 50.144},
+	{"Samarinda", -0.513, 117.153},
+	{"Samarqand", 39.659, 67.000},
+	{"Sambalpur", 21.480, 83.973},
+	{"Sampit", -2.532, 112.957},
+	{"Samsun", 41.245, 36.606},
+	{"Samut Prakan", 13.676, 100.704},
+	{"San Angelo", 31.454, -100.452},
+	{"San Antonio", -33.562, -71.606},
+	{"San Antonio", 29.482, -98.503},
+	{"San Bernardino", 34.064, -117.342},
+	{"San Bernardo", -33.591, -70.711},
+	{"San Carlos de Bariloche", -41.147, -71.329},
+	{"San Carlos del Zulia", 8.996, -71.910},
+	{"San Cristobal", 7.799, -72.237},
+	{"San Cristobal de Las Casas", 16.757, -92.658},
+	{"San Diego", 32.900, -117.134},
+	{"San Fernando", -34.585, -70.981},
+	{"San Fernando", 10.314, -61.455},
+	{"San Fernando de Apure", 7.870, -67.482},
+	{"San Francisco", 37.622, -122.301},
+	{"San Francisco de Macoris", 19.302, -70.267},
+	{"San Jose", 9.959, -84.112},
+	{"San Jose", 37.361, -121.945},
+	{"San Juan", -31.525, -68.519},
+	{"San Juan", 18.293, -66.085},
+	{"San Juan del Rio", 20.409, -99.987},
+	{"San Lorenzo", -25.336, -57.496},
+	{"San Luis", -33.296, -66.335},
+	{"San Luis Obispo", 35.269, -120.662},
+	{"San Luis Potosi", 22.161, -100.968},
+	{"San Marcos", 29.879, -97.940},
+	{"San Martin", -33.071, -68.507},
+	{"San Mateo", 37.496, -122.251},
+	{"San Miguel", 13.478, -88.165},
+	{"San Miguel de Tucuman", -26.824, -65.219},
+	{"San Nicolas", -33.356, -60.215},
+	{"San Pablo", 14.070, 121.325},
+	{"San Pedro", -24.232, -64.865},
+	{"San Pedro Sula", 15.515, -87.996},
+	{"San Pedro de Macoris", 18.468, -69.305},
+	{"San Pedro de las Colonias", 25.759, -102.982},
+	{"San Ramon de la Nueva Oran", -23.137, -64.319},
+	{"San Salvador", 13.739, -89.176},
+	{"San Salvador de Jujuy", -24.202, -65.287},
+	{"San SebastiÌÁn", 43.283, -1.970},
+	{"San-Pedro", 4.761, -6.651},
+	{"Sanaa", 15.376, 44.207},
+	{"Sanandaj", 35.303, 46.995},
+	{"Sancti Spiritus", 21.931, -79.442},
+	{"Sandakan", 5.866, 118.078},
+	{"Sanford", 28.714, -81.305},
+	{"Sangli", 16.823, 74.583},
+	{"Sangolqui", -0.320, -78.452},
+	{"Sanliurfa", 37.161, 38.807},
+	{"Santa Ana", 13.998, -89.541},
+	{"Santa Barbara", 34.433, -119.753},
+	{"Santa Clara", 22.407, -79.978},
+	{"Santa Cruz de Tenerife", 28.464, -16.301},
+	{"Santa Cruz do Sul", -29.735, -52.430},
+	{"Santa Fe", -31.607, -60.710},
+	{"Santa Fe", 35.658, -105.977},
+	{"Santa Maria", -29.700, -53.793},
+	{"Santa Maria", 34.920, -120.434},
+	{"Santa Marta", 11.236, -74.183},
+	{"Santa Rosa", -36.622, -64.289},
+	{"Santa Rosa", -27.864, -54.469},
+	{"Santa Rosa", 38.422, -122.709},
+	{"Santana do Livramento", -30.879, -55.518},
+	{"Santander", 43.438, -3.852},
+	{"Santarem", -2.446, -54.720},
+	{"Santiago", -33.462, -70.661},
+	{"Santiago", 19.474, -70.707},
+	{"Santiago de Compostela", 42.891, -8.532},
+	{"Santiago de Cuba", 20.027, -75.826},
+	{"Santiago del Estero", -27.780, -64.262},
+	{"Santo Andre", -23.577, -46.432},
+	{"Santo Domingo", 18.467, -69.981},
+	{"Santo angelo", -28.297, -54.258},
+	{"Santos", -23.947, -46.367},
+	{"Sanya", 18.267, 109.507},
+	{"Sao Carlos", -22.006, -47.889},
+	{"Sao Joao da Boa Vista", -21.977, -46.794},
+	{"Sao Joao del Rei", -21.126, -44.245},
+	{"Sao Jose de Ribamar", -2.546, -44.069},
+	{"Sao Jose do Rio Preto", -20.814, -49.376},
+	{"Sao Jose dos Campos", -23.227, -45.895},
+	{"Sao Jose dos Pinhais", -25.502, -49.169},
+	{"Sao Paolo", -23.559, -46.651},
+	{"Sao Tome", 0.338, 6.719},
+	{"Sapele", 5.965, 5.711},
+	{"Sapporo", 43.076, 141.372},
+	{"Saraburi", 14.528, 100.915},
+	{"Sarajevo", 43.846, 18.351},
+	{"Saransk", 54.193, 45.183},
+	{"Sarapul", 56.466, 53.784},
+	{"Sarasota", 27.326, -82.506},
+	{"Saratoga Springs", 43.075, -73.801},
+	{"Saratov", 51.557, 45.967},
+	{"Sargodha", 32.061, 72.818},
+	{"Sarh", 9.144, 18.386},
+	{"Sari", 36.570, 53.066},
+	{"Sarnia", 42.960, -82.438},
+	{"Saseba", 33.162, 129.749},
+	{"Saskatoon", 52.136, -106.655},
+	{"Sassari", 40.733, 8.551},
+	{"Sault Ste. Marie", 46.515, -84.333},
+	{"Savannah", 32.044, -81.119},
+	{"Savannakhet", 16.570, 104.764},
+	{"Saveh", 35.027, 50.357},
+	{"Sawahlunto", -0.693, 100.790},
+	{"Sayanogorsk", 53.087, 91.404},
+	{"Saywun", 15.945, 48.791},
+	{"Scarborough", 54.268, -0.415},
+	{"Schenectady", 42.809, -73.928},
+	{"Scranton", 41.414, -75.661},
+	{"Seattle", 47.519, -122.249},
+	{"Segou", 13.434, -6.266},
+	{"Sekondi", 4.925, -1.765},
+	{"Semarang", -7.066, 110.642},
+	{"Semey", 50.415, 80.244},
+	{"Semnan", 35.570, 53.387},
+	{"Sendai", 38.272, 140.913},
+	{"Sennar", 13.537, 33.609},
+	{"Seoul", 37.486, 126.971},
+	{"Serang", -6.145, 106.268},
+	{"Seremban", 2.710, 101.944},
+	{"Sergiyev Posad", 56.296, 38.141},
+	{"Serov", 59.611, 60.591},
+	{"Serpukhov", 54.921, 37.413},
+	{"Serrinha", -11.656, -38.993},
+	{"Sete Lagoas", -19.454, -44.230},
+	{"Setif", 36.186, 5.393},
+	{"Settat", 33.005, -7.613},
+	{"Setubal", 38.539, -8.861},
+	{"Sevastapol", 44.599, 33.532},
+	{"Severodvinsk", 64.566, 39.823},
+	{"Seville", 37.356, -5.972},
+	{"Sfax", 34.774, 10.749},
+	{"Shache", 38.423, 77.255},
+	{"Shadrinsk", 56.086, 63.647},
+	{"Shahjahanpur", 27.730, 79.986},
+	{"Shahrisabz", 39.047, 66.740},
+	{"Shahrud", 36.420, 54.970},
+	{"Shakhty", 47.713, 40.244},
+	{"Shanghai", 31.253, 121.054},
+	{"Shangqiu", 34.305, 115.521},
+	{"Shangrao", 28.402, 118.038},
+	{"Shangzhi", 45.219, 127.964},
+	{"Shantou", 23.280, 116.283},
+	{"Shanxian", 34.760, 116.206},
+	{"Shaoguan", 24.773, 113.571},
+	{"Shaoxing", 30.083, 120.671},
+	{"Sharjah", 25.327, 55.442},
+	{"Shashemene", 7.217, 38.617},
+	{"Shashi", 30.197, 112.218},
+	{"Shchekino", 54.010, 37.513},
+	{"Sheberghan", 36.669, 65.750},
+	{"Sheboygan", 43.745, -87.732},
+	{"Sheffield", 53.419, -1.374},
+	{"Sheikhu Pura", 31.656, 73.819},
+	{"Shendi", 16.686, 33.418},
+	{"Shenyeng", 41.765, 123.391},
+	{"Shenzhen", 22.704, 114.026},
+	{"Sherbrooke", 45.394, -71.923},
+	{"Shihezi", 44.333, 86.020},
+	{"Shijianzhuang", 38.096, 114.711},
+	{"Shillong", 25.497, 91.841},
+	{"Shimoga", 13.907, 75.649},
+	{"Shimonoseki", 34.000, 130.962},
+	{"Shinyanga", -3.665, 33.462},
+	{"Shiraz", 29.622, 52.519},
+	{"Shishou", 29.680, 112.474},
+	{"Shiyan", 32.613, 110.812},
+	{"Shizuishan", 39.239, 106.770},
+	{"Shizuoka", 34.994, 138.422},
+	{"Shkoder", 42.068, 19.514},
+	{"Sholapur", 17.668, 75.907},
+	{"Shostka", 51.876, 33.483},
+	{"Shouzhou", 39.350, 112.469},
+	{"Shreveport", 32.477, -93.738},
+	{"Shuangcheng", 45.377, 126.303},
+	{"Shulan", 44.414, 126.951},
+	{"Shumen", 43.273, 26.937},
+	{"Shuya", 56.851, 41.371},
+	{"Shuyang", 34.129, 118.729},
+	{"Shwebo", 22.578, 95.699},
+	{"Shymkent", 42.312, 69.628},
+	{"Sialkote", 32.441, 74.596},
+	{"Siauliai", 55.929, 23.309},
+	{"Sibiu", 45.790, 24.150},
+	{"Sibolga", 1.645, 98.850},
+	{"Sibu", 2.288, 111.845},
+	{"Sidi bel Abbes", 35.196, -0.621},
+	{"Siedlce", 52.170, 22.283},
+	{"Siem Reap", 13.375, 103.860},
+	{"Siguiri", 11.424, -9.174},
+	{"Sikar", 27.716, 75.152},
+	{"Sikasso", 11.314, -5.672},
+	{"Silchar", 24.752, 92.502},
+	{"Siliguri", 26.474, 88.403},
+	{"Simao", 22.780, 100.967},
+	{"Simferopol", 44.969, 34.085},
+	{"Sincelejo", 9.307, -75.391},
+	{"Singapore", 1.353, 103.822},
+	{"Singaraja", -8.112, 115.147},
+	{"Singkawang", 0.909, 108.990},
+	{"Sioux City", 42.496, -96.390},
+	{"Sioux Falls", 43.535, -96.738},
+	{"Siping", 43.165, 124.358},
+	{"Siracusa", 37.053, 15.280},
+	{"Sirsa", 29.560, 75.301},
+	{"Sisophon", 13.590, 102.963},
+	{"Sitapur", 27.633, 80.705},
+	{"Sittwe", 20.143, 92.881},
+	{"Sivas", 39.748, 37.007},
+	{"Skien", 59.158, 9.638},
+	{"Skikda", 36.871, 6.952},
+	{"Skopje", 42.007, 21.430},
+	{"Slavonski Brod", 45.149, 18.018},
+	{"Slidell", 30.281, -89.774},
+	{"Sliven", 42.667, 26.328},
+	{"Slupsk", 54.469, 17.020},
+	{"Smolensk", 54.792, 32.063},
+	{"Sobral", -3.685, -40.350},
+	{"Soc Trang", 9.556, 105.989},
+	{"Sochi", 43.603, 39.735},
+	{"Sodo", 6.969, 37.739},
+	{"Sofia", 42.682, 23.328},
+	{"Sogamoso", 5.717, -72.935},
+	{"Sohag", 26.576, 31.678},
+	{"Sokcho", 38.201, 128.574},
+	{"Soke", 37.734, 27.415},
+	{"Sokode", 8.982, 1.148},
+	{"Sokoto", 12.942, 5.239},
+	{"Soledad", 10.860, -74.788},
+	{"Solikamsk", 59.667, 56.736},
+	{"Son Tay", 21.076, 105.527},
+	{"Sonbong", 42.341, 130.391},
+	{"Songea", -10.677, 35.652},
+	{"Sonipat", 29.011, 77.074},
+	{"Sonsonate", 13.734, -89.742},
+	{"Sopur", 34.271, 74.461},
+	{"Sorocaba", -23.489, -47.455},
+	{"Sotik", -0.681, 35.153},
+	{"Soubre", 5.794, -6.581},
+	{"Souk Ahras", 36.288, 7.961},
+	{"Sousse", 35.834, 10.589},
+	{"South Bend", 41.706, -86.224},
+	{"Southampton", 50.922, -1.358},
+	{"Southaven", 34.991, -90.023},
+	{"Southend", 51.534, 0.525},
+	{"Soyo", -6.137, 12.369},
+	{"Spanish Town", 17.991, -76.946},
+	{"Spartanburg", 34.961, -81.953},
+	{"Split", 43.531, 16.454},
+	{"Spokane", 47.677, -117.345},
+	{"Spring Hill", 28.480, -82.547},
+	{"Springfield", 37.188, -93.287},
+	{"Springfield", 39.785, -89.658},
+	{"Springfield", 39.935, -83.801},
+	{"Springfield", 42.108, -72.562},
+	{"Springfield", 44.053, -122.979},
+	{"Springs", -26.266, 28.416},
+	{"Srinagar", 33.914, 74.886},
+	{"St. Augustine", 29.856, -81.315},
+	{"St. Charles", 38.625, -76.915},
+	{"St. Charles", 38.704, -90.552},
+	{"St. Cloud", 45.571, -94.188},
+	{"St. George", 37.107, -113.586},
+	{"St. John", 45.286, -66.055},
+	{"St. John‰Ûªs", 47.558, -52.762},
+	{"St. Joseph", 39.762, -94.826},
+	{"St. Louis", 38.613, -90.346},
+	{"St. Paul", 44.942, -93.055},
+	{"St. Petersburg", 27.871, -82.730},
+	{"St. Petersburg", 59.903, 30.357},
+	{"St.-Brieuc", 48.506, -2.747},
+	{"St.-Denis", -20.905, 55.475},
+	{"St.-Jerome", 45.799, -74.009},
+	{"Stamford", 41.085, -73.653},
+	{"Stara Zagora", 42.417, 25.631},
+	{"Starsy Oskol", 51.308, 37.840},
+	{"State College", 40.798, -77.854},
+	{"Stavanger", 58.956, 5.703},
+	{"Stavropol", 45.031, 41.963},
+	{"Stepanakert", 39.823, 46.754},
+	{"Sterlitamak", 53.652, 55.959},
+	{"Stockholm", 59.329, 18.045},
+	{"Stoke", 53.033, -2.185},
+	{"Stralsund", 54.301, 13.072},
+	{"Strasbourg", 48.576, 7.754},
+	{"Stuttgart", 48.800, 9.221},
+	{"Subotica", 46.099, 19.665},
+	{"Suceava", 47.661, 26.251},
+	{"Sucre", -19.031, -65.260},
+	{"Sudbury", 46.482, -80.972},
+	{"Suhar", 24.393, 56.692},
+	{"Suihua", 46.641, 126.971},
+	{"Suileng", 47.239, 127.121},
+	{"Sukabumi", -7.184, 106.856},
+	{"Sukhumi", 43.005, 41.003},
+	{"Sukkur", 27.832, 68.810},
+	{"Sullana", -4.896, -80.685},
+	{"Sumbawanga", -7.943, 31.609},
+	{"Sumenep", -7.015, 113.612},
+	{"Sumqayt", 40.580, 49.663},
+	{"Sumter", 33.919, -80.365},
+	{"Sumy", 50.913, 34.800},
+	{"Sunchon", 39.431, 126.060},
+	{"Sunderland", 54.915, -1.439},
+	{"Sundsvall", 62.437, 17.334},
+	{"Sungai Petani", 5.614, 100.483},
+	{"Sur", 22.574, 59.514},
+	{"Surabaya", -7.372, 112.480},
+	{"Surakarta", -7.653, 110.982},
+	{"Surat", 21.266, 72.975},
+	{"Surat Thani", 9.131, 99.339},
+	{"Surgut", 61.260, 73.417},
+	{"Surin", 14.896, 103.500},
+	{"Surt", 31.191, 16.575},
+	{"Suva", -18.107, 178.473},
+	{"Suwalki", 54.099, 22.932},
+	{"Suwon", 37.123, 127.069},
+	{"Suzhou", 31.377, 120.722},
+	{"Svobodnyy", 51.371, 128.139},
+	{"Swansea", 51.642, -3.903},
+	{"Sydney", -33.847, 151.051},
+	{"Syktyvkar", 61.717, 50.788},
+	{"Sylhet", 24.781, 91.819},
+	{"Syracuse", 43.074, -76.137},
+	{"Syzran", 53.150, 48.447},
+	{"Szczecin", 53.469, 14.544},
+	{"Szeged", 46.267, 20.151},
+	{"Szekesfehervar", 47.188, 18.419},
+	{"Szolnok", 47.163, 20.181},
+	{"Szombathely", 47.229, 16.630},
+	{"Tabora", -5.023, 32.819},
+	{"Tabriz", 38.072, 46.298},
+	{"Tabuk", 28.379, 36.586},
+	{"Tacloban", 11.205, 125.001},
+	{"Tacna", -18.012, -70.248},
+	{"Tacoma", 47.188, -122.402},
+	{"Tacuarembo", -31.718, -55.979},
+	{"Tadmur", 34.567, 38.281},
+	{"Taganrog", 47.243, 38.902},
+	{"Tahoua", 14.901, 5.264},
+	{"Taian", 36.080, 116.981},
+	{"Taichung", 24.163, 120.634},
+	{"Tailai", 46.391, 123.412},
+	{"Tainan", 23.008, 120.253},
+	{"Taipei", 24.965, 121.292},
+	{"Taiping", 4.853, 100.726},
+	{"Taitung", 22.764, 121.119},
+	{"Taiyuan", 37.606, 112.349},
+	{"Taizhou", 32.823, 120.095},
+	{"Taizz", 13.612, 44.064},
+	{"Takamatsu", 34.310, 134.052},
+	{"Takaoka", 36.652, 136.938},
+	{"Takapuna", -36.767, 174.735},
+	{"Talara", -4.583, -81.264},
+	{"Talca", -35.430, -71.650},
+	{"Talcahuano", -36.749, -73.118},
+	{"Taldyqorghan", 45.005, 78.372},
+	{"Tall Afar", 36.373, 42.441},
+	{"Tallahassee", 30.477, -84.272},
+	{"Tallinn", 59.427, 24.747},
+	{"Tamale", 9.416, -0.850},
+	{"Tamanrasset", 22.787, 5.527},
+	{"Tamazunchale", 21.272, -98.734},
+	{"Tambacounda", 13.772, -13.672},
+	{"Tambov", 52.734, 41.440},
+	{"Tampa", 28.020, -82.529},
+	{"Tampere", 61.483, 23.739},
+	{"Tampico", 22.275, -97.862},
+	{"Tan An", 10.605, 106.467},
+	{"Tan Tan", 28.435, -11.100},
+	{"Tandil", -37.313, -59.138},
+	{"Tanga", -5.080, 39.089},
+	{"Tangail", 24.235, 89.902},
+	{"Tangier", 35.747, -5.828},
+	{"Tangshan", 39.725, 118.384},
+	{"Tanjungkarang-Telubketung", -5.449, 105.312},
+	{"Tanjungpandan", -2.737, 107.644},
+	{"Tanjungpinang", 0.915, 104.457},
+	{"Tanta", 30.646, 31.136},
+	{"Taonan", 45.333, 122.779},
+	{"Tapachula", 14.901, -92.269},
+	{"Tarakan", 3.312, 117.592},
+	{"Taranto", 40.522, 17.225},
+	{"Taraz", 42.891, 71.387},
+	{"Tarbes", 43.229, 0.066},
+	{"Tarija", -21.532, -64.730},
+	{"Tarlac", 15.592, 120.605},
+	{"Tarnow", 50.045, 20.936},
+	{"Tarragona", 41.127, 1.228},
+	{"Tarsus", 36.888, 34.817},
+	{"Tartagal", -22.516, -63.798},
+	{"Tartu", 58.371, 26.722},
+	{"Tartus", 34.836, 36.006},
+	{"Tashkent", 41.319, 69.257},
+	{"Tasikmalaya", -7.223, 108.093},
+	{"Tatui", -23.347, -47.844},
+	{"Tatvan", 38.501, 42.284},
+	{"Taubate", -22.995, -45.529},
+	{"Taungoo", 18.937, 96.436},
+	{"Tauranga", -37.701, 176.153},
+	{"Tawau", 4.271, 117.895},
+	{"Tayshet", 55.934, 98.011},
+	{"Taza", 34.220, -4.000},
+	{"Tbilisi", 41.722, 44.823},
+	{"Tebessa", 35.411, 8.111},
+	{"Tebingtinggi", 3.337, 99.179},
+	{"Tecoman", 18.916, -103.877},
+	{"Tefe", -3.364, -64.712},
+	{"Tegal", -6.990, 109.101},
+	{"Tegucigalpa", 14.083, -87.199},
+	{"Tehran", 35.709, 51.417},
+	{"Tehuacan", 18.469, -97.402},
+	{"Tejen", 37.379, 60.499},
+	{"Tekirdag", 40.975, 27.507},
+	{"Tel Aviv-Yafo", 32.030, 34.837},
+	{"Teluk Intan", 4.011, 101.030},
+	{"Tema", 5.673, -0.053},
+	{"Temirtau", 50.054, 72.988},
+	{"Temple", 31.094, -97.364},
+	{"Temuco", -38.728, -72.585},
+	{"Tengchong", 25.039, 98.498},
+	{"Teofilo Otoni", -17.865, -41.505},
+	{"Tepic", 21.504, -104.888},
+	{"Teresina", -5.087, -42.789},
+	{"Termiz", 37.255, 67.307},
+	{"Ternate", 0.777, 127.363},
+	{"Ternopil", 49.546, 25.603},
+	{"Terre Haute", 39.467, -87.387},
+	{"Tete", -16.155, 33.593},
+	{"Tetovo", 42.041, 21.010},
+	{"Texarkana", 33.441, -94.063},
+	{"Texas City", 29.378, -94.961},
+	{"Teziutlan", 19.800, -97.310},
+	{"Tezpur", 26.754, 92.771},
+	{"Thai Nguyen", 21.485, 105.929},
+	{"Thanh Hoa", 19.807, 105.705},
+	{"Thanjavur", 10.542, 79.191},
+	{"The Hague", 52.021, 4.356},
+	{"Thessaloniki", 40.645, 22.927},
+	{"Thies", 14.793, -16.924},
+	{"Thika", -1.050, 37.082},
+	{"Thimphu", 27.478, 89.638},
+	{"Thohoyandou", -23.033, 30.643},
+	{"Thunder Bay", 48.409, -89.262},
+	{"Tianjin", 38.869, 117.351},
+	{"Tianmen", 30.616, 112.987},
+	{"Tianshui", 34.551, 105.750},
+	{"Tiarat", 35.360, 1.320},
+	{"Tidore", 0.660, 127.403},
+	{"Tieli", 46.990, 128.039},
+	{"Tieling", 42.276, 123.854},
+	{"Tijuana", 32.527, -116.994},
+	{"Tikhoretsk", 45.860, 40.124},
+	{"Tikrit", 34.623, 43.671},
+	{"Timbauba", -7.491, -35.305},
+	{"Timisoara", 45.744, 21.230},
+	{"Timon", -5.094, -42.833},
+	{"Tirana", 41.339, 19.806},
+	{"Tiraspol", 46.850, 29.631},
+	{"Tirgu Mures", 46.536, 24.540},
+	{"Tiruchchirappalli", 10.731, 78.942},
+	{"Tirunelveli", 8.756, 77.722},
+	{"Tirupati", 13.621, 79.617},
+	{"Tiruppur", 11.105, 77.300},
+	{"Tiruvannamalai", 12.187, 79.095},
+	{"Titusville", 28.594, -80.829},
+	{"Tiznit", 29.703, -9.741},
+	{"Tlaxcala", 19.296, -98.288},
+	{"Tlimcen", 34.891, -1.312},
+	{"Toamasina", -18.147, 49.386},
+	{"Tobolsk", 58.198, 68.260},
+	{"Tokar", 18.429, 37.727},
+	{"Tokmak", 42.832, 75.287},
+	{"Tokushima", 34.101, 134.493},
+	{"Tokyo", 35.743, 139.751},
+	{"Toledo", 41.657, -83.604},
+	{"Toliara", -23.345, 43.701},
+	{"Toluca", 19.263, -99.602},
+	{"Tolyatti", 53.540, 49.382},
+	{"Tomakomai", 42.636, 141.579},
+	{"Tomsk", 56.521, 84.971},
+	{"Tongchuan", 35.096, 109.091},
+	{"Tongliao", 43.619, 122.270},
+	{"Tongling", 30.943, 117.881},
+	{"Tongren", 27.716, 109.178},
+	{"Tonk", 26.166, 75.794},
+	{"Toowoomba", -27.565, 151.940},
+	{"Topeka", 39.036, -95.700},
+	{"Torbat-e Jam", 35.239, 60.623},
+	{"Toronto", 43.713, -79.464},
+	{"Tororo", 0.669, 34.215},
+	{"Torreon", 25.551, -103.432},
+	{"Tottori", 35.496, 134.224},
+	{"Touggourt", 33.109, 6.070},
+	{"Toulon", 43.125, 5.915},
+	{"Toulouse", 43.597, 1.415},
+	{"Tours", 47.382, 0.695},
+	{"Townsville", -19.298, 146.769},
+	{"Toyama", 36.710, 137.235},
+	{"Trablous", 34.469, 35.976},
+	{"Trabzon", 40.962, 39.957},
+	{"Trang", 7.569, 99.642},
+	{"Trelew", -43.254, -65.314},
+	{"Trento", 46.095, 11.120},
+	{"Trenton", 40.162, -74.823},
+	{"Treviso", 45.691, 12.256},
+	{"Trieste", 45.645, 13.802},
+	{"Trindade", -16.634, -49.436},
+	{"Trinidad", -14.832, -64.897},
+	{"Tripoli", 32.862, 13.193},
+	{"Trivandrum", 8.708, 76.922},
+	{"Trnava", 48.369, 17.586},
+	{"Trois Rivieres", 46.362, -72.561},
+	{"Troitsk", 54.094, 61.562},
+	{"Trondheim", 63.401, 10.399},
+	{"Troyes", 48.294, 4.073},
+	{"Trujillo", -8.099, -79.031},
+	{"Trujillo", 9.364, -70.438},
+	{"Tshikapa", -6.417, 20.789},
+	{"Tsu", 34.802, 136.550},
+	{"Tsuruoka", 38.728, 139.826},
+	{"Tuapse", 44.103, 39.087},
+	{"Tuban", -7.042, 111.933},
+	{"Tubarao", -28.477, -49.018},
+	{"Tubruq", 32.084, 23.954},
+	{"Tucson", 32.254, -110.931},
+	{"Tukuyu", -9.218, 33.627},
+	{"Tula", 54.203, 37.621},
+	{"Tulare", 36.206, -119.343},
+	{"Tulcea", 45.178, 28.792},
+	{"Tulsa", 36.103, -95.920},
+	{"Tulua", 4.082, -76.196},
+	{"Tumbes", -3.565, -80.443},
+	{"Tumen", 42.965, 129.841},
+	{"Tumkur", 13.353, 77.100},
+	{"Tungkang", 22.536, 120.481},
+	{"Tunis", 36.803, 10.202},
+	{"Tunja", 5.535, -73.356},
+	{"Turbat", 26.006, 63.049},
+	{"Turin", 45.066, 7.649},
+	{"Turkistan", 43.296, 68.249},
+	{"Turkmenabat", 39.068, 63.570},
+	{"Turkmenbasy", 40.011, 52.974},
+	{"Turku", 60.463, 22.291},
+	{"Turnovo", 43.068, 25.619},
+	{"Turpan", 42.950, 89.175},
+	{"Tuscaloosa", 33.198, -87.536},
+	{"Tuticorin", 8.817, 78.134},
+	{"Tuxpam", 20.953, -97.388},
+	{"Tuxtla Gutierrez", 16.759, -93.125},
+	{"Tuymazy", 54.608, 53.703},
+	{"Tuzla", 44.531, 18.669},
+	{"Tver", 56.862, 35.888},
+	{"Tyler", 32.330, -95.296},
+	{"Tyumen", 57.157, 65.592},
+	{"Tzaneen", -23.834, 30.166},
+	{"Uba", -21.110, -42.946},
+	{"Uberaba", -19.754, -47.936},
+	{"Uberlandia", -18.913, -48.276},
+	{"Ubon Ratchathani", 15.239, 104.865},
+	{"Udaipur", 24.586, 73.716},
+	{"Udine", 46.089, 13.228},
+	{"Udon Thani", 17.402, 102.795},
+	{"Ufa", 54.818, 56.047},
+	{"Uige", -7.614, 15.057},
+	{"Uitenhage", -33.775, 25.417},
+	{"Ujjain", 23.197, 75.804},
+	{"Ujungpandang", -5.378, 119.596},
+	{"Ukhta", 63.576, 53.715},
+	{"Ulaanbaatar", 47.932, 106.883},
+	{"Ulan Ude", 51.841, 107.618},
+	{"Ulanhot", 46.082, 122.065},
+	{"Ulm", 48.399, 9.982},
+	{"Ulsan", 35.524, 129.352},
+	{"Ulyanovsk", 54.305, 48.330},
+	{"Uman", 48.748, 30.217},
+	{"Umea", 63.830, 20.256},
+	{"Umtata", -31.585, 28.763},
+	{"Upata", 8.007, -62.401},
+	{"Upington", -28.452, 21.243},
+	{"Uppsala", 59.851, 17.640},
+	{"Urbana", 40.109, -88.247},
+	{"Urgentch", 41.546, 60.628},
+	{"Urgut", 39.423, 67.263},
+	{"Uroteppa", 39.920, 68.998},
+	{"Uruapan", 19.415, -102.051},
+	{"Uruguaiana", -29.769, -57.065},
+	{"Urumqi", 43.855, 87.578},
+	{"Usak", 38.677, 29.420},
+	{"Usolye Sibirskoye", 52.769, 103.632},
+	{"Ussuriysk", 43.807, 131.956},
+	{"Utica", 43.092, -75.266},
+	{"Utrecht", 52.077, 5.123},
+	{"Utsunomiya", 36.464, 139.866},
+	{"Uttaradit", 17.626, 100.070},
+	{"Uvinza", -5.104, 30.385},
+	{"Uvira", -3.372, 29.151},
+	{"Uzhgorod", 48.609, 22.295},
+	{"Vadodara", 22.477, 73.012},
+	{"Valdivia", -39.822, -73.226},
+	{"Valdosta", 30.847, -83.285},
+	{"Valencia", 10.197, -67.976},
+	{"Valencia", 39.481, -0.439},
+	{"Valera", 9.322, -70.600},
+	{"Valladolid", 41.637, -4.725},
+	{"Valle de la Pascua", 9.214, -66.005},
+	{"Valledupar", 10.476, -73.258},
+	{"Vallejo", 38.110, -122.220},
+	{"Valletta", 35.882, 14.483},
+	{"Valparai", 10.334, 76.952},
+	{"Valparaiso", -33.035, -71.541},
+	{"Van", 38.506, 43.376},
+	{"Vanadzor", 40.812, 44.492},
+	{"Vancouver", 45.615, -122.550},
+	{"Vancouver", 49.229, -122.983},
+	{"Varamin", 35.333, 51.647},
+	{"Varanasi", 25.874, 83.214},
+	{"Varna", 43.217, 27.894},
+	{"Varzea Grande", -15.649, -56.150},
+	{"Vasteraas", 59.622, 16.542},
+	{"Vatican City", 41.903, 12.420},
+	{"Velikiye Luki", 56.343, 30.545},
+	{"Vellore", 12.914, 79.086},
+	{"Veracruz", 19.173, -96.151},
+	{"Vereeniging", -26.676, 27.882},
+	{"Vero Beach", 27.616, -80.418},
+	{"Verona", 45.459, 10.932},
+	{"Versailles", 48.850, 2.059},
+	{"Victoria", 28.828, -96.987},
+	{"Victoria", 48.455, -123.396},
+	{"Viedma", -40.810, -62.994},
+	{"Vienna", 48.182, 16.352},
+	{"Vientiane", 17.967, 102.648},
+	{"Viet Tri", 21.346, 105.537},
+	{"Vigo", 42.197, -8.742},
+	{"Vijayawada", 16.638, 80.770},
+	{"Vila Velha", -20.310, -40.320},
+	{"Villa Carlos Paz", -31.430, -64.503},
+	{"Villa Maria", -32.414, -63.239},
+	{"Villahermosa", 18.007, -92.948},
+	{"Villavicencio", 4.143, -73.622},
+	{"Vilnius", 54.692, 25.260},
+	{"Vina del Mar", -33.021, -71.482},
+	{"Vinh", 18.728, 105.668},
+	{"Vinnytsya", 49.234, 28.484},
+	{"Virginia Beach", 36.825, -76.054},
+	{"Visakhapatnam", 17.760, 83.049},
+	{"Visalia", 36.327, -119.321},
+	{"Vitoria", -20.254, -40.330},
+	{"Vitoria", 42.864, -2.673},
+	{"Vitoria da Conquista", -14.865, -40.851},
+	{"Vitsyebsk", 55.193, 30.183},
+	{"Vladikavkaz", 43.050, 44.679},
+	{"Vladimir", 56.142, 40.404},
+	{"Vladivostok", 43.143, 131.939},
+	{"Vlore", 40.469, 19.490},
+	{"Volgodonsk", 47.519, 42.144},
+	{"Volgograd", 48.755, 44.519},
+	{"Vologda", 59.223, 39.881},
+	{"Volos", 39.374, 22.935},
+	{"Volsk", 52.051, 47.387},
+	{"Volta Redonda", -22.511, -44.082},
+	{"Volzhskiy", 48.808, 44.773},
+	{"Vorkuta", 67.505, 64.042},
+	{"Voronezh", 51.662, 39.267},
+	{"Votkinsk", 57.055, 53.985},
+	{"Vratsa", 43.207, 23.558},
+	{"Vryheid", -27.771, 30.798},
+	{"Vung Tau", 10.379, 107.107},
+	{"Vyborg", 60.713, 28.768},
+	{"Vyska", 55.326, 42.158},
+	{"Wa", 10.061, -2.501},
+	{"Waco", 31.539, -97.160},
+	{"Wafangdian", 39.626, 122.008},
+	{"Wahiawa", 21.434, -158.010},
+	{"Wailuku", 20.887, -156.484},
+	{"Wakayama", 34.227, 135.211},
+	{"Walbrzych", 50.784, 16.271},
+	{"Wamba", 2.141, 27.997},
+	{"Wangqing", 43.318, 129.754},
+	{"Wanxian", 30.701, 108.260},
+	{"Warangal", 18.110, 79.695},
+	{"Warri", 5.532, 5.850},
+	{"Warsaw", 52.231, 21.031},
+	{"Washington, D.C.", 39.008, -77.003},
+	{"Waterbury", 41.552, -73.035},
+	{"Waterloo", 42.495, -92.376},
+	{"Wau", 7.701, 27.991},
+	{"Waukegan", 42.273, -87.955},
+	{"Waukesha", 43.040, -88.164},
+	{"Wausau", 44.931, -89.629},
+	{"Weifang", 36.711, 119.007},
+	{"Weihai", 37.447, 122.084},
+	{"Weinan", 34.627, 109.523},
+	{"Welkom", -27.973, 26.757},
+	{"Wellington", -41.286, 174.788},
+	{"Wenatchee", 47.430, -120.305},
+	{"Wenshan", 23.376, 104.243},
+	{"Wenzhou", 27.746, 120.586},
+	{"West Palm Beach", 26.695, -80.135},
+	{"Whangarei", -35.712, 174.317},
+	{"Wheeling", 40.055, -80.709},
+	{"Wichita", 37.683, -97.327},
+	{"Wichita Falls", 33.900, -98.522},
+	{"Wiener", 47.828, 16.249},
+	{"Wiesbaden", 50.016, 8.302},
+	{"Wilkes Barre", 41.270, -75.863},
+	{"Williamsport", 41.248, -77.022},
+	{"Wilmington", 34.218, -77.880},
+	{"Wilmington", 37.903, -76.362},
+	{"Winchester", 39.177, -78.158},
+	{"Windhoek", -22.551, 17.064},
+	{"Windsor", 42.477, -82.950},
+	{"Winnipeg", 49.883, -97.149},
+	{"Winston-Salem", 36.104, -80.257},
+	{"Winter Haven", 28.020, -81.741},
+	{"Wloclawek", 52.666, 19.047},
+	{"Wollongong", -34.420, 150.879},
+	{"Wonju", 37.352, 127.948},
+	{"Wonsan", 39.150, 127.441},
+	{"Worcester", -33.647, 19.453},
+	{"Worcester", 42.265, -71.782},
+	{"Wroclaw", 51.116, 17.014},
+	{"Wuhan", 30.618, 114.192},
+	{"Wuhu", 31.148, 118.582},
+	{"Wukari", 7.872, 9.778},
+	{"Wuppertal", 51.222, 7.167},
+	{"Wurzburg", 49.797, 9.943},
+	{"Wuwei", 37.915, 102.686},
+	{"Wuxi", 31.692, 120.266},
+	{"Wuzhou", 23.451, 111.268},
+	{"Xai-Xai", -25.048, 33.668},
+	{"Xalapa", 19.547, -96.926},
+	{"Xanthi", 41.125, 24.883},
+	{"Xapeco", -27.098, -52.628},
+	{"Xiamen", 24.490, 118.124},
+	{"Xian", 34.395, 108.730},
+	{"Xiangfan", 32.341, 112.375},
+	{"Xiangtai", 37.118, 114.667},
+	{"Xiangtan", 27.896, 112.751},
+	{"Xiantao", 30.390, 113.338},
+	{"Xiaogan", 30.952, 113.868},
+	{"Xichang", 27.891, 102.226},
+	{"Xilinhot", 43.947, 116.087},
+	{"Xingyi", 25.094, 104.913},
+	{"Xining", 36.621, 101.722},
+	{"Xinxiang", 35.332, 113.827},
+	{"Xinyang", 32.199, 114.591},
+	{"Xinyi", 34.263, 118.164},
+	{"Xinyu", 27.946, 115.095},
+	{"Xinzhou", 38.411, 112.730},
+	{"Xuanhua", 40.613, 115.039},
+	{"Xuchang", 34.075, 113.911},
+	{"Xuzhou", 34.284, 117.236},
+	{"Yaan", 30.118, 103.371},
+	{"Yakeshi", 49.287, 120.716},
+	{"Yakima", 46.591, -120.540},
+	{"Yakutsk", 62.062, 129.720},
+	{"Yala", 6.540, 101.292},
+	{"Yalta", 44.498, 34.155},
+	{"Yamagata", 38.281, 140.339},
+	{"Yamoussoukro", 6.832, -5.264},
+	{"Yanbu al Bahr", 24.088, 38.079},
+	{"Yangjiang", 21.801, 111.759},
+	{"Yangquan", 37.913, 113.556},
+	{"Yangzhou", 32.470, 119.287},
+	{"Yanji", 42.900, 129.452},
+	{"Yantai", 37.484, 121.236},
+	{"Yaounde", 3.866, 11.518},
+	{"Yaroslave", 57.631, 39.860},
+	{"Yaynangyoung", 20.468, 94.885},
+	{"Yazd", 31.876, 54.360},
+	{"Ye", 15.239, 97.847},
+	{"Yegoryevsk", 55.378, 39.044},
+	{"Yekaterinburg", 56.848, 60.607},
+	{"Yelets", 52.614, 38.520},
+	{"Yeosu", 34.792, 127.693},
+	{"Yerevan", 40.127, 44.506},
+	{"Yevpatoriya", 45.199, 33.367},
+	{"Yeysk", 46.700, 38.267},
+	{"Yibin", 28.993, 104.578},
+	{"Yichang", 30.671, 111.451},
+	{"Yichun", 47.722, 128.883},
+	{"Yinchuan", 38.461, 106.277},
+	{"Yingkow", 40.750, 122.422},
+	{"Yining", 43.937, 81.325},
+	{"Yirga Alem", 6.753, 38.470},
+	{"Yishui", 35.687, 118.732},
+	{"Yiyang", 28.484, 112.378},
+	{"Yogyakarta", -7.866, 110.462},
+	{"Yokohama", 35.396, 139.485},
+	{"Yola", 9.205, 12.544},
+	{"Yongzhou", 26.308, 111.704},
+	{"York", 39.970, -76.714},
+	{"York", 53.970, -1.090},
+	{"Yoshkar Ola", 56.647, 47.890},
+	{"Youngstown", 41.133, -80.714},
+	{"Yuanlin", 23.869, 120.542},
+	{"Yuba City", 39.125, -121.608},
+	{"Yuci", 37.589, 112.616},
+	{"Yueyang", 29.285, 113.263},
+	{"Yulin", 22.663, 110.239},
+	{"Yulin", 38.279, 109.747},
+	{"Yuma", 32.697, -114.638},
+	{"Yunxian", 32.828, 110.824},
+	{"Yurga", 55.721, 84.926},
+	{"Yuxi", 24.358, 102.530},
+	{"Yuzhno Sakhalinsk", 46.961, 142.726},
+	{"Zabid", 14.176, 43.361},
+	{"Zabol", 31.030, 61.519},
+	{"Zacatecas", 22.769, -102.550},
+	{"Zagreb", 45.803, 16.005},
+	{"Zahedan", 29.499, 60.859},
+	{"Zakho", 37.148, 42.672},
+	{"Zalantun", 47.997, 122.738},
+	{"Zamboanga", 6.945, 122.102},
+	{"Zamora", 19.979, -102.293},
+	{"Zanjan", 36.678, 48.492},
+	{"Zanzibar", -6.183, 39.241},
+	{"Zaozhuang", 34.770, 117.510},
+	{"Zaporizhzhya", 47.832, 35.185},
+	{"Zaragoza", 41.669, -0.867},
+	{"Zarate", -34.102, -59.032},
+	{"Zaria", 11.113, 7.700},
+	{"Zarzis", 33.516, 11.089},
+	{"Zenica", 44.204, 17.919},
+	{"Zhangjiakou", 40.782, 114.887},
+	{"Zhangye", 38.926, 100.462},
+	{"Zhangzhou", 24.545, 117.716},
+	{"Zhanjiang", 21.272, 110.357},
+	{"Zhanyi", 25.615, 103.809},
+	{"Zhaodong", 46.078, 125.984},
+	{"Zhaoqing", 23.020, 112.560},
+	{"Zhaotang", 27.241, 103.803},
+	{"Zheleznogorsk", 52.338, 35.363},
+	{"Zhengzhou", 34.504, 113.954},
+	{"Zhenjiang", 32.084, 119.566},
+	{"Zhijiang", 27.468, 109.720},
+	{"Zhob", 31.343, 69.443},
+	{"Zhoukou", 33.672, 114.664},
+	{"Zhuanghe", 39.689, 122.882},
+	{"Zhucheng", 35.938, 119.341},
+	{"Zhuzhou", 27.922, 113.162},
+	{"Zhytomyr", 50.265, 28.665},
+	{"Zibo", 36.826, 118.086},
+	{"Zicheng", 30.350, 111.455},
+	{"Zielona Gora", 51.943, 15.507},
+	{"Zigong", 29.494, 104.629},
+	{"Ziguinchor", 12.564, -16.272},
+	{"Zilina", 49.215, 18.734},
+	{"Zima", 53.919, 102.050},
+	{"Zinder", 13.813, 8.983},
+	{"Zlatoust", 55.190, 59.678},
+	{"Zlin", 49.213, 17.605},
+	{"Zomba", -15.458, 35.396},
+	{"Zonguldak", 41.469, 31.839},
+	{"Zouirat", 22.736, -12.472},
+	{"Zrenjanin", 45.373, 20.398},
+	{"Zumpango", 19.728, -99.102},
+	{"Zunyi", 27.621, 106.887},
+	{"Zuwarah", 32.927, 12.089},
+	{"ZÌùrich", 47.367, 8.560},
+}
@@ -0,0 +1,649 @@
+// Code generated by gen.go from Natural Earth ne_110m_admin_0_countries.geojson.gz; DO NOT EDIT.
+
+package geo
+
+var countries = []country{
+	{name: "Afghanistan", rings: [][]float32{
+		{66.519, 37.363, 67.076, 37.356, 67.830, 37.145, 68.136, 37.023, 68.859, 37.344, 69.196, 37.151, 69.519, 37.609, 70.117, 37.588, 70.271, 37.735, 70.376, 38.138, 70.807, 38.486, 71.348, 38.259, 71.239, 37.953, 71.542, 37.906, 71.449, 37.066, 71.845, 36.738, 72.193, 36.948, 72.637, 37.048, 73.260, 37.495, 73.949, 37.422, 74.980, 37.420, 75.158, 37.133, 74.576, 37.021, 74.068, 36.836, 72.920, 36.720, 71.846, 36.510, 71.262, 36.074, 71.499, 35.651, 71.613, 35.153, 71.115, 34.733, 71.157, 34.349, 70.882, 33.989, 69.931, 34.020, 70.324, 33.359, 69.687, 33.105, 69.263, 32.502, 69.318, 31.901, 68.927, 31.620, 68.557, 31.713, 67.793, 31.583, 67.683, 31.303, 66.939, 31.305, 66.381, 30.739, 66.346, 29.888, 65.047, 29.472, 64.350, 29.560, 64.148, 29.341, 63.550, 29.468, 62.550, 29.319, 60.874, 29.829, 61.781, 30.736, 61.699, 31.380, 60.942, 31.548, 60.864, 32.183, 60.536, 32.981, 60.964, 33.529, 60.528, 33.676, 60.803, 34.404, 61.211, 35.650, 62.231, 35.271, 62.985, 35.404, 63.194, 35.857, 63.983, 36.008, 64.546, 36.312, 64.746, 37.112, 65.589, 37.305, 65.746, 37.661, 66.217, 37.394, 66.519, 37.363},
+	}},
+	{name: "Albania", rings: [][]float32{
+		{21.020, 40.843, 21.000, 40.580, 20.675, 40.435, 20.615, 40.110, 20.150, 39.625, 19.980, 39.695, 19.960, 39.915, 19.406, 40.251, 19.319, 40.727, 19.404, 41.410, 19.540, 41.720, 19.372, 41.878, 19.372, 41.878, 19.304, 42.196, 19.738, 42.688, 19.802, 42.500, 20.071, 42.589, 20.284, 42.320, 20.523, 42.218, 20.590, 41.855, 20.590, 41.855, 20.463, 41.515, 20.605, 41.086, 21.020, 40.843},
+	}},
+	{name: "Algeria", rings: [][]float32{
+		{-8.684, 27.396, -8.665, 27.589, -8.666, 27.656, -8.674, 28.841, -7.059, 29.579, -6.061, 29.732, -5.242, 30.000, -4.860, 30.501, -3.690, 30.897, -3.647, 31.637, -3.069, 31.724, -2.617, 32.094, -1.308, 32.263, -1.125, 32.652, -1.388, 32.864, -1.733, 33.920, -1.793, 34.528, -2.170, 35.168, -1.209, 35.715, -0.127, 35.889, 0.504, 36.301, 1.467, 36.606, 3.162, 36.784, 4.816, 36.865, 5.320, 36.717, 6.262, 37.111, 7.330, 37.118, 7.737, 36.886, 8.421, 36.946, 8.218, 36.433, 8.376, 35.480, 8.141, 34.655, 7.524, 34.097, 7.613, 33.344, 8.430, 32.748, 8.439, 32.506, 9.056, 32.103, 9.482, 30.308, 9.806, 29.425, 9.860, 28.960, 9.684, 28.144, 9.756, 27.688, 9.629, 27.141, 9.716, 26.512, 9.319, 26.094, 9.911, 25.365, 9.948, 24.937, 10.304, 24.379, 10.771, 24.563, 11.561, 24.098, 12.000, 23.472, 8.573, 21.566, 5.678, 19.601, 4.267, 19.155, 3.158, 19.057, 3.147, 19.694, 2.684, 19.856, 2.061, 20.142, 1.823, 20.611, -1.550, 22.793, -4.923, 24.975, -8.684, 27.396},
+	}},
+	{name: "Angola", rings: [][]float32{
+		{12.996, -4.781, 12.632, -4.991, 12.468, -5.248, 12.437, -5.684, 12.182, -5.790, 11.915, -5.038, 12.319, -4.606, 12.621, -4.438, 12.996, -4.781},
+		{12.322, -6.100, 12.735, -5.966, 13.025, -5.984, 13.376, -5.864, 16.327, -5.877, 16.573, -6.623, 16.860, -7.222, 17.090, -7.546, 17.473, -8.069, 18.134, -7.988, 18.464, -7.847, 19.017, -7.988, 19.167, -7.738, 19.418, -7.155, 20.038, -7.116, 20.092, -6.943, 20.602, -6.939, 20.515, -7.300, 21.728, -7.291, 21.746, -7.920, 21.949, -8.306, 21.802, -8.909, 21.875, -9.524, 22.209, -9.895, 22.155, -11.085, 22.403, -10.993, 22.837, -11.018, 23.457, -10.868, 23.912, -10.927, 24.018, -11.237, 23.904, -11.722, 24.080, -12.191, 23.931, -12.566, 24.016, -12.911, 21.934, -12.898, 21.888, -16.080, 22.562, -16.898, 23.215, -17.523, 21.377, -17.931, 18.956, -17.789, 18.263, -17.310, 14.210, -17.353, 14.059, -17.423, 13.462, -16.971, 12.814, -16.941, 12.215, -17.112, 11.734, -17.302, 11.640, -16.673, 11.779, -15.794, 12.124, -14.878, 12.176, -14.449, 12.500, -13.548, 12.738, -13.138, 13.313, -12.484, 13.634, -12.039, 13.739, -11.298, 13.686, -10.731, 13.387, -10.374, 13.121, -9.767, 12.875, -9.167, 12.929, -8.959, 13.236, -8.563, 12.933, -7.597, 12.728, -6.927, 12.227, -6.294, 12.322, -6.100},
+	}},
+	{name: "Antarctica", rings: [][]float32{
+		{-48.661, -78.047, -48.151, -78.047, -46.663, -77.831, -45.155, -78.047, -43.921, -78.478, -43.490, -79.086, -43.372, -79.517, -43.333, -80.026, -44.881, -80.340, -46.506, -80.594, -48.386, -80.829, -50.482, -81.025, -52.852, -80.967, -54.164, -80.634, -53.988, -80.222, -51.853, -79.948, -50.991, -79.615, -50.365, -79.183, -49.914, -78.811, -49.307, -78.459, -48.661, -78.047, -48.661, -78.047},
+		{-66.290, -80.256, -64.038, -80.295, -61.883, -80.393, -61.139, -79.981, -60.610, -79.629, -59.572, -80.040, -59.866, -80.550, -60.160, -81.000, -62.255, -80.863, -64.488, -80.922, -65.742, -80.589, -65.742, -80.550, -66.290, -80.256},
+		{-73.916, -71.269, -73.916, -71.269, -73.230, -71.152, -72.075, -71.191, -71.781, -70.681, -71.722, -70.309, -71.742, -69.506, -71.174, -69.035, -70.253, -68.879, -69.724, -69.251, -69.489, -69.623, -69.059, -70.074, -68.726, -70.505, -68.451, -70.956, -68.334, -71.406, -68.510, -71.798, -68.784, -72.171, -69.959, -72.308, -71.076, -72.504, -72.388, -72.484, -71.898, -72.092, -73.074, -72.229, -74.190, -72.367, -74.954, -72.073, -75.013, -71.661, -73.916, -71.269},
+		{-102.331, -71.894, -102.331, -71.894, -101.704, -71.718, -100.431, -71.855, -98.982, -71.933, -97.885, -72.071, -96.788, -71.953, -96.200, -72.521, -96.984, -72.443, -98.198, -72.482, -99.432, -72.443, -100.783, -72.502, -101.802, -72.306, -102.331, -71.894},
+		{-122.622, -73.658, -122.622, -73.658, -122.406, -73.325, -121.212, -73.501, -119.919, -73.658, -118.724, -73.481, -119.292, -73.834, -120.232, -74.089, -121.623, -74.010, -122.622, -73.658},
+		{-127.283, -73.462, -127.283, -73.462, -126.558, -73.246, -125.560, -73.481, -124.032, -73.873, -124.619, -73.834, -125.912, -73.736, -127.283, -73.462},
+		{-163.713, -78.596, -163.713, -78.596, -163.106, -78.223, -161.245, -78.380, -160.246, -78.694, -159.482, -79.046, -159.208, -79.497, -161.128, -79.634, -162.440, -79.281, -163.027, -78.929, -163.067, -78.870, -163.713, -78.596},
+		{180.000, -84.713, 180.000, -90.000, -180.000, -90.000, -180.000, -84.713, -179.942, -84.721, -179.059, -84.139, -177.257, -84.453, -177.141, -84.418, -176.085, -84.099, -175.947, -84.110, -175.830, -84.118, -174.383, -84.534, -173.117, -84.118, -172.889, -84.061, -169.951, -83.885, -169.000, -84.118, -168.530, -84.237, -167.022, -84.570, -164.182, -84.825, -161.930, -85.139, -158.071, -85.374, -155.192, -85.100, -150.942, -85.296, -148.533, -85.609, -145.889, -85.315, -143.108, -85.041, -142.892, -84.570, -146.829, -84.531, -150.061, -84.296, -150.903, -83.904, -153.586, -83.689, -153.410, -83.238, -153.038, -82.827, -152.666, -82.454, -152.862, -82.043, -154.526, -81.768, -155.290, -81.416, -156.837, -81.102, -154.409, -81.161, -152.098, -81.004, -150.648, -81.337, -148.866, -81.043, -147.221, -80.671, -146.418, -80.338, -146.770, -79.926, -148.063, -79.652, -149.532, -79.358, -151.588, -79.299, -153.390, -79.162, -155.329, -79.064, -155.976, -78.692, -157.268, -78.378, -158.052, -78.026, -158.365, -76.889, -157.875, -76.987, -156.975, -77.301, -155.329, -77.203, -153.743, -77.066, -152.920, -77.497, -151.334, -77.399, -150.002, -77.183, -148.748, -76.909, -147.612, -76.576, -146.104, -76.478, -146.144, -76.105, -146.496, -75.733, -146.202, -75.380, -144.910, -75.204, -144.322, -75.537, -142.794, -75.341, -141.639, -75.086, -140.209, -75.067, -138.858, -74.969, -137.506, -74.734, -136.429, -74.518, -135.215, -74.303, -134.431, -74.361, -133.746, -74.440, -132.257, -74.303, -130.925, -74.479, -129.554, -74.459, -128.242, -74.322, -126.891, -74.420, -125.402, -74.518, -124.011, -74.479, -122.562, -74.499, -121.074, -74.518, -119.703, -74.479, -118.684, -74.185, -117.470, -74.028, -116.216, -74.244, -115.022, -74.068, -113.944, -73.715, -113.298, -74.028, -112.945, -74.381, -112.299, -74.714, -111.261, -74.420, -110.066, -74.793, -108.715, -74.910, -107.559, -75.184, -106.149, -75.126, -104.876, -74.949, -103.368, -74.988, -102.017, -75.126, -100.646, -75.302, -100.117, -74.871, -100.763, -74.538, -101.253, -74.185, -102.545, -74.107, -103.113, -73.734, -103.329, -73.362, -103.681, -72.618, -102.917, -72.755, -101.605, -72.813, -100.313, -72.755, -99.137, -72.911, -98.119, -73.205, -97.688, -73.558, -96.337, -73.617, -95.044, -73.480, -93.673, -73.284, -92.439, -73.166, -91.421, -73.401, -90.089, -73.323, -89.227, -72.559, -88.424, -73.009, -87.268, -73.186, -86.015, -73.088, -85.192, -73.480, -83.880, -73.519, -82.666, -73.636, -81.471, -73.852, -80.687, -73.480, -80.296, -73.127, -79.297, -73.519, -77.926, -73.421, -76.907, -73.636, -76.222, -73.970, -74.890, -73.872, -73.852, -73.656, -72.834, -73.401, -71.619, -73.264, -70.209, -73.147, -68.936, -73.009, -67.957, -72.794, -67.369, -72.480, -67.134, -72.049, -67.252, -71.638, -67.565, -71.246, -67.917, -70.854, -68.231, -70.462, -68.485, -70.109, -68.544, -69.717, -68.446, -69.326, -67.976, -68.953, -67.585, -68.542, -67.428, -68.150, -67.624, -67.719, -67.741, -67.327, -67.252, -66.876, -66.703, -66.582, -66.057, -66.210, -65.371, -65.896, -64.568, -65.603, -64.177, -65.171, -63.628, -64.897, -63.001, -64.642, -62.042, -64.584, -61.415, -64.270, -60.710, -64.074, -59.887, -63.957, -59.163, -63.702, -58.595, -63.388, -57.811, -63.271, -57.224, -63.525, -57.596, -63.859, -58.614, -64.152, -59.045, -64.368, -59.789, -64.211, -60.612, -64.309, -61.297, -64.544, -62.022, -64.799, -62.512, -65.093, -62.649, -65.485, -62.590, -65.857, -62.120, -66.190, -62.806, -66.426, -63.746, -66.504, -64.294, -66.837, -64.882, -67.150, -65.508, -67.582, -65.665, -67.954, -65.313, -68.365, -64.784, -68.679, -63.961, -68.914, -63.197, -69.228, -62.786, -69.619, -62.571, -69.992, -62.277, -70.384, -61.807, -70.717, -61.513, -71.089, -61.376, -72.010, -61.082, -72.382, -61.004, -72.774, -60.690, -73.166, -60.827, -73.695, -61.376, -74.107, -61.963, -74.440, -63.295, -74.577, -63.746, -74.930, -64.353, -75.263, -65.861, -75.635, -67.193, -75.792, -68.446, -76.007, -69.798, -76.223, -70.601, -76.634, -72.207, -76.674, -73.970, -76.634, -75.556, -76.713, -77.240, -76.713, -76.927, -77.105, -75.399, -77.281, -74.283, -77.555, -73.656, -77.908, -74.773, -78.222, -76.496, -78.124, -77.926, -78.378, -77.985, -78.790, -78.024, -79.182, -76.849, -79.515, -76.633, -79.887, -75.360, -80.260, -73.245, -80.416, -71.443, -80.691, -70.013, -81.004, -68.192, -81.318, -65.704, -81.474, -63.256, -81.749, -61.552, -82.043, -59.691, -82.376, -58.712, -82.846, -58.222, -83.218, -57.008, -82.866, -55.363, -82.572, -53.620, -82.258, -51.544, -82.004, -49.761, -81.729, -47.274, -81.710, -44.826, -81.847, -42.808, -82.082, -42.162, -81.651, -40.771, -81.357, -38.245, -81.337, -36.267, -81.122, -34.386, -80.906, -32.310, -80.769, -30.097, -80.593, -28.550, -80.338, -29.255, -79.985, -29.686, -79.633, -29.686, -79.260, -31.625, -79.299, -33.681, -79.456, -35.640, -79.456, -35.914, -79.084, -35.777, -78.339, -35.327, -78.124, -33.897, -77.889, -32.212, -77.653, -30.998, -77.360, -29.784, -77.066, -28.883, -76.674, -27.512, -76.497, -26.160, -76.360, -25.475, -76.282, -23.928, -76.243, -22.459, -76.105, -21.225, -75.909, -20.010, -75.674, -18.914, -75.439, -17.523, -75.126, -16.642, -74.793, -15.701, -74.499, -15.408, -74.107, -16.465, -73.872, -16.113, -73.460, -15.447, -73.147, -14.409, -72.951, -13.312, -72.715, -12.294, -72.402, -11.510, -72.010, -11.020, -71.540, -10.296, -71.265, -9.101, -71.324, -8.611, -71.657, -7.417, -71.697, -7.377, -71.324, -6.868, -70.932, -5.791, -71.030, -5.536, -71.403, -4.342, -71.461, -3.049, -71.285, -1.795, -71.167, -0.659, -71.226, -0.229, -71.638, 0.868, -71.305, 1.887, -71.128, 3.023, -70.991, 4.139, -70.854, 5.158, -70.619, 6.274, -70.462, 7.136, -70.247, 7.743, -69.894, 8.487, -70.149, 9.525, -70.011, 10.250, -70.482, 10.818, -70.834, 11.954, -70.638, 12.404, -70.247, 13.423, -69.972, 14.735, -70.031, 15.127, -70.403, 15.949, -70.031, 17.027, -69.913, 18.202, -69.874, 19.259, -69.894, 20.376, -70.011, 21.453, -70.070, 21.923, -70.403, 22.569, -70.697, 23.666, -70.521, 24.841, -70.482, 25.977, -70.482, 27.094, -70.462, 28.093, -70.325, 29.150, -70.207, 30.032, -69.933, 30.972, -69.757, 31.990, -69.659, 32.754, -69.384, 33.302, -68.836, 33.870, -68.503, 34.908, -68.659, 35.300, -69.012, 36.162, -69.247, 37.200, -69.169, 37.905, -69.521, 38.649, -69.776, 39.668, -69.541, 40.020, -69.110, 40.921, -68.934, 41.959, -68.601, 42.939, -68.463, 44.114, -68.267, 44.897, -68.052, 45.720, -67.817, 46.503, -67.601, 47.443, -67.719, 48.344, -67.366, 48.991, -67.092, 49.931, -67.111, 50.753, -66.876, 50.949, -66.523, 51.792, -66.249, 52.614, -66.053, 53.613, -65.896, 54.534, -65.818, 55.415, -65.877, 56.355, -65.975, 57.158, -66.249, 57.256, -66.680, 58.137, -67.013, 58.745, -67.288, 59.939, -67.405, 60.605, -67.680, 61.428, -67.954, 62.387, -68.013, 63.190, -67.817, 64.052, -67.405, 64.992, -67.621, 65.972, -67.738, 66.912, -67.856, 67.891, -67.934, 68.890, -67.934, 69.713, -68.973, 69.673, -69.228, 69.556, -69.678, 68.596, -69.933, 67.813, -70.305, 67.950, -70.697, 69.066, -70.678, 68.929, -71.069, 68.420, -71.442, 67.950, -71.853, 68.714, -72.167, 69.869, -72.265, 71.025, -72.088, 71.573, -71.697, 71.906, -71.324, 72.455, -71.011, 73.081, -70.717, 73.336, -70.364, 73.865, -69.874, 74.492, -69.776, 75.628, -69.737, 76.626, -69.619, 77.645, -69.463, 78.135, -69.071, 78.428, -68.698, 79.114, -68.326, 80.093, -68.072, 80.935, -67.876, 81.484, -67.542, 82.052, -67.366, 82.776, -67.209, 83.775, -67.307, 84.676, -67.209, 85.656, -67.092, 86.752, -67.150, 87.477, -66.876, 87.986, -66.210, 88.358, -66.484, 88.828, -66.955, 89.671, -67.150, 90.630, -67.229, 91.590, -67.111, 92.609, -67.190, 93.549, -67.209, 94.175, -67.111, 95.018, -67.170, 95.781, -67.386, 96.682, -67.249, 97.760, -67.249, 98.680, -67.111, 99.718, -67.249, 100.384, -66.915, 100.893, -66.582, 101.579, -66.308, 102.832, -65.563, 103.479, -65.700, 104.243, -65.975, 104.908, -66.328, 106.182, -66.935, 107.161, -66.955, 108.081, -66.955, 109.159, -66.837, 110.236, -66.700, 111.058, -66.426, 111.744, -66.132, 112.860, -66.092, 113.605, -65.877, 114.388, -66.073, 114.897, -66.386, 115.602, -66.700, 116.699, -66.661, 117.385, -66.915, 118.579, -67.170, 119.833, -67.268, 120.871, -67.190, 121.654, -66.876, 122.320, -66.563, 123.221, -66.484, 124.122, -66.621, 125.160, -66.719, 126.100, -66.563, 127.001, -66.563, 127.883, -66.661, 128.803, -66.759, 129.704, -66.582, 130.781, -66.426, 131.800, -66.386, 132.936, -66.386, 133.856, -66.288, 134.757, -66.210, 135.032, -65.720, 135.071, -65.309, 135.697, -65.583, 135.874, -66.034, 136.207, -66.445, 136.618, -66.778, 137.460, -66.955, 138.596, -66.896, 139.908, -66.876, 140.809, -66.817, 142.122, -66.817, 143.062, -66.798, 144.374, -66.837, 145.490, -66.915, 146.196, -67.229, 146.000, -67.601, 146.646, -67.895, 147.723, -68.130, 148.840, -68.385, 150.132, -68.561, 151.484, -68.718, 152.502, -68.875, 153.638, -68.895, 154.285, -68.561, 155.166, -68.836, 155.930, -69.149, 156.811, -69.384, 158.026, -69.482, 159.181, -69.600, 159.671, -69.992, 160.807, -70.227, 161.570, -70.580, 162.687, -70.736, 163.842, -70.717, 164.920, -70.776, 166.114, -70.756, 167.309, -70.834, 168.426, -70.971, 169.464, -71.207, 170.502, -71.403, 171.207, -71.697, 171.089, -72.088, 170.560, -72.441, 170.110, -72.892, 169.757, -73.245, 169.287, -73.656, 167.975, -73.813, 167.387, -74.165, 166.095, -74.381, 165.644, -74.773, 164.959, -75.145, 164.234, -75.459, 163.823, -75.870, 163.568, -76.243, 163.470, -76.693, 163.490, -77.066, 164.058, -77.457, 164.273, -77.830, 164.743, -78.183, 166.604, -78.320, 166.996, -78.751, 165.194, -78.907, 163.666, -79.123, 161.766, -79.162, 160.924, -79.730, 160.748, -80.201, 160.317, -80.573, 159.788, -80.945, 161.120, -81.279, 161.629, -81.690, 162.491, -82.062, 163.705, -82.395, 165.096, -82.709, 166.604, -83.022, 168.896, -83.336, 169.405, -83.826, 172.284, -84.041, 172.477, -84.118, 173.224, -84.414, 175.986, -84.159, 178.277, -84.473, 180.000, -84.713},
+	}},
+	{name: "Argentina", rings: [][]float32{
+		{-68.634, -52.636, -68.250, -53.100, -67.750, -53.850, -66.450, -54.450, -65.050, -54.700, -65.500, -55.200, -66.450, -55.250, -66.960, -54.897, -67.562, -54.870, -68.633, -54.870, -68.634, -52.636},
+		{-57.625, -30.216, -57.875, -31.017, -58.142, -32.045, -58.133, -33.041, -58.350, -33.263, -58.427, -33.909, -58.495, -34.431, -57.226, -35.288, -57.362, -35.977, -56.737, -36.413, -56.788, -36.902, -57.749, -38.184, -59.232, -38.720, -61.237, -38.928, -62.336, -38.828, -62.126, -39.424, -62.331, -40.173, -62.146, -40.677, -62.746, -41.029, -63.770, -41.167, -64.732, -40.803, -65.118, -41.064, -64.979, -42.058, -64.303, -42.359, -63.756, -42.044, -63.458, -42.563, -64.379, -42.874, -65.182, -43.495, -65.329, -44.501, -65.565, -45.037, -66.510, -45.040, -67.294, -45.552, -67.581, -46.302, -66.597, -47.034, -65.641, -47.236, -65.985, -48.133, -67.166, -48.697, -67.816, -49.870, -68.729, -50.264, -69.139, -50.733, -68.816, -51.771, -68.150, -52.350, -68.572, -52.299, -69.498, -52.143, -71.915, -52.009, -72.329, -51.426, -72.310, -50.677, -72.976, -50.741, -73.328, -50.379, -73.415, -49.318, -72.648, -48.879, -72.331, -48.244, -72.447, -47.739, -71.917, -46.885, -71.552, -45.561, -71.659, -44.974, -71.223, -44.784, -71.330, -44.408, -71.794, -44.207, -71.464, -43.788, -71.915, -43.409, -72.149, -42.255, -71.747, -42.051, -71.916, -40.832, -71.681, -39.808, -71.414, -38.916, -70.815, -38.553, -71.119, -37.577, -71.122, -36.658, -70.365, -36.005, -70.388, -35.170, -69.817, -34.194, -69.815, -33.274, -70.074, -33.091, -70.535, -31.365, -69.919, -30.336, -70.014, -29.368, -69.656, -28.459, -69.001, -27.521, -68.296, -26.899, -68.595, -26.507, -68.386, -26.185, -68.418, -24.519, -67.328, -24.025, -66.985, -22.986, -67.107, -22.736, -66.273, -21.832, -64.965, -22.076, -64.377, -22.798, -63.987, -21.994, -62.846, -22.035, -62.685, -22.249, -60.847, -23.881, -60.029, -24.033, -58.807, -24.771, -57.777, -25.162, -57.634, -25.604, -58.618, -27.124, -57.610, -27.396, -56.487, -27.548, -55.696, -27.388, -54.789, -26.622, -54.625, -25.739, -54.130, -25.548, -53.628, -26.125, -53.649, -26.923, -54.491, -27.475, -55.162, -27.882, -56.291, -28.853, -57.625, -30.216},
+	}},
+	{name: "Armenia", rings: [][]float32{
+		{46.506, 38.771, 46.144, 38.741, 45.735, 39.320, 45.740, 39.474, 45.298, 39.472, 45.002, 39.740, 44.794, 39.713, 44.400, 40.005, 43.656, 40.254, 43.753, 40.740, 43.583, 41.092, 44.972, 41.248, 45.179, 40.985, 45.560, 40.812, 45.359, 40.562, 45.892, 40.218, 45.610, 39.900, 46.035, 39.628, 46.483, 39.464, 46.506, 38.771},
+	}},
+	{name: "Australia", rings: [][]float32{
+		{147.689, -40.808, 148.289, -40.875, 148.360, -42.062, 148.017, -42.407, 147.914, -43.212, 147.565, -42.938, 146.870, -43.635, 146.663, -43.581, 146.048, -43.550, 145.432, -42.694, 145.295, -42.034, 144.718, -41.163, 144.744, -40.704, 145.398, -40.793, 146.364, -41.138, 146.909, -41.001, 147.689, -40.808},
+		{126.149, -32.216, 125.089, -32.729, 124.222, -32.959, 124.029, -33.484, 123.660, -33.890, 122.811, -33.914, 122.183, -34.003, 121.299, -33.821, 120.580, -33.930, 119.894, -33.976, 119.299, -34.509, 119.007, -34.464, 118.506, -34.747, 118.025, -35.065, 117.296, -35.025, 116.625, -35.025, 115.564, -34.386, 115.027, -34.197, 115.049, -33.623, 115.545, -33.487, 115.715, -33.260, 115.679, -32.900, 115.802, -32.205, 115.690, -31.612, 115.161, -30.602, 114.997, -30.031, 115.040, -29.461, 114.642, -28.810, 114.616, -28.516, 114.174, -28.118, 114.049, -27.335, 113.477, -26.543, 113.339, -26.117, 113.778, -26.549, 113.441, -25.621, 113.937, -25.911, 114.233, -26.298, 114.216, -25.786, 113.721, -24.999, 113.625, -24.684, 113.394, -24.385, 113.502, -23.806, 113.707, -23.560, 113.843, -23.060, 113.737, -22.475, 114.150, -21.756, 114.225, -22.517, 114.648, -21.830, 115.460, -21.495, 115.947, -21.069, 116.712, -20.702, 117.166, -20.624, 117.442, -20.747, 118.230, -20.374, 118.836, -20.263, 118.988, -20.044, 119.252, -19.953, 119.805, -19.977, 120.856, -19.684, 121.400, -19.240, 121.655, -18.705, 122.242, -18.198, 122.287, -17.799, 122.313, -17.255, 123.013, -16.405, 123.434, -17.269, 123.859, -17.069, 123.503, -16.597, 123.817, -16.111, 124.258, -16.328, 124.380, -15.567, 124.926, -15.075, 125.167, -14.680, 125.670, -14.510, 125.686, -14.231, 126.125, -14.347, 126.143, -14.096, 126.583, -13.953, 127.066, -13.818, 127.805, -14.277, 128.360, -14.869, 128.986, -14.876, 129.621, -14.970, 129.410, -14.421, 129.889, -13.619, 130.339, -13.357, 130.184, -13.108, 130.618, -12.536, 131.223, -12.184, 131.735, -12.302, 132.575, -12.114, 132.557, -11.603, 131.825, -11.274, 132.357, -11.129, 133.020, -11.376, 133.551, -11.787, 134.393, -12.042, 134.679, -11.941, 135.298, -12.249, 135.883, -11.962, 136.258, -12.049, 136.492, -11.857, 136.952, -12.352, 136.685, -12.887, 136.305, -13.291, 135.962, -13.325, 136.078, -13.724, 135.784, -14.224, 135.429, -14.715, 135.500, -14.998, 136.295, -15.550, 137.065, -15.871, 137.580, -16.215, 138.303, -16.808, 138.585, -16.807, 139.109, -17.063, 139.261, -17.372, 140.215, -17.711, 140.875, -17.369, 141.071, -16.832, 141.274, -16.389, 141.398, -15.841, 141.702, -15.045, 141.563, -14.561, 141.636, -14.270, 141.520, -13.698, 141.651, -12.945, 141.843, -12.742, 141.687, -12.408, 141.929, -11.877, 142.118, -11.328, 142.144, -11.043, 142.515, -10.668, 142.797, -11.157, 142.867, -11.785, 143.116, -11.906, 143.159, -12.326, 143.522, -12.834, 143.597, -13.400, 143.562, -13.764, 143.922, -14.548, 144.564, -14.171, 144.895, -14.594, 145.375, -14.985, 145.272, -15.428, 145.485, -16.286, 145.637, -16.785, 145.889, -16.907, 146.160, -17.762, 146.064, -18.280, 146.387, -18.958, 147.471, -19.481, 148.178, -19.956, 148.848, -20.391, 148.717, -20.633, 149.289, -21.261, 149.678, -22.343, 150.077, -22.123, 150.483, -22.556, 150.727, -22.402, 150.900, -23.462, 151.609, -24.076, 152.074, -24.458, 152.855, -25.268, 153.136, -26.071, 153.162, -26.641, 153.093, -27.260, 153.569, -28.110, 153.512, -28.995, 153.339, -29.458, 153.069, -30.350, 153.090, -30.924, 152.892, -31.640, 152.450, -32.550, 151.709, -33.041, 151.344, -33.816, 151.011, -34.310, 150.714, -35.173, 150.328, -35.672, 150.075, -36.420, 149.946, -37.109, 149.997, -37.425, 149.424, -37.773, 148.305, -37.809, 147.382, -38.219, 146.922, -38.607, 146.318, -39.036, 145.490, -38.594, 144.877, -38.417, 145.032, -37.896, 144.486, -38.085, 143.610, -38.809, 142.745, -38.538, 142.178, -38.380, 141.607, -38.309, 140.639, -38.019, 139.992, -37.403, 139.807, -36.644, 139.574, -36.138, 139.083, -35.733, 138.121, -35.612, 138.449, -35.127, 138.208, -34.385, 137.719, -35.077, 136.829, -35.261, 137.352, -34.707, 137.504, -34.130, 137.890, -33.640, 137.810, -32.900, 136.997, -33.753, 136.372, -34.095, 135.989, -34.890, 135.208, -34.479, 135.239, -33.948, 134.613, -33.223, 134.086, -32.848, 134.274, -32.617, 132.991, -32.011, 132.288, -31.983, 131.326, -31.496, 129.536, -31.590, 128.241, -31.948, 127.103, -32.282, 126.149, -32.216},
+	}},
+	{name: "Austria", rings: [][]float32{
+		{16.980, 48.123, 16.904, 47.715, 16.341, 47.713, 16.534, 47.496, 16.202, 46.852, 16.012, 46.684, 15.137, 46.659, 14.632, 46.432, 13.806, 46.509, 12.376, 46.768, 12.153, 47.115, 11.165, 46.942, 11.049, 46.751, 10.443, 46.894, 9.932, 46.921, 9.480, 47.103, 9.633, 47.348, 9.594, 47.525, 9.896, 47.580, 10.402, 47.302, 10.545, 47.566, 11.426, 47.524, 12.141, 47.703, 12.621, 47.672, 12.933, 47.468, 13.026, 47.638, 12.884, 48.289, 13.243, 48.416, 13.596, 48.877, 14.339, 48.555, 14.901, 48.964, 15.253, 49.039, 16.030, 48.734, 16.499, 48.786, 16.960, 48.597, 16.880, 48.470, 16.980, 48.123},
+	}},
+	{name: "Azerbaijan", rings: [][]float32{
+		{46.405, 41.861, 46.686, 41.827, 47.373, 41.220, 47.816, 41.151, 47.987, 41.406, 48.584, 41.809, 49.110, 41.282, 49.619, 40.573, 50.085, 40.526, 50.393, 40.257, 49.569, 40.176, 49.395, 39.399, 49.223, 39.049, 48.857, 38.815, 48.883, 38.320, 48.634, 38.270, 48.011, 38.794, 48.356, 39.289, 48.060, 39.582, 47.685, 39.508, 46.506, 38.771, 46.483, 39.464, 46.035, 39.628, 45.610, 39.900, 45.892, 40.218, 45.359, 40.562, 45.560, 40.812, 45.179, 40.985, 44.972, 41.248, 45.217, 41.411, 45.963, 41.124, 46.502, 41.064, 46.638, 41.182, 46.145, 41.723, 46.405, 41.861},
+		{46.144, 38.741, 45.458, 38.874, 44.953, 39.336, 44.794, 39.713, 45.002, 39.740, 45.298, 39.472, 45.740, 39.474, 45.735, 39.320, 46.144, 38.741},
+	}},
+	{name: "Bahamas", rings: [][]float32{
+		{-78.980, 26.790, -78.510, 26.870, -77.850, 26.840, -77.820, 26.580, -78.910, 26.420, -78.980, 26.790},
+		{-77.790, 27.040, -77.000, 26.590, -77.173, 25.879, -77.356, 26.007, -77.340, 26.530, -77.788, 26.925, -77.790, 27.040},
+		{-78.191, 25.210, -77.890, 25.170, -77.540, 24.340, -77.535, 23.760, -77.780, 23.710, -78.034, 24.286, -78.408, 24.576, -78.191, 25.210},
+	}},
+	{name: "Bangladesh", rings: [][]float32{
+		{92.673, 22.041, 92.652, 21.324, 92.303, 21.475, 92.369, 20.671, 92.083, 21.192, 92.025, 21.702, 91.835, 22.183, 91.417, 22.765, 90.496, 22.805, 90.587, 22.393, 90.273, 21.836, 89.847, 22.039, 89.702, 21.857, 89.419, 21.966, 89.032, 22.056, 88.876, 22.879, 88.530, 23.631, 88.700, 24.234, 88.084, 24.502, 88.306, 24.866, 88.932, 25.239, 88.210, 25.768, 88.563, 26.447, 89.355, 26.014, 89.832, 25.965, 89.921, 25.270, 90.872, 25.133, 91.800, 25.147, 92.376, 24.977, 91.915, 24.130, 91.468, 24.073, 91.159, 23.504, 91.706, 22.985, 91.870, 23.624, 92.146, 23.627, 92.673, 22.041},
+	}},
+	{name: "Belarus", rings: [][]float32{
+		{28.177, 56.169, 29.230, 55.918, 29.372, 55.670, 29.896, 55.789, 30.874, 55.551, 30.972, 55.082, 30.758, 54.812, 31.384, 54.157, 31.791, 53.975, 31.731, 53.794, 32.406, 53.618, 32.694, 53.351, 32.305, 53.133, 31.498, 53.167, 31.305, 53.074, 31.540, 52.742, 31.786, 52.102, 31.786, 52.102, 30.928, 52.042, 30.619, 51.823, 30.555, 51.320, 30.157, 51.416, 29.255, 51.368, 28.993, 51.602, 28.618, 51.428, 28.242, 51.572, 27.454, 51.592, 26.338, 51.832, 25.328, 51.911, 24.553, 51.888, 24.005, 51.617, 23.527, 51.578, 23.508, 52.024, 23.199, 52.487, 23.799, 52.691, 23.805, 53.090, 23.528, 53.470, 23.484, 53.912, 24.451, 53.906, 25.536, 54.282, 25.768, 54.847, 26.588, 55.167, 26.494, 55.615, 27.102, 55.783, 28.177, 56.169},
+	}},
+	{name: "Belgium", rings: [][]float32{
+		{6.157, 50.804, 6.043, 50.128, 5.782, 50.090, 5.674, 49.529, 4.799, 49.985, 4.286, 49.907, 3.588, 50.379, 3.123, 50.780, 2.658, 50.797, 2.514, 51.149, 3.315, 51.346, 3.315, 51.346, 3.315, 51.346, 4.047, 51.267, 4.974, 51.475, 5.607, 51.037, 6.157, 50.804},
+	}},
+	{name: "Belize", rings: [][]float32{
+		{-89.143, 17.808, -89.151, 17.955, -89.030, 18.002, -88.848, 17.883, -88.490, 18.487, -88.300, 18.500, -88.296, 18.353, -88.107, 18.349, -88.123, 18.077, -88.285, 17.644, -88.198, 17.489, -88.303, 17.132, -88.240, 17.036, -88.355, 16.531, -88.552, 16.265, -88.732, 16.234, -88.931, 15.887, -89.229, 15.887, -89.151, 17.016, -89.143, 17.808},
+	}},
+	{name: "Benin", rings: [][]float32{
+		{2.692, 6.259, 1.865, 6.142, 1.619, 6.832, 1.664, 9.129, 1.463, 9.335, 1.425, 9.825, 1.078, 10.176, 0.772, 10.471, 0.900, 10.997, 1.243, 11.111, 1.447, 11.548, 1.936, 11.641, 2.154, 11.940, 2.490, 12.233, 2.849, 12.236, 3.611, 11.660, 3.572, 11.328, 3.797, 10.735, 3.600, 10.332, 3.705, 10.063, 3.220, 9.444, 2.912, 9.138, 2.724, 8.507, 2.749, 7.871, 2.692, 6.259},
+	}},
+	{name: "Bhutan", rings: [][]float32{
+		{91.697, 27.772, 92.104, 27.453, 92.033, 26.838, 91.218, 26.809, 90.373, 26.876, 89.745, 26.719, 88.836, 27.099, 88.814, 27.299, 89.476, 28.043, 90.016, 28.296, 90.731, 28.065, 91.259, 28.041, 91.697, 27.772},
+	}},
+	{name: "Bolivia", rings: [][]float32{
+		{-69.530, -10.952, -68.786, -11.036, -68.271, -11.015, -68.048, -10.712, -67.174, -10.307, -66.647, -9.931, -65.338, -9.762, -65.445, -10.511, -65.322, -10.896, -65.402, -11.566, -64.316, -12.462, -63.196, -12.627, -62.803, -13.001, -62.127, -13.199, -61.713, -13.489, -61.084, -13.479, -60.503, -13.776, -60.459, -14.354, -60.264, -14.646, -60.251, -15.077, -60.543, -15.094, -60.158, -16.258, -58.241, -16.300, -58.388, -16.877, -58.281, -17.272, -57.735, -17.552, -57.498, -18.174, -57.676, -18.962, -57.950, -19.400, -57.854, -19.970, -58.166, -20.177, -58.183, -19.868, -59.115, -19.357, -60.044, -19.343, -61.786, -19.634, -62.266, -20.514, -62.291, -21.052, -62.685, -22.249, -62.846, -22.035, -63.987, -21.994, -64.377, -22.798, -64.965, -22.076, -66.273, -21.832, -67.107, -22.736, -67.828, -22.873, -68.220, -21.494, -68.757, -20.373, -68.442, -19.405, -68.967, -18.982, -69.100, -18.260, -69.590, -17.580, -68.960, -16.501, -69.390, -15.660, -69.160, -15.324, -69.340, -14.953, -68.949, -14.454, -68.929, -13.603, -68.880, -12.900, -68.665, -12.561, -69.530, -10.952},
+	}},
+	{name: "Bosnia and Herz.", rings: [][]float32{
+		{18.560, 42.650, 17.675, 43.029, 17.297, 43.446, 16.916, 43.668, 16.456, 44.041, 16.240, 44.351, 15.750, 44.819, 15.959, 45.234, 16.318, 45.004, 16.535, 45.212, 17.002, 45.234, 17.862, 45.068, 18.553, 45.082, 19.005, 44.860, 19.005, 44.860, 19.368, 44.863, 19.118, 44.423, 19.600, 44.038, 19.454, 43.568, 19.219, 43.524, 19.032, 43.433, 18.706, 43.200, 18.560, 42.650},
+	}},
+	{name: "Botswana", rings: [][]float32{
+		{29.432, -22.091, 28.017, -22.828, 27.119, -23.574, 26.786, -24.241, 26.486, -24.616, 25.942, -24.696, 25.766, -25.175, 25.665, -25.487, 25.025, -25.720, 24.211, -25.670, 23.734, -25.390, 23.312, -25.269, 22.824, -25.500, 22.580, -25.979, 22.106, -26.280, 21.606, -26.727, 20.890, -26.829, 20.666, -26.477, 20.759, -25.868, 20.166, -24.918, 19.896, -24.768, 19.895, -21.849, 20.881, -21.814, 20.911, -18.252, 21.655, -18.219, 23.197, -17.869, 23.579, -18.281, 24.217, -17.889, 24.521, -17.887, 25.084, -17.662, 25.264, -17.737, 25.649, -18.536, 25.850, -18.714, 26.165, -19.293, 27.297, -20.392, 27.725, -20.499, 27.727, -20.852, 28.021, -21.486, 28.795, -21.639, 29.432, -22.091},
+	}},
+	{name: "Brazil", rings: [][]float32{
+		{-53.374, -33.768, -53.651, -33.202, -53.210, -32.728, -53.788, -32.047, -54.572, -31.495, -55.602, -30.854, -55.973, -30.883, -56.976, -30.110, -57.625, -30.216, -56.291, -28.853, -55.162, -27.882, -54.491, -27.475, -53.649, -26.923, -53.628, -26.125, -54.130, -25.548, -54.625, -25.739, -54.429, -25.162, -54.293, -24.571, -54.293, -24.021, -54.653, -23.840, -55.028, -24.001, -55.401, -23.957, -55.518, -23.572, -55.611, -22.656, -55.798, -22.357, -56.473, -22.086, -56.882, -22.282, -57.937, -22.090, -57.871, -20.733, -58.166, -20.177, -57.854, -19.970, -57.950, -19.400, -57.676, -18.962, -57.498, -18.174, -57.735, -17.552, -58.281, -17.272, -58.388, -16.877, -58.241, -16.300, -60.158, -16.258, -60.543, -15.094, -60.251, -15.077, -60.264, -14.646, -60.459, -14.354, -60.503, -13.776, -61.084, -13.479, -61.713, -13.489, -62.127, -13.199, -62.803, -13.001, -63.196, -12.627, -64.316, -12.462, -65.402, -11.566, -65.322, -10.896, -65.445, -10.511, -65.338, -9.762, -66.647, -9.931, -67.174, -10.307, -68.048, -10.712, -68.271, -11.015, -68.786, -11.036, -69.530, -10.952, -70.094, -11.124, -70.549, -11.009, -70.482, -9.490, -71.302, -10.079, -72.185, -10.054, -72.563, -9.520, -73.227, -9.462, -73.015, -9.033, -73.571, -8.424, -73.987, -7.524, -73.723, -7.341, -73.724, -6.919, -73.120, -6.630, -73.220, -6.089, -72.965, -5.741, -72.892, -5.275, -71.748, -4.594, -70.929, -4.402, -70.795, -4.251, -69.894, -4.298, -69.444, -1.556, -69.420, -1.123, -69.577, -0.550, -70.021, -0.185, -70.016, 0.541, -69.452, 0.706, -69.252, 0.603, -69.219, 0.986, -69.805, 1.089, -69.817, 1.715, -67.869, 1.692, -67.538, 2.037, -67.260, 1.720, -67.065, 1.130, -66.876, 1.253, -66.326, 0.724, -65.548, 0.789, -65.355, 1.095, -64.611, 1.329, -64.199, 1.493, -64.083, 1.916, -63.369, 2.201, -63.423, 2.411, -64.270, 2.497, -64.409, 3.127, -64.368, 3.797, -64.816, 4.056, -64.629, 4.148, -63.888, 4.021, -63.093, 3.771, -62.805, 4.007, -62.085, 4.162, -60.967, 4.536, -60.601, 4.918, -60.734, 5.200, -60.214, 5.244, -59.981, 5.014, -60.111, 4.575, -59.767, 4.424, -59.538, 3.959, -59.815, 3.606, -59.975, 2.755, -59.719, 2.250, -59.646, 1.787, -59.031, 1.318, -58.540, 1.268, -58.429, 1.464, -58.113, 1.507, -57.661, 1.683, -57.336, 1.949, -56.783, 1.864, -56.539, 1.900, -55.996, 1.818, -55.906, 2.022, -56.073, 2.221, -55.973, 2.510, -55.570, 2.422, -55.098, 2.524, -54.525, 2.312, -54.088, 2.106, -53.779, 2.377, -53.555, 2.335, -53.418, 2.053, -52.940, 2.125, -52.556, 2.505, -52.249, 3.241, -51.658, 4.156, -51.317, 4.203, -51.070, 3.650, -50.509, 1.902, -49.974, 1.736, -49.947, 1.046, -50.699, 0.223, -50.388, -0.078, -48.621, -0.235, -48.584, -1.238, -47.825, -0.582, -46.567, -0.941, -44.906, -1.552, -44.418, -2.138, -44.582, -2.691, -43.419, -2.383, -41.473, -2.912, -39.979, -2.873, -38.500, -3.701, -37.223, -4.821, -36.453, -5.109, -35.598, -5.150, -35.235, -5.465, -34.896, -6.738, -34.730, -7.343, -35.128, -8.996, -35.637, -9.649, -37.047, -11.041, -37.684, -12.171, -38.424, -13.038, -38.674, -13.058, -38.953, -13.793, -38.882, -15.667, -39.161, -17.208, -39.267, -17.868, -39.584, -18.262, -39.761, -19.599, -40.775, -20.905, -40.945, -21.937, -41.754, -22.371, -41.988, -22.970, -43.075, -22.968, -44.648, -23.352, -45.352, -23.797, -46.472, -24.089, -47.649, -24.885, -48.495, -25.877, -48.641, -26.624, -48.475, -27.176, -48.662, -28.186, -48.888, -28.674, -49.587, -29.224, -50.697, -30.984, -51.576, -31.778, -52.256, -32.245, -52.712, -33.197, -53.374, -33.768},
+	}},
+	{name: "Brunei", rings: [][]float32{
+		{115.451, 5.448, 115.406, 4.955, 115.347, 4.317, 114.870, 4.348, 114.660, 4.008, 114.204, 4.526, 114.600, 4.900, 115.451, 5.448},
+	}},
+	{name: "Bulgaria", rings: [][]float32{
+		{22.657, 44.235, 22.945, 43.824, 23.332, 43.897, 24.101, 43.741, 25.569, 43.688, 26.065, 43.943, 27.242, 44.176, 27.970, 43.812, 28.558, 43.707, 28.039, 43.293, 27.674, 42.578, 27.997, 42.007, 27.136, 42.141, 26.117, 41.827, 26.106, 41.329, 25.197, 41.234, 24.493, 41.584, 23.692, 41.309, 22.952, 41.338, 22.881, 41.999, 22.381, 42.320, 22.545, 42.461, 22.437, 42.580, 22.605, 42.899, 22.986, 43.211, 22.500, 43.643, 22.410, 44.008, 22.657, 44.235},
+	}},
+	{name: "Burkina Faso", rings: [][]float32{
+		{-5.404, 10.371, -5.471, 10.951, -5.198, 11.375, -5.221, 11.714, -4.427, 12.543, -4.280, 13.228, -4.006, 13.472, -3.523, 13.338, -3.104, 13.541, -2.968, 13.798, -2.192, 14.246, -2.001, 14.559, -1.066, 14.974, -0.516, 15.116, -0.266, 14.924, 0.375, 14.929, 0.296, 14.444, 0.430, 13.989, 0.993, 13.336, 1.024, 12.852, 2.177, 12.625, 2.154, 11.940, 1.936, 11.641, 1.447, 11.548, 1.243, 11.111, 0.900, 10.997, 0.024, 11.019, -0.439, 11.098, -0.762, 10.937, -1.203, 11.010, -2.940, 10.963, -2.964, 10.395, -2.827, 9.642, -3.512, 9.900, -3.980, 9.862, -4.330, 9.611, -4.780, 9.822, -4.955, 10.153, -5.404, 10.371},
+	}},
+	{name: "Burundi", rings: [][]float32{
+		{30.470, -2.414, 30.528, -2.808, 30.743, -3.034, 30.752, -3.359, 30.506, -3.569, 30.116, -4.090, 29.754, -4.452, 29.340, -4.500, 29.276, -3.294, 29.025, -2.839, 29.632, -2.918, 29.938, -2.348, 30.470, -2.414},
+	}},
+	{name: "Cambodia", rings: [][]float32{
+		{102.585, 12.187, 102.348, 13.394, 102.988, 14.226, 104.281, 14.417, 105.219, 14.273, 106.044, 13.881, 106.496, 14.571, 107.383, 14.202, 107.615, 13.536, 107.491, 12.337, 105.811, 11.568, 106.250, 10.962, 105.200, 10.889, 104.334, 10.487, 103.497, 10.633, 103.091, 11.154, 102.585, 12.187},
+	}},
+	{name: "Cameroon", rings: [][]float32{
+		{14.496, 12.859, 14.893, 12.219, 14.960, 11.556, 14.924, 10.891, 15.468, 9.982, 14.909, 9.992, 14.627, 9.921, 14.171, 10.021, 13.954, 9.549, 14.544, 8.966, 14.980, 8.796, 15.121, 8.382, 15.436, 7.693, 15.279, 7.422, 14.777, 6.408, 14.537, 6.227, 14.459, 5.452, 14.559, 5.031, 14.478, 4.733, 14.951, 4.210, 15.036, 3.851, 15.405, 3.335, 15.863, 3.014, 15.907, 2.557, 16.013, 2.268, 15.941, 1.728, 15.146, 1.964, 14.338, 2.228, 13.076, 2.267, 12.951, 2.322, 12.359, 2.193, 11.752, 2.327, 11.276, 2.261, 9.649, 2.284, 9.795, 3.073, 9.404, 3.735, 8.948, 3.904, 8.745, 4.352, 8.489, 4.496, 8.500, 4.772, 8.758, 5.480, 9.233, 6.444, 9.523, 6.453, 10.118, 7.039, 10.497, 7.055, 11.059, 6.644, 11.746, 6.981, 11.839, 7.397, 12.064, 7.800, 12.219, 8.306, 12.754, 8.718, 12.955, 9.418, 13.168, 9.641, 13.309, 10.160, 13.573, 10.799, 14.415, 11.572, 14.468, 11.905, 14.577, 12.085, 14.181, 12.484, 14.214, 12.802, 14.496, 12.859},
+	}},
+	{name: "Canada", rings: [][]float32{
+		{-122.840, 49.000, -122.974, 49.003, -124.910, 49.985, -125.625, 50.417, -127.436, 50.831, -127.993, 51.716, -127.850, 52.330, -129.130, 52.755, -129.305, 53.562, -130.515, 54.288, -130.536, 54.803, -130.536, 54.803, -129.980, 55.285, -130.008, 55.916, -131.708, 56.552, -132.730, 57.693, -133.356, 58.410, -134.271, 58.861, -134.945, 59.271, -135.476, 59.788, -136.480, 59.464, -137.452, 58.905, -138.341, 59.562, -139.039, 60.000, -140.013, 60.277, -140.998, 60.306, -140.993, 66.000, -140.986, 69.712, -140.986, 69.712, -139.121, 69.471, -137.546, 68.990, -136.504, 68.898, -135.626, 69.315, -134.415, 69.627, -132.929, 69.505, -131.431, 69.945, -129.795, 70.194, -129.108, 69.779, -128.362, 70.013, -128.138, 70.484, -127.447, 70.377, -125.756, 69.481, -124.425, 70.158, -124.290, 69.400, -123.061, 69.564, -122.683, 69.856, -121.472, 69.798, -119.943, 69.378, -117.603, 69.011, -116.226, 68.842, -115.247, 68.906, -113.898, 68.399, -115.305, 67.903, -113.497, 67.688, -110.798, 67.806, -109.946, 67.981, -108.880, 67.381, -107.792, 67.887, -108.813, 68.312, -108.167, 68.654, -106.950, 68.700, -106.150, 68.800, -105.343, 68.561, -104.338, 68.018, -103.221, 68.098, -101.454, 67.647, -99.902, 67.806, -98.443, 67.782, -98.559, 68.404, -97.669, 68.579, -96.120, 68.239, -96.126, 67.293, -95.489, 68.091, -94.685, 68.064, -94.233, 69.069, -95.304, 69.686, -96.471, 70.090, -96.391, 71.195, -95.209, 71.921, -93.890, 71.760, -92.878, 71.319, -91.520, 70.191, -92.407, 69.700, -90.547, 69.498, -90.552, 68.475, -89.215, 69.259, -88.020, 68.615, -88.317, 67.873, -87.350, 67.199, -86.306, 67.921, -85.577, 68.785, -85.522, 69.882, -84.101, 69.805, -82.623, 69.658, -81.280, 69.162, -81.220, 68.666, -81.964, 68.133, -81.259, 67.597, -81.387, 67.111, -83.345, 66.412, -84.735, 66.257, -85.769, 66.558, -86.068, 66.056, -87.031, 65.213, -87.323, 64.776, -88.483, 64.099, -89.914, 64.033, -90.704, 63.610, -90.770, 62.960, -91.933, 62.835, -93.157, 62.025, -94.242, 60.899, -94.629, 60.110, -94.685, 58.949, -93.215, 58.782, -92.765, 57.846, -92.297, 57.087, -90.898, 57.285, -89.040, 56.852, -88.040, 56.472, -87.324, 55.999, -86.071, 55.724, -85.012, 55.303, -83.361, 55.245, -82.273, 55.148, -82.436, 54.282, -82.125, 53.277, -81.401, 52.158, -79.913, 51.208, -79.143, 51.534, -78.602, 52.562, -79.124, 54.141, -79.830, 54.668, -78.229, 55.136, -77.096, 55.837, -76.541, 56.534, -76.623, 57.203, -77.302, 58.052, -78.517, 58.805, -77.337, 59.853, -77.773, 60.758, -78.107, 62.320, -77.411, 62.551, -75.696, 62.278, -74.668, 62.181, -73.840, 62.444, -72.909, 62.105, -71.677, 61.525, -71.374, 61.137, -69.590, 61.061, -69.620, 60.221, -69.288, 58.957, -68.375, 58.801, -67.650, 58.212, -66.202, 58.767, -65.245, 59.871, -64.584, 60.336, -63.805, 59.443, -62.502, 58.167, -61.397, 56.967, -61.799, 56.339, -60.469, 55.775, -59.570, 55.204, -57.975, 54.945, -57.333, 54.627, -56.937, 53.780, -56.158, 53.647, -55.756, 53.270, -55.683, 52.147, -56.409, 51.771, -57.127, 51.420, -58.775, 51.064, -60.033, 50.243, -61.724, 50.080, -63.863, 50.291, -65.363, 50.298, -66.399, 50.229, -67.236, 49.512, -68.511, 49.068, -69.954, 47.745, -71.105, 46.822, -70.255, 46.986, -68.650, 48.300, -66.552, 49.133, -65.056, 49.233, -64.171, 48.742, -65.115, 48.071, -64.799, 46.993, -64.472, 46.238, -63.173, 45.739, -61.521, 45.884, -60.518, 47.008, -60.449, 46.283, -59.803, 45.920, -61.040, 45.265, -63.255, 44.670, -64.247, 44.266, -65.364, 43.545, -66.123, 43.619, -66.162, 44.465, -64.425, 45.292, -66.026, 45.259, -67.137, 45.138, -67.791, 45.703, -67.790, 47.066, -68.234, 47.355, -68.905, 47.185, -69.237, 47.448, -70.000, 46.693, -70.305, 45.915, -70.660, 45.460, -71.085, 45.305, -71.405, 45.255, -71.505, 45.008, -73.348, 45.007, -74.867, 45.000, -75.318, 44.816, -76.375, 44.096, -76.500, 44.018, -76.820, 43.629, -77.738, 43.629, -78.720, 43.625, -79.172, 43.466, -79.010, 43.270, -78.920, 42.965, -78.939, 42.864, -80.247, 42.366, -81.278, 42.209, -82.439, 41.675, -82.690, 41.675, -83.030, 41.833, -83.142, 41.976, -83.120, 42.080, -82.900, 42.430, -82.430, 42.980, -82.138, 43.571, -82.338, 44.440, -82.551, 45.348, -83.593, 45.817, -83.470, 45.995, -83.616, 46.117, -83.891, 46.117, -84.092, 46.275, -84.142, 46.512, -84.337, 46.409, -84.605, 46.440, -84.544, 46.539, -84.779, 46.637, -84.876, 46.900, -85.652, 47.220, -86.462, 47.553, -87.440, 47.940, -88.378, 48.303, -89.273, 48.020, -89.600, 48.010, -90.830, 48.270, -91.640, 48.140, -92.610, 48.450, -93.631, 48.609, -94.329, 48.671, -94.640, 48.840, -94.818, 49.389, -95.156, 49.384, -95.159, 49.000, -97.229, 49.001, -100.650, 49.000, -104.048, 49.000, -107.050, 49.000, -110.050, 49.000, -113.000, 49.000, -116.048, 49.000, -117.031, 49.000, -120.000, 49.000, -122.840, 49.000},
+		{-83.994, 62.453, -83.250, 62.914, -81.877, 62.905, -81.898, 62.711, -83.069, 62.159, -83.775, 62.182, -83.994, 62.453},
+		{-79.776, 72.803, -80.876, 73.333, -80.834, 73.693, -80.353, 73.760, -78.064, 73.652, -76.340, 73.103, -76.251, 72.826, -77.314, 72.856, -78.392, 72.877, -79.486, 72.742, -79.776, 72.803},
+		{-80.315, 62.086, -79.929, 62.386, -79.520, 62.364, -79.266, 62.159, -79.658, 61.633, -80.100, 61.718, -80.362, 62.016, -80.315, 62.086},
+		{-93.613, 74.980, -94.157, 74.592, -95.609, 74.667, -96.821, 74.928, -96.289, 75.378, -94.851, 75.647, -93.978, 75.296, -93.613, 74.980},
+		{-93.840, 77.520, -94.296, 77.491, -96.170, 77.555, -96.436, 77.835, -94.423, 77.820, -93.721, 77.634, -93.840, 77.520},
+		{-96.754, 78.766, -95.559, 78.418, -95.830, 78.057, -97.310, 77.851, -98.124, 78.083, -98.553, 78.458, -98.632, 78.872, -97.337, 78.832, -96.754, 78.766},
+		{-88.150, 74.392, -89.765, 74.516, -92.422, 74.838, -92.768, 75.387, -92.890, 75.883, -93.894, 76.319, -95.962, 76.441, -97.121, 76.751, -96.745, 77.161, -94.684, 77.098, -93.574, 76.776, -91.605, 76.779, -90.742, 76.450, -90.970, 76.074, -89.822, 75.848, -89.187, 75.610, -87.838, 75.566, -86.379, 75.482, -84.790, 75.699, -82.753, 75.784, -81.129, 75.714, -80.058, 75.337, -79.834, 74.923, -80.458, 74.657, -81.949, 74.442, -83.229, 74.564, -86.097, 74.410, -88.150, 74.392},
+		{-111.264, 78.153, -109.854, 77.996, -110.187, 77.697, -112.051, 77.409, -113.534, 77.732, -112.725, 78.051, -111.264, 78.153},
+		{-110.964, 78.804, -109.663, 78.602, -110.881, 78.407, -112.542, 78.408, -112.526, 78.551, -111.500, 78.850, -110.964, 78.804},
+		{-55.600, 51.317, -56.134, 50.687, -56.796, 49.812, -56.143, 50.150, -55.471, 49.936, -55.822, 49.587, -54.935, 49.313, -54.474, 49.557, -53.477, 49.249, -53.786, 48.517, -53.086, 48.688, -52.959, 48.157, -52.648, 47.536, -53.069, 46.655, -53.521, 46.618, -54.179, 46.807, -53.962, 47.625, -54.240, 47.752, -55.401, 46.885, -55.997, 46.920, -55.291, 47.390, -56.251, 47.633, -57.325, 47.573, -59.266, 47.603, -59.419, 47.899, -58.797, 48.252, -59.232, 48.523, -58.392, 49.126, -57.359, 50.718, -56.739, 51.287, -55.871, 51.632, -55.407, 51.588, -55.600, 51.317},
+		{-83.883, 65.110, -82.788, 64.767, -81.642, 64.455, -81.553, 63.980, -80.817, 64.057, -80.103, 63.726, -80.991, 63.411, -82.547, 63.652, -83.109, 64.102, -84.100, 63.570, -85.523, 63.052, -85.867, 63.637, -87.222, 63.541, -86.353, 64.036, -86.225, 64.823, -85.884, 65.739, -85.161, 65.657, -84.976, 65.218, -84.464, 65.372, -83.883, 65.110},
+		{-78.771, 72.352, -77.825, 72.750, -75.606, 72.244, -74.229, 71.767, -74.099, 71.331, -72.242, 71.557, -71.200, 70.920, -68.786, 70.525, -67.915, 70.122, -66.969, 69.186, -68.805, 68.720, -66.450, 68.067, -64.862, 67.848, -63.425, 66.928, -61.852, 66.862, -62.163, 66.160, -63.918, 64.999, -65.149, 65.426, -66.721, 66.388, -68.015, 66.263, -68.141, 65.690, -67.090, 65.108, -65.732, 64.648, -65.320, 64.383, -64.669, 63.393, -65.014, 62.674, -66.275, 62.945, -68.783, 63.746, -67.370, 62.884, -66.328, 62.280, -66.166, 61.931, -68.877, 62.330, -71.023, 62.911, -72.235, 63.398, -71.886, 63.680, -73.378, 64.194, -74.834, 64.679, -74.819, 64.389, -77.710, 64.230, -78.556, 64.573, -77.897, 65.309, -76.018, 65.327, -73.960, 65.455, -74.294, 65.812, -73.945, 66.311, -72.651, 67.285, -72.926, 67.727, -73.312, 68.069, -74.843, 68.555, -76.869, 68.895, -76.229, 69.148, -77.287, 69.770, -78.169, 69.826, -78.957, 70.167, -79.492, 69.872, -81.305, 69.743, -84.945, 69.967, -87.060, 70.260, -88.682, 70.411, -89.513, 70.762, -88.468, 71.218, -89.888, 71.223, -90.205, 72.235, -89.437, 73.129, -88.408, 73.538, -85.826, 73.804, -86.562, 73.157, -85.774, 72.534, -84.850, 73.340, -82.316, 73.751, -80.600, 72.717, -80.749, 72.062, -78.771, 72.352},
+		{-94.504, 74.135, -92.420, 74.100, -90.510, 73.857, -92.004, 72.966, -93.196, 72.772, -94.269, 72.025, -95.410, 72.062, -96.034, 72.940, -96.018, 73.437, -95.496, 73.862, -94.504, 74.135},
+		{-122.855, 76.117, -122.855, 76.117, -121.158, 76.865, -119.104, 77.512, -117.570, 77.498, -116.199, 77.645, -116.336, 76.877, -117.106, 76.530, -118.040, 76.481, -119.899, 76.053, -121.500, 75.900, -122.855, 76.117},
+		{-132.710, 54.040, -131.750, 54.120, -132.049, 52.985, -131.179, 52.180, -131.578, 52.182, -132.180, 52.640, -132.550, 53.100, -133.055, 53.411, -133.240, 53.851, -133.180, 54.170, -132.710, 54.040},
+		{-105.492, 79.302, -103.529, 79.165, -100.825, 78.800, -100.060, 78.325, -99.671, 77.908, -101.304, 78.019, -102.950, 78.343, -105.176, 78.380, -104.210, 78.677, -105.420, 78.918, -105.492, 79.302},
+		{-123.510, 48.510, -124.013, 48.371, -125.655, 48.825, -125.955, 49.180, -126.850, 49.530, -127.030, 49.815, -128.059, 49.995, -128.445, 50.539, -128.358, 50.771, -127.309, 50.553, -126.695, 50.401, -125.755, 50.295, -125.415, 49.950, -124.921, 49.475, -123.923, 49.062, -123.510, 48.510},
+		{-121.538, 74.449, -120.110, 74.241, -117.556, 74.186, -116.584, 73.896, -115.511, 73.475, -116.768, 73.223, -119.220, 72.520, -120.460, 71.820, -120.460, 71.384, -123.092, 70.902, -123.620, 71.340, -125.929, 71.869, -125.500, 72.292, -124.807, 73.023, -123.940, 73.680, -124.918, 74.293, -121.538, 74.449},
+		{-107.819, 75.846, -106.929, 76.013, -105.881, 75.969, -105.705, 75.480, -106.313, 75.005, -109.700, 74.850, -112.223, 74.417, -113.744, 74.394, -113.871, 74.720, -111.794, 75.162, -116.312, 75.043, -117.710, 75.222, -116.346, 76.199, -115.405, 76.479, -112.591, 76.141, -110.814, 75.549, -109.067, 75.473, -110.497, 76.430, -109.581, 76.794, -108.549, 76.678, -108.211, 76.202, -107.819, 75.846},
+		{-106.523, 73.076, -105.402, 72.673, -104.775, 71.698, -104.465, 70.993, -102.785, 70.498, -100.981, 70.024, -101.089, 69.584, -102.731, 69.504, -102.093, 69.120, -102.430, 68.753, -104.240, 68.910, -105.960, 69.180, -107.123, 69.119, -109.000, 68.780, -111.534, 68.630, -113.313, 68.536, -113.855, 69.007, -115.220, 69.280, -116.108, 69.168, -117.340, 69.960, -116.675, 70.067, -115.131, 70.237, -113.721, 70.192, -112.416, 70.366, -114.350, 70.600, -116.487, 70.520, -117.905, 70.541, -118.432, 70.909, -116.113, 71.309, -117.656, 71.295, -119.402, 71.559, -118.563, 72.308, -117.866, 72.706, -115.189, 73.315, -114.167, 73.121, -114.666, 72.653, -112.441, 72.955, -111.050, 72.450, -109.920, 72.961, -109.007, 72.633, -108.188, 71.651, -107.686, 72.065, -108.396, 73.090, -107.516, 73.236, -106.523, 73.076},
+		{-100.438, 72.706, -101.540, 73.360, -100.356, 73.844, -99.164, 73.633, -97.380, 73.760, -97.120, 73.470, -98.054, 72.991, -96.540, 72.560, -96.720, 71.660, -98.360, 71.273, -99.323, 71.356, -100.015, 71.738, -102.500, 72.510, -102.480, 72.830, -100.438, 72.706},
+		{-106.600, 73.600, -105.260, 73.640, -104.500, 73.420, -105.380, 72.760, -106.940, 73.460, -106.600, 73.600},
+		{-98.500, 76.720, -97.736, 76.257, -97.704, 75.743, -98.160, 75.000, -99.809, 74.897, -100.884, 75.057, -100.863, 75.641, -102.502, 75.564, -102.566, 76.337, -101.490, 76.305, -99.983, 76.646, -98.577, 76.589, -98.500, 76.720},
+		{-96.016, 80.602, -95.323, 80.907, -94.298, 80.977, -94.735, 81.206, -92.410, 81.257, -91.133, 80.723, -89.450, 80.509, -87.810, 80.320, -87.020, 79.660, -85.814, 79.337, -87.188, 79.039, -89.035, 78.287, -90.804, 78.215, -92.877, 78.343, -93.951, 78.751, -93.936, 79.114, -93.145, 79.380, -94.974, 79.372, -96.076, 79.705, -96.710, 80.158, -96.016, 80.602},
+		{-91.587, 81.894, -90.100, 82.085, -88.932, 82.118, -86.970, 82.280, -85.500, 82.652, -84.260, 82.600, -83.180, 82.320, -82.420, 82.860, -81.100, 83.020, -79.307, 83.131, -76.250, 83.172, -75.719, 83.064, -72.832, 83.233, -70.666, 83.170, -68.500, 83.106, -65.827, 83.028, -63.680, 82.900, -61.850, 82.629, -61.894, 82.362, -64.334, 81.928, -66.753, 81.725, -67.658, 81.501, -65.480, 81.507, -67.840, 80.900, -69.470, 80.617, -71.180, 79.800, -73.243, 79.634, -73.880, 79.430, -76.908, 79.323, -75.529, 79.198, -76.220, 79.019, -75.393, 78.526, -76.344, 78.183, -77.889, 77.900, -78.363, 77.509, -79.760, 77.210, -79.620, 76.983, -77.911, 77.022, -77.889, 76.778, -80.561, 76.178, -83.174, 76.454, -86.112, 76.299, -87.600, 76.420, -89.491, 76.472, -89.616, 76.952, -87.767, 77.178, -88.260, 77.900, -87.650, 77.970, -84.976, 77.539, -86.340, 78.180, -87.962, 78.372, -87.152, 78.759, -85.379, 78.997, -85.095, 79.345, -86.507, 79.736, -86.932, 80.251, -84.198, 80.208, -83.409, 80.100, -81.848, 80.464, -84.100, 80.580, -87.599, 80.516, -89.367, 80.856, -90.200, 81.260, -91.368, 81.553, -91.587, 81.894},
+		{-75.216, 67.444, -75.866, 67.149, -76.987, 67.099, -77.236, 67.588, -76.812, 68.149, -75.895, 68.287, -75.115, 68.010, -75.103, 67.582, -75.216, 67.444},
+		{-96.257, 69.490, -95.648, 69.108, -96.270, 68.757, -97.617, 69.060, -98.432, 68.951, -99.797, 69.400, -98.917, 69.710, -98.218, 70.144, -97.157, 69.860, -96.557, 69.680, -96.257, 69.490},
+		{-64.519, 49.873, -64.173, 49.957, -62.858, 49.706, -61.836, 49.289, -61.806, 49.105, -62.293, 49.087, -63.589, 49.401, -64.519, 49.873},
+		{-64.015, 47.036, -63.664, 46.550, -62.939, 46.416, -62.012, 46.443, -62.504, 46.033, -62.874, 45.968, -64.143, 46.393, -64.393, 46.727, -64.015, 47.036},
+	}},
+	{name: "Central African Rep.", rings: [][]float32{
+		{27.374, 5.234, 27.044, 5.128, 26.403, 5.151, 25.650, 5.256, 25.279, 5.170, 25.129, 4.927, 24.805, 4.897, 24.411, 5.109, 23.297, 4.610, 22.841, 4.710, 22.704, 4.633, 22.405, 4.029, 21.659, 4.224, 20.928, 4.323, 20.291, 4.692, 19.468, 5.032, 18.932, 4.710, 18.543, 4.202, 18.453, 3.504, 17.810, 3.560, 17.133, 3.728, 16.537, 3.198, 16.013, 2.268, 15.907, 2.557, 15.863, 3.014, 15.405, 3.335, 15.036, 3.851, 14.951, 4.210, 14.478, 4.733, 14.559, 5.031, 14.459, 5.452, 14.537, 6.227, 14.777, 6.408, 15.279, 7.422, 16.106, 7.497, 16.291, 7.754, 16.456, 7.735, 16.706, 7.508, 17.965, 7.891, 18.390, 8.281, 18.911, 8.631, 18.812, 8.983, 19.094, 9.075, 20.060, 9.013, 21.001, 9.476, 21.724, 10.567, 22.231, 10.972, 22.864, 11.142, 22.978, 10.714, 23.554, 10.089, 23.557, 9.681, 23.395, 9.265, 23.459, 8.954, 23.806, 8.666, 24.567, 8.229, 25.115, 7.825, 25.124, 7.500, 25.797, 6.979, 26.213, 6.547, 26.466, 5.947, 27.213, 5.551, 27.374, 5.234},
+	}},
+	{name: "Chad", rings: [][]float32{
+		{23.838, 19.580, 23.887, 15.611, 23.025, 15.681, 22.568, 14.944, 22.304, 14.327, 22.512, 14.093, 22.183, 13.786, 22.297, 13.372, 22.038, 12.955, 21.937, 12.588, 22.288, 12.646, 22.498, 12.260, 22.509, 11.679, 22.876, 11.385, 22.864, 11.142, 22.231, 10.972, 21.724, 10.567, 21.001, 9.476, 20.060, 9.013, 19.094, 9.075, 18.812, 8.983, 18.911, 8.631, 18.390, 8.281, 17.965, 7.891, 16.706, 7.508, 16.456, 7.735, 16.291, 7.754, 16.106, 7.497, 15.279, 7.422, 15.436, 7.693, 15.121, 8.382, 14.980, 8.796, 14.544, 8.966, 13.954, 9.549, 14.171, 10.021, 14.627, 9.921, 14.909, 9.992, 15.468, 9.982, 14.924, 10.891, 14.960, 11.556, 14.893, 12.219, 14.496, 12.859, 14.596, 13.330, 13.954, 13.353, 13.957, 13.997, 13.540, 14.367, 13.972, 15.684, 15.248, 16.627, 15.300, 17.928, 15.686, 19.957, 15.903, 20.388, 15.487, 20.730, 15.471, 21.048, 15.097, 21.309, 14.851, 22.863, 15.861, 23.410, 19.849, 21.495, 23.838, 19.580},
+	}},
+	{name: "Chile", rings: [][]float32{
+		{-68.634, -52.636, -68.633, -54.870, -67.562, -54.870, -66.960, -54.897, -67.291, -55.301, -68.149, -55.612, -68.640, -55.580, -69.232, -55.499, -69.958, -55.198, -71.006, -55.054, -72.264, -54.495, -73.285, -53.958, -74.663, -52.837, -73.838, -53.047, -72.434, -53.715, -71.108, -54.074, -70.592, -53.616, -70.267, -52.931, -69.346, -52.518, -68.634, -52.636},
+		{-69.590, -17.580, -69.100, -18.260, -68.967, -18.982, -68.442, -19.405, -68.757, -20.373, -68.220, -21.494, -67.828, -22.873, -67.107, -22.736, -66.985, -22.986, -67.328, -24.025, -68.418, -24.519, -68.386, -26.185, -68.595, -26.507, -68.296, -26.899, -69.001, -27.521, -69.656, -28.459, -70.014, -29.368, -69.919, -30.336, -70.535, -31.365, -70.074, -33.091, -69.815, -33.274, -69.817, -34.194, -70.388, -35.170, -70.365, -36.005, -71.122, -36.658, -71.119, -37.577, -70.815, -38.553, -71.414, -38.916, -71.681, -39.808, -71.916, -40.832, -71.747, -42.051, -72.149, -42.255, -71.915, -43.409, -71.464, -43.788, -71.794, -44.207, -71.330, -44.408, -71.223, -44.784, -71.659, -44.974, -71.552, -45.561, -71.917, -46.885, -72.447, -47.739, -72.331, -48.244, -72.648, -48.879, -73.415, -49.318, -73.328, -50.379, -72.976, -50.741, -72.310, -50.677, -72.329, -51.426, -71.915, -52.009, -69.498, -52.143, -68.572, -52.299, -69.461, -52.292, -69.943, -52.538, -70.845, -52.899, -71.006, -53.833, -71.430, -53.856, -72.558, -53.531, -73.703, -52.835, -73.703, -52.835, -74.947, -52.263, -75.260, -51.629, -74.977, -51.043, -75.480, -50.378, -75.608, -48.674, -75.183, -47.712, -74.127, -46.939, -75.644, -46.648, -74.692, -45.764, -74.352, -44.103, -73.240, -44.455, -72.718, -42.383, -73.389, -42.118, -73.701, -43.366, -74.332, -43.225, -74.018, -41.795, -73.677, -39.942, -73.218, -39.259, -73.506, -38.283, -73.588, -37.156, -73.167, -37.124, -72.553, -35.509, -71.862, -33.909, -71.438, -32.419, -71.669, -30.921, -71.370, -30.096, -71.490, -28.861, -70.905, -27.640, -70.725, -25.706, -70.404, -23.629, -70.091, -21.393, -70.164, -19.756, -70.373, -18.348, -69.858, -18.093, -69.590, -17.580},
+	}},
+	{name: "China", rings: [][]float32{
+		{109.475, 18.198, 108.655, 18.508, 108.626, 19.368, 109.119, 19.821, 110.212, 20.101, 110.787, 20.078, 111.010, 19.696, 110.571, 19.256, 110.339, 18.678, 109.475, 18.198},
+		{80.260, 42.350, 80.180, 42.920, 80.866, 43.180, 79.966, 44.918, 81.947, 45.317, 82.459, 45.540, 83.180, 47.330, 85.164, 47.001, 85.720, 47.453, 85.768, 48.456, 86.599, 48.549, 87.360, 49.215, 87.751, 49.297, 88.014, 48.599, 88.854, 48.069, 90.281, 47.694, 90.971, 46.888, 90.586, 45.720, 90.946, 45.286, 92.134, 45.115, 93.481, 44.975, 94.689, 44.352, 95.307, 44.241, 95.762, 43.319, 96.349, 42.726, 97.452, 42.749, 99.516, 42.525, 100.846, 42.664, 101.833, 42.515, 103.312, 41.907, 104.522, 41.908, 104.965, 41.597, 106.129, 42.134, 107.745, 42.482, 109.244, 42.519, 110.412, 42.871, 111.130, 43.407, 111.830, 43.743, 111.668, 44.073, 111.348, 44.457, 111.873, 45.102, 112.436, 45.012, 113.464, 44.809, 114.460, 45.340, 115.985, 45.727, 116.718, 46.388, 117.422, 46.673, 118.874, 46.805, 119.663, 46.693, 119.773, 47.048, 118.867, 47.747, 118.064, 48.067, 117.296, 47.698, 116.309, 47.853, 115.743, 47.727, 115.485, 48.135, 116.192, 49.135, 116.679, 49.889, 117.879, 49.511, 119.288, 50.143, 119.279, 50.583, 120.182, 51.644, 120.738, 51.964, 120.726, 52.516, 120.177, 52.754, 121.003, 53.251, 122.246, 53.432, 123.571, 53.459, 125.068, 53.161, 125.946, 52.793, 126.564, 51.784, 126.939, 51.354, 127.287, 50.740, 127.657, 49.760, 129.398, 49.441, 130.582, 48.730, 130.987, 47.790, 132.507, 47.789, 133.374, 48.183, 135.026, 48.478, 134.501, 47.578, 134.112, 47.212, 133.770, 46.117, 133.097, 45.144, 131.883, 45.321, 131.025, 44.968, 131.289, 44.112, 131.145, 42.930, 130.634, 42.903, 130.640, 42.395, 129.994, 42.985, 129.597, 42.425, 128.052, 41.994, 128.208, 41.467, 127.344, 41.503, 126.869, 41.817, 126.182, 41.107, 125.080, 40.570, 124.266, 39.928, 122.868, 39.638, 122.131, 39.170, 121.055, 38.897, 121.586, 39.361, 121.377, 39.750, 122.169, 40.422, 121.640, 40.946, 120.769, 40.593, 119.640, 39.898, 119.023, 39.252, 118.043, 39.204, 117.533, 38.738, 118.060, 38.061, 118.878, 37.897, 118.912, 37.448, 119.703, 37.156, 120.823, 37.870, 121.711, 37.481, 122.358, 37.454, 122.520, 36.931, 121.104, 36.651, 120.637, 36.111, 119.665, 35.610, 119.151, 34.910, 120.228, 34.360, 120.620, 33.377, 121.229, 32.460, 121.908, 31.692, 121.892, 30.949, 121.264, 30.676, 121.504, 30.143, 122.092, 29.833, 121.938, 29.018, 121.684, 28.226, 121.126, 28.136, 120.395, 27.053, 119.585, 25.741, 118.657, 24.547, 117.282, 23.625, 115.891, 22.783, 114.764, 22.668, 114.153, 22.224, 113.807, 22.548, 113.241, 22.051, 111.844, 21.550, 110.785, 21.397, 110.444, 20.341, 109.890, 20.282, 109.628, 21.008, 109.864, 21.395, 108.523, 21.715, 108.050, 21.552, 107.043, 21.812, 106.567, 22.218, 106.725, 22.794, 105.811, 22.977, 105.329, 23.352, 104.477, 22.819, 103.505, 22.704, 102.707, 22.709, 102.170, 22.465, 101.652, 22.318, 101.803, 21.174, 101.270, 21.202, 101.180, 21.437, 101.150, 21.850, 100.417, 21.559, 99.983, 21.743, 99.241, 22.118, 99.532, 22.949, 98.899, 23.143, 98.660, 24.063, 97.605, 23.897, 97.725, 25.084, 98.672, 25.919, 98.712, 26.744, 98.683, 27.509, 98.246, 27.747, 97.912, 28.336, 97.327, 28.262, 96.249, 28.411, 96.587, 28.831, 96.118, 29.453, 95.405, 29.032, 94.566, 29.277, 93.413, 28.641, 92.503, 27.897, 91.697, 27.772, 91.259, 28.041, 90.731, 28.065, 90.016, 28.296, 89.476, 28.043, 88.814, 27.299, 88.730, 28.087, 88.120, 27.877, 86.955, 27.974, 85.823, 28.204, 85.012, 28.643, 84.235, 28.840, 83.899, 29.320, 83.337, 29.464, 82.328, 30.115, 81.526, 30.423, 81.111, 30.183, 79.721, 30.883, 78.739, 31.516, 78.458, 32.618, 79.176, 32.484, 79.209, 32.994, 78.811, 33.506, 78.912, 34.322, 77.837, 35.494, 76.193, 35.898, 75.897, 36.667, 75.158, 37.133, 74.980, 37.420, 74.830, 37.990, 74.865, 38.379, 74.258, 38.607, 73.929, 38.506, 73.675, 39.431, 73.960, 39.660, 73.822, 39.894, 74.777, 40.366, 75.468, 40.562, 76.526, 40.428, 76.904, 41.066, 78.187, 41.185, 78.544, 41.582, 80.119, 42.124, 80.260, 42.350},
+	}},
+	{name: "Colombia", rings: [][]float32{
+		{-66.876, 1.253, -67.065, 1.130, -67.260, 1.720, -67.538, 2.037, -67.869, 1.692, -69.817, 1.715, -69.805, 1.089, -69.219, 0.986, -69.252, 0.603, -69.452, 0.706, -70.016, 0.541, -70.021, -0.185, -69.577, -0.550, -69.420, -1.123, -69.444, -1.556, -69.894, -4.298, -70.394, -3.767, -70.693, -3.743, -70.048, -2.725, -70.813, -2.257, -71.414, -2.343, -71.775, -2.170, -72.326, -2.434, -73.070, -2.309, -73.660, -1.260, -74.122, -1.003, -74.442, -0.531, -75.107, -0.057, -75.373, -0.152, -75.801, 0.085, -76.292, 0.416, -76.576, 0.257, -77.425, 0.396, -77.669, 0.826, -77.855, 0.810, -78.855, 1.381, -78.991, 1.691, -78.618, 1.766, -78.662, 2.267, -78.428, 2.630, -77.932, 2.697, -77.510, 3.325, -77.128, 3.850, -77.496, 4.088, -77.308, 4.668, -77.533, 5.583, -77.319, 5.845, -77.477, 6.691, -77.882, 7.224, -77.753, 7.710, -77.431, 7.638, -77.243, 7.935, -77.475, 8.524, -77.353, 8.671, -76.837, 8.639, -76.086, 9.337, -75.675, 9.443, -75.665, 9.774, -75.480, 10.619, -74.907, 11.083, -74.277, 11.102, -74.197, 11.310, -73.415, 11.227, -72.628, 11.732, -72.238, 11.956, -71.754, 12.437, -71.400, 12.376, -71.137, 12.113, -71.332, 11.776, -71.974, 11.609, -72.228, 11.109, -72.615, 10.822, -72.905, 10.450, -73.028, 9.737, -73.305, 9.152, -72.789, 9.085, -72.660, 8.625, -72.440, 8.405, -72.361, 8.003, -72.480, 7.633, -72.444, 7.424, -72.198, 7.340, -71.960, 6.992, -70.674, 7.088, -70.093, 6.960, -69.389, 6.100, -68.985, 6.207, -68.265, 6.153, -67.695, 6.267, -67.341, 6.095, -67.522, 5.557, -67.745, 5.221, -67.823, 4.504, -67.622, 3.839, -67.338, 3.542, -67.303, 3.318, -67.810, 2.821, -67.447, 2.600, -67.181, 2.251, -66.876, 1.253},
+	}},
+	{name: "Congo", rings: [][]float32{
+		{18.453, 3.504, 18.394, 2.900, 18.094, 2.366, 17.899, 1.742, 17.774, 0.856, 17.827, 0.289, 17.664, -0.058, 17.639, -0.425, 17.524, -0.744, 16.865, -1.226, 16.407, -1.741, 15.973, -2.712, 16.006, -3.535, 15.754, -3.855, 15.171, -4.344, 14.583, -4.970, 14.209, -4.793, 14.145, -4.510, 13.600, -4.500, 13.258, -4.883, 12.996, -4.781, 12.621, -4.438, 12.319, -4.606, 11.915, -5.038, 11.094, -3.979, 11.855, -3.427, 11.478, -2.766, 11.821, -2.514, 12.496, -2.392, 12.575, -1.949, 13.110, -2.429, 13.992, -2.471, 14.299, -1.998, 14.425, -1.333, 14.316, -0.553, 13.843, 0.039, 14.276, 1.197, 14.027, 1.396, 13.283, 1.314, 13.003, 1.831, 13.076, 2.267, 14.338, 2.228, 15.146, 1.964, 15.941, 1.728, 16.013, 2.268, 16.537, 3.198, 17.133, 3.728, 17.810, 3.560, 18.453, 3.504},
+	}},
+	{name: "Costa Rica", rings: [][]float32{
+		{-82.546, 9.566, -82.933, 9.477, -82.927, 9.074, -82.719, 8.926, -82.869, 8.807, -82.830, 8.626, -82.913, 8.424, -82.966, 8.225, -83.508, 8.447, -83.711, 8.657, -83.596, 8.830, -83.633, 9.051, -83.910, 9.291, -84.303, 9.487, -84.648, 9.616, -84.713, 9.908, -84.976, 10.087, -84.911, 9.796, -85.111, 9.557, -85.339, 9.835, -85.661, 9.933, -85.797, 10.135, -85.792, 10.439, -85.659, 10.754, -85.942, 10.895, -85.713, 11.088, -85.562, 11.217, -84.903, 10.952, -84.673, 11.083, -84.356, 10.999, -84.190, 10.793, -83.895, 10.727, -83.656, 10.939, -83.402, 10.395, -83.016, 9.993, -82.546, 9.566},
+	}},
+	{name: "Croatia", rings: [][]float32{
+		{16.565, 46.504, 16.883, 46.381, 17.630, 45.952, 18.456, 45.759, 18.830, 45.909, 19.073, 45.522, 19.390, 45.237, 19.005, 44.860, 18.553, 45.082, 17.862, 45.068, 17.002, 45.234, 16.535, 45.212, 16.318, 45.004, 15.959, 45.234, 15.750, 44.819, 16.240, 44.351, 16.456, 44.041, 16.916, 43.668, 17.297, 43.446, 17.675, 43.029, 18.560, 42.650, 18.450, 42.480, 18.450, 42.480, 17.510, 42.850, 16.930, 43.210, 16.015, 43.507, 15.174, 44.243, 15.376, 44.318, 14.920, 44.738, 14.902, 45.076, 14.259, 45.234, 13.952, 44.802, 13.657, 45.137, 13.679, 45.484, 13.715, 45.500, 14.412, 45.466, 14.595, 45.635, 14.935, 45.472, 15.328, 45.452, 15.324, 45.732, 15.672, 45.834, 15.769, 46.238, 16.565, 46.504},
+	}},
+	{name: "Cuba", rings: [][]float32{
+		{-82.268, 23.189, -81.404, 23.117, -80.619, 23.106, -79.680, 22.765, -79.281, 22.399, -78.347, 22.512, -77.993, 22.277, -77.146, 21.658, -76.524, 21.207, -76.195, 21.221, -75.598, 21.017, -75.671, 20.735, -74.934, 20.694, -74.178, 20.285, -74.297, 20.050, -74.962, 19.923, -75.635, 19.874, -76.324, 19.953, -77.755, 19.855, -77.085, 20.413, -77.493, 20.673, -78.137, 20.740, -78.483, 21.029, -78.720, 21.598, -79.285, 21.559, -80.217, 21.827, -80.518, 22.037, -81.821, 22.192, -82.170, 22.387, -81.795, 22.637, -82.776, 22.688, -83.494, 22.169, -83.909, 22.155, -84.052, 21.911, -84.547, 21.801, -84.975, 21.896, -84.447, 22.205, -84.230, 22.566, -83.778, 22.788, -83.268, 22.983, -82.510, 23.079, -82.268, 23.189},
+	}},
+	{name: "Cyprus", rings: [][]float32{
+		{32.732, 35.140, 32.920, 35.088, 33.191, 35.173, 33.384, 35.163, 33.456, 35.101, 33.476, 35.000, 33.526, 35.039, 33.675, 35.018, 33.866, 35.094, 33.974, 35.059, 34.005, 34.978, 32.980, 34.572, 32.490, 34.702, 32.257, 35.103, 32.732, 35.140},
+	}},
+	{name: "Czechia", rings: [][]float32{
+		{15.017, 51.107, 15.491, 50.785, 16.239, 50.698, 16.176, 50.423, 16.719, 50.216, 16.869, 50.474, 17.555, 50.362, 17.649, 50.049, 18.393, 49.989, 18.853, 49.496, 18.555, 49.495, 18.400, 49.315, 18.170, 49.272, 18.105, 49.044, 17.914, 48.996, 17.886, 48.903, 17.545, 48.800, 17.102, 48.817, 16.960, 48.597, 16.499, 48.786, 16.030, 48.734, 15.253, 49.039, 14.901, 48.964, 14.339, 48.555, 13.596, 48.877, 13.031, 49.307, 12.521, 49.547, 12.415, 49.969, 12.240, 50.266, 12.967, 50.484, 13.338, 50.733, 14.056, 50.927, 14.307, 51.117, 14.571, 51.002, 15.017, 51.107},
+	}},
+	{name: "Côte d'Ivoire", rings: [][]float32{
+		{-8.030, 10.207, -7.900, 10.297, -7.623, 10.147, -6.851, 10.139, -6.666, 10.431, -6.494, 10.411, -6.205, 10.524, -6.050, 10.096, -5.817, 10.223, -5.404, 10.371, -4.955, 10.153, -4.780, 9.822, -4.330, 9.611, -3.980, 9.862, -3.512, 9.900, -2.827, 9.642, -2.562, 8.220, -2.984, 7.380, -3.244, 6.250, -2.811, 5.389, -2.856, 4.994, -3.311, 4.984, -4.009, 5.180, -4.650, 5.168, -5.834, 4.994, -6.529, 4.705, -7.519, 4.338, -7.712, 4.365, -7.635, 5.188, -7.540, 5.313, -7.570, 5.707, -7.994, 6.126, -8.311, 6.193, -8.603, 6.468, -8.385, 6.912, -8.485, 7.395, -8.439, 7.686, -8.281, 7.687, -8.222, 8.123, -8.299, 8.316, -8.203, 8.455, -7.832, 8.576, -8.079, 9.376, -8.310, 9.790, -8.229, 10.129, -8.030, 10.207},
+	}},
+	{name: "Dem. Rep. Congo", rings: [][]float32{
+		{29.340, -4.500, 29.520, -5.420, 29.420, -5.940, 29.620, -6.520, 30.200, -7.080, 30.740, -8.340, 30.740, -8.340, 30.346, -8.238, 29.003, -8.407, 28.735, -8.527, 28.450, -9.165, 28.674, -9.606, 28.496, -10.790, 28.372, -11.794, 28.642, -11.972, 29.342, -12.361, 29.616, -12.179, 29.700, -13.257, 28.934, -13.249, 28.524, -12.699, 28.155, -12.272, 27.389, -12.133, 27.164, -11.609, 26.553, -11.924, 25.752, -11.785, 25.418, -11.331, 24.783, -11.239, 24.315, -11.263, 24.257, -10.952, 23.912, -10.927, 23.457, -10.868, 22.837, -11.018, 22.403, -10.993, 22.155, -11.085, 22.209, -9.895, 21.875, -9.524, 21.802, -8.909, 21.949, -8.306, 21.746, -7.920, 21.728, -7.291, 20.515, -7.300, 20.602, -6.939, 20.092, -6.943, 20.038, -7.116, 19.418, -7.155, 19.167, -7.738, 19.017, -7.988, 18.464, -7.847, 18.134, -7.988, 17.473, -8.069, 17.090, -7.546, 16.860, -7.222, 16.573, -6.623, 16.327, -5.877, 13.376, -5.864, 13.025, -5.984, 12.735, -5.966, 12.322, -6.100, 12.182, -5.790, 12.437, -5.684, 12.468, -5.248, 12.632, -4.991, 12.996, -4.781, 13.258, -4.883, 13.600, -4.500, 14.145, -4.510, 14.209, -4.793, 14.583, -4.970, 15.171, -4.344, 15.754, -3.855, 16.006, -3.535, 15.973, -2.712, 16.407, -1.741, 16.865, -1.226, 17.524, -0.744, 17.639, -0.425, 17.664, -0.058, 17.827, 0.289, 17.774, 0.856, 17.899, 1.742, 18.094, 2.366, 18.394, 2.900, 18.453, 3.504, 18.543, 4.202, 18.932, 4.710, 19.468, 5.032, 20.291, 4.692, 20.928, 4.323, 21.659, 4.224, 22.405, 4.029, 22.704, 4.633, 22.841, 4.710, 23.297, 4.610, 24.411, 5.109, 24.805, 4.897, 25.129, 4.927, 25.279, 5.170, 25.650, 5.256, 26.403, 5.151, 27.044, 5.128, 27.374, 5.234, 27.980, 4.408, 28.429, 4.287, 28.697, 4.455, 29.159, 4.389, 29.716, 4.601, 29.953, 4.174, 30.834, 3.509, 30.834, 3.509, 30.773, 2.340, 31.174, 2.204, 30.853, 1.849, 30.469, 1.584, 30.086, 1.062, 29.876, 0.597, 29.820, -0.205, 29.588, -0.587, 29.579, -1.341, 29.292, -1.620, 29.255, -2.215, 29.117, -2.292, 29.025, -2.839, 29.276, -3.294, 29.340, -4.500},
+	}},
+	{name: "Denmark", rings: [][]float32{
+		{9.922, 54.983, 9.282, 54.831, 8.526, 54.963, 8.120, 55.518, 8.090, 56.540, 8.257, 56.810, 8.543, 57.110, 9.424, 57.172, 9.776, 57.448, 10.580, 57.730, 10.546, 57.216, 10.250, 56.890, 10.370, 56.610, 10.912, 56.459, 10.668, 56.081, 10.370, 56.190, 9.650, 55.470, 9.922, 54.983},
+		{12.371, 56.111, 12.690, 55.610, 12.090, 54.800, 11.044, 55.365, 10.904, 55.780, 12.371, 56.111},
+	}},
+	{name: "Djibouti", rings: [][]float32{
+		{42.352, 12.542, 42.780, 12.455, 43.081, 12.700, 43.318, 12.390, 43.286, 11.975, 42.716, 11.736, 43.145, 11.462, 42.777, 10.927, 42.555, 11.105, 42.314, 11.034, 41.756, 11.051, 41.740, 11.355, 41.662, 11.631, 42.000, 12.100, 42.352, 12.542},
+	}},
+	{name: "Dominican Rep.", rings: [][]float32{
+		{-71.708, 18.045, -71.688, 18.317, -71.945, 18.617, -71.701, 18.785, -71.625, 19.170, -71.712, 19.714, -71.587, 19.885, -70.807, 19.880, -70.214, 19.623, -69.951, 19.648, -69.769, 19.293, -69.222, 19.313, -69.254, 19.015, -68.809, 18.979, -68.318, 18.612, -68.689, 18.205, -69.165, 18.423, -69.624, 18.381, -69.953, 18.428, -70.133, 18.246, -70.517, 18.184, -70.669, 18.427, -71.000, 18.283, -71.400, 17.599, -71.658, 17.758, -71.708, 18.045},
+	}},
+	{name: "Ecuador", rings: [][]float32{
+		{-75.373, -0.152, -75.234, -0.911, -75.545, -1.562, -76.635, -2.609, -77.838, -3.003, -78.451, -3.873, -78.640, -4.548, -79.205, -4.959, -79.625, -4.454, -80.029, -4.346, -80.442, -4.426, -80.469, -4.059, -80.184, -3.821, -80.303, -3.405, -79.770, -2.658, -79.987, -2.221, -80.369, -2.685, -80.968, -2.247, -80.765, -1.965, -80.934, -1.057, -80.583, -0.907, -80.399, -0.284, -80.021, 0.360, -80.091, 0.768, -79.543, 0.983, -78.855, 1.381, -77.855, 0.810, -77.669, 0.826, -77.425, 0.396, -76.576, 0.257, -76.292, 0.416, -75.801, 0.085, -75.373, -0.152},
+	}},
+	{name: "Egypt", rings: [][]float32{
+		{36.866, 22.000, 32.900, 22.000, 29.020, 22.000, 25.000, 22.000, 25.000, 25.683, 25.000, 29.239, 24.700, 30.044, 24.958, 30.662, 24.803, 31.089, 25.165, 31.569, 26.495, 31.586, 27.458, 31.321, 28.450, 31.026, 28.914, 30.870, 29.683, 31.187, 30.095, 31.473, 30.977, 31.556, 31.688, 31.430, 31.960, 30.934, 32.192, 31.260, 32.994, 31.024, 33.773, 30.967, 34.265, 31.219, 34.265, 31.219, 34.823, 29.761, 34.923, 29.501, 34.642, 29.099, 34.427, 28.344, 34.155, 27.823, 33.921, 27.649, 33.588, 27.971, 33.137, 28.418, 32.423, 29.851, 32.320, 29.760, 32.735, 28.705, 33.349, 27.700, 34.105, 26.142, 34.474, 25.599, 34.795, 25.034, 35.692, 23.927, 35.494, 23.752, 35.526, 23.102, 36.691, 22.205, 36.866, 22.000},
+	}},
+	{name: "El Salvador", rings: [][]float32{
+		{-89.353, 14.424, -89.059, 14.340, -88.843, 14.141, -88.541, 13.980, -88.504, 13.845, -88.065, 13.965, -87.860, 13.893, -87.724, 13.785, -87.793, 13.384, -87.904, 13.149, -88.483, 13.164, -88.843, 13.260, -89.257, 13.459, -89.812, 13.521, -90.096, 13.735, -90.065, 13.882, -89.722, 14.134, -89.534, 14.245, -89.587, 14.363, -89.353, 14.424},
+	}},
+	{name: "Eq. Guinea", rings: [][]float32{
+		{9.649, 2.284, 11.276, 2.261, 11.285, 1.058, 9.830, 1.068, 9.493, 1.010, 9.306, 1.161, 9.649, 2.284},
+	}},
+	{name: "Eritrea", rings: [][]float32{
+		{36.430, 14.422, 36.323, 14.822, 36.754, 16.292, 36.853, 16.957, 37.167, 17.263, 37.904, 17.428, 38.410, 17.998, 38.991, 16.841, 39.266, 15.923, 39.814, 15.436, 41.179, 14.491, 41.735, 13.921, 42.277, 13.344, 42.590, 13.000, 43.081, 12.700, 42.780, 12.455, 42.352, 12.542, 42.010, 12.866, 41.599, 13.452, 41.155, 13.773, 40.897, 14.119, 40.026, 14.520, 39.341, 14.532, 39.099, 14.741, 38.513, 14.505, 37.906, 14.959, 37.594, 14.213, 36.430, 14.422},
+	}},
+	{name: "Estonia", rings: [][]float32{
+		{27.981, 59.475, 27.981, 59.475, 28.132, 59.301, 27.420, 58.725, 27.717, 57.792, 27.288, 57.475, 26.464, 57.476, 25.603, 57.848, 25.165, 57.970, 24.313, 57.793, 24.429, 58.383, 24.061, 58.257, 23.427, 58.613, 23.340, 59.187, 24.604, 59.466, 25.864, 59.611, 26.949, 59.446, 27.981, 59.475, 27.981, 59.475},
+	}},
+	{name: "Ethiopia", rings: [][]float32{
+		{47.789, 8.003, 44.964, 5.002, 43.661, 4.958, 42.770, 4.253, 42.129, 4.234, 41.855, 3.919, 41.172, 3.919, 40.768, 4.257, 39.855, 3.839, 39.559, 3.422, 38.893, 3.501, 38.671, 3.616, 38.437, 3.589, 38.121, 3.599, 36.855, 4.448, 36.159, 4.448, 35.817, 4.777, 35.817, 5.338, 35.298, 5.506, 34.707, 6.594, 34.250, 6.826, 34.075, 7.226, 33.568, 7.713, 32.954, 7.785, 33.295, 8.355, 33.825, 8.379, 33.975, 8.685, 33.962, 9.584, 34.257, 10.630, 34.731, 10.910, 34.832, 11.319, 35.260, 12.083, 35.864, 12.578, 36.270, 13.563, 36.430, 14.422, 37.594, 14.213, 37.906, 14.959, 38.513, 14.505, 39.099, 14.741, 39.341, 14.532, 40.026, 14.520, 40.897, 14.119, 41.155, 13.773, 41.599, 13.452, 42.010, 12.866, 42.352, 12.542, 42.000, 12.100, 41.662, 11.631, 41.740, 11.355, 41.756, 11.051, 42.314, 11.034, 42.555, 11.105, 42.777, 10.927, 42.559, 10.573, 42.928, 10.022, 43.297, 9.540, 43.679, 9.184, 46.948, 7.997, 47.789, 8.003},
+	}},
+	{name: "Falkland Is.", rings: [][]float32{
+		{-61.200, -51.850, -60.000, -51.250, -59.150, -51.500, -58.550, -51.100, -57.750, -51.550, -58.050, -51.900, -59.400, -52.200, -59.850, -51.850, -60.700, -52.300, -61.200, -51.850},
+	}},
+	{name: "Fiji", rings: [][]float32{
+		{180.000, -16.067, 180.000, -16.555, 179.364, -16.801, 178.725, -17.012, 178.597, -16.639, 179.097, -16.434, 179.414, -16.379, 180.000, -16.067},
+		{178.126, -17.505, 178.374, -17.340, 178.718, -17.628, 178.553, -18.151, 177.933, -18.288, 177.381, -18.164, 177.285, -17.725, 177.671, -17.381, 178.126, -17.505},
+		{-179.793, -16.021, -179.917, -16.502, -180.000, -16.555, -180.000, -16.067, -179.793, -16.021},
+	}},
+	{name: "Finland", rings: [][]float32{
+		{28.592, 69.065, 28.446, 68.365, 29.977, 67.698, 29.055, 66.944, 30.218, 65.806, 29.544, 64.949, 30.445, 64.204, 30.036, 63.553, 31.516, 62.868, 31.140, 62.358, 30.211, 61.780, 28.070, 60.504, 28.070, 60.504, 28.070, 60.504, 26.255, 60.424, 24.497, 60.057, 22.870, 59.846, 22.291, 60.392, 21.322, 60.720, 21.545, 61.705, 21.059, 62.607, 21.536, 63.190, 22.443, 63.818, 24.731, 64.902, 25.398, 65.111, 25.294, 65.534, 23.903, 66.007, 23.566, 66.396, 23.539, 67.936, 21.979, 68.617, 20.646, 69.106, 21.245, 69.370, 22.356, 68.842, 23.662, 68.891, 24.736, 68.650, 25.689, 69.092, 26.180, 69.825, 27.732, 70.164, 29.016, 69.766, 28.592, 69.065},
+	}},
+	{name: "Fr. S. Antarctic Lands", rings: [][]float32{
+		{68.935, -48.625, 69.580, -48.940, 70.525, -49.065, 70.560, -49.255, 70.280, -49.710, 68.745, -49.775, 68.720, -49.242, 68.868, -48.830, 68.935, -48.625},
+	}},
+	{name: "France", rings: [][]float32{
+		{-51.658, 4.156, -52.249, 3.241, -52.556, 2.505, -52.940, 2.125, -53.418, 2.053, -53.555, 2.335, -53.779, 2.377, -54.088, 2.106, -54.525, 2.312, -54.270, 2.732, -54.182, 3.190, -54.007, 3.620, -54.400, 4.213, -54.479, 4.897, -53.958, 5.757, -53.618, 5.647, -52.882, 5.410, -51.823, 4.566, -51.658, 4.156},
+		{6.186, 49.464, 6.658, 49.202, 8.099, 49.018, 7.594, 48.333, 7.467, 47.621, 7.192, 47.450, 6.737, 47.542, 6.769, 47.288, 6.037, 46.726, 6.023, 46.273, 6.500, 46.430, 6.844, 45.991, 6.802, 45.709, 7.097, 45.333, 6.750, 45.029, 7.008, 44.255, 7.550, 44.128, 7.435, 43.694, 6.529, 43.129, 4.557, 43.400, 3.100, 43.075, 2.986, 42.473, 1.827, 42.343, 0.702, 42.796, 0.338, 42.580, -1.503, 43.034, -1.901, 43.423, -1.384, 44.023, -1.194, 46.015, -2.226, 47.064, -2.963, 47.570, -4.492, 47.955, -4.592, 48.684, -3.296, 48.902, -1.617, 48.644, -1.933, 49.776, -0.989, 49.347, 1.339, 50.127, 1.639, 50.947, 2.514, 51.149, 2.658, 50.797, 3.123, 50.780, 3.588, 50.379, 4.286, 49.907, 4.799, 49.985, 5.674, 49.529, 5.898, 49.443, 6.186, 49.464},
+		{8.746, 42.628, 9.390, 43.010, 9.560, 42.152, 9.230, 41.380, 8.776, 41.584, 8.544, 42.257, 8.746, 42.628},
+	}},
+	{name: "Gabon", rings: [][]float32{
+		{11.276, 2.261, 11.752, 2.327, 12.359, 2.193, 12.951, 2.322, 13.076, 2.267, 13.003, 1.831, 13.283, 1.314, 14.027, 1.396, 14.276, 1.197, 13.843, 0.039, 14.316, -0.553, 14.425, -1.333, 14.299, -1.998, 13.992, -2.471, 13.110, -2.429, 12.575, -1.949, 12.496, -2.392, 11.821, -2.514, 11.478, -2.766, 11.855, -3.427, 11.094, -3.979, 10.066, -2.969, 9.405, -2.144, 8.798, -1.111, 8.830, -0.779, 9.048, -0.459, 9.291, 0.269, 9.493, 1.010, 9.830, 1.068, 11.285, 1.058, 11.276, 2.261},
+	}},
+	{name: "Gambia", rings: [][]float32{
+		{-16.714, 13.595, -15.625, 13.624, -15.399, 13.860, -15.082, 13.876, -14.687, 13.630, -14.377, 13.626, -14.047, 13.794, -13.845, 13.505, -14.278, 13.281, -14.712, 13.298, -15.141, 13.510, -15.512, 13.279, -15.691, 13.270, -15.931, 13.130, -16.842, 13.151, -16.714, 13.595},
+	}},
+	{name: "Georgia", rings: [][]float32{
+		{39.955, 43.435, 40.077, 43.553, 40.922, 43.382, 42.394, 43.220, 43.756, 42.741, 43.931, 42.555, 44.538, 42.712, 45.470, 42.503, 45.776, 42.092, 46.405, 41.861, 46.145, 41.723, 46.638, 41.182, 46.502, 41.064, 45.963, 41.124, 45.217, 41.411, 44.972, 41.248, 43.583, 41.092, 42.620, 41.583, 41.554, 41.536, 41.703, 41.963, 41.453, 42.645, 40.875, 43.014, 40.321, 43.129, 39.955, 43.435},
+	}},
+	{name: "Germany", rings: [][]float32{
+		{14.120, 53.757, 14.353, 53.248, 14.075, 52.981, 14.438, 52.625, 14.685, 52.090, 14.607, 51.745, 15.017, 51.107, 14.571, 51.002, 14.307, 51.117, 14.056, 50.927, 13.338, 50.733, 12.967, 50.484, 12.240, 50.266, 12.415, 49.969, 12.521, 49.547, 13.031, 49.307, 13.596, 48.877, 13.243, 48.416, 12.884, 48.289, 13.026, 47.638, 12.933, 47.468, 12.621, 47.672, 12.141, 47.703, 11.426, 47.524, 10.545, 47.566, 10.402, 47.302, 9.896, 47.580, 9.594, 47.525, 8.523, 47.831, 8.317, 47.614, 7.467, 47.621, 7.594, 48.333, 8.099, 49.018, 6.658, 49.202, 6.186, 49.464, 6.243, 49.902, 6.043, 50.128, 6.157, 50.804, 5.989, 51.852, 6.589, 51.852, 6.843, 52.228, 7.092, 53.144, 6.905, 53.482, 7.100, 53.694, 7.936, 53.748, 8.122, 53.528, 8.801, 54.021, 8.572, 54.396, 8.526, 54.963, 9.282, 54.831, 9.922, 54.983, 9.940, 54.597, 10.950, 54.364, 10.939, 54.009, 11.956, 54.196, 12.518, 54.470, 13.647, 54.076, 14.120, 53.757},
+	}},
+	{name: "Ghana", rings: [][]float32{
+		{0.024, 11.019, -0.050, 10.707, 0.368, 10.191, 0.366, 9.465, 0.461, 8.677, 0.712, 8.312, 0.491, 7.412, 0.570, 6.914, 0.837, 6.280, 1.060, 5.929, -0.508, 5.343, -1.064, 5.001, -1.965, 4.710, -2.856, 4.994, -2.811, 5.389, -3.244, 6.250, -2.984, 7.380, -2.562, 8.220, -2.827, 9.642, -2.964, 10.395, -2.940, 10.963, -1.203, 11.010, -0.762, 10.937, -0.439, 11.098, 0.024, 11.019},
+	}},
+	{name: "Greece", rings: [][]float32{
+		{26.290, 35.300, 26.165, 35.005, 24.725, 34.920, 24.735, 35.085, 23.515, 35.280, 23.700, 35.705, 24.247, 35.368, 25.025, 35.425, 25.769, 35.354, 25.745, 35.180, 26.290, 35.300},
+		{22.952, 41.338, 23.692, 41.309, 24.493, 41.584, 25.197, 41.234, 26.106, 41.329, 26.117, 41.827, 26.604, 41.562, 26.295, 40.936, 26.057, 40.824, 25.448, 40.853, 24.926, 40.947, 23.715, 40.687, 24.408, 40.125, 23.900, 39.962, 23.343, 39.961, 22.814, 40.476, 22.626, 40.257, 22.850, 39.659, 23.350, 39.190, 22.973, 38.971, 23.530, 38.510, 24.025, 38.220, 24.040, 37.655, 23.115, 37.920, 23.410, 37.410, 22.775, 37.305, 23.154, 36.423, 22.490, 36.410, 21.670, 36.845, 21.295, 37.645, 21.120, 38.310, 20.730, 38.770, 20.218, 39.340, 20.150, 39.625, 20.615, 40.110, 20.675, 40.435, 21.000, 40.580, 21.020, 40.843, 21.674, 40.931, 22.055, 41.150, 22.597, 41.130, 22.762, 41.305, 22.952, 41.338},
+	}},
+	{name: "Greenland", rings: [][]float32{
+		{-46.764, 82.628, -43.406, 83.225, -39.898, 83.180, -38.622, 83.549, -35.088, 83.645, -27.100, 83.520, -20.845, 82.727, -22.692, 82.342, -26.518, 82.298, -31.900, 82.200, -31.396, 82.022, -27.857, 82.132, -24.844, 81.787, -22.903, 82.093, -22.072, 81.734, -23.170, 81.153, -20.624, 81.525, -15.768, 81.912, -12.770, 81.719, -12.209, 81.292, -16.285, 80.580, -16.850, 80.350, -20.046, 80.177, -17.730, 80.129, -18.900, 79.400, -19.705, 78.751, -19.674, 77.639, -18.473, 76.986, -20.035, 76.944, -21.679, 76.628, -19.834, 76.098, -19.599, 75.248, -20.668, 75.156, -19.373, 74.296, -21.594, 74.224, -20.435, 73.817, -20.762, 73.464, -22.172, 73.310, -23.566, 73.307, -22.313, 72.629, -22.300, 72.184, -24.278, 72.598, -24.793, 72.330, -23.443, 72.080, -22.133, 71.469, -21.754, 70.664, -23.536, 70.471, -24.307, 70.856, -25.543, 71.431, -25.201, 70.752, -26.363, 70.226, -23.727, 70.184, -22.349, 70.129, -25.029, 69.259, -27.747, 68.470, -30.674, 68.125, -31.777, 68.121, -32.811, 67.735, -34.202, 66.680, -36.353, 65.979, -37.044, 65.938, -38.375, 65.692, -39.812, 65.458, -40.669, 64.840, -40.683, 64.139, -41.189, 63.482, -42.819, 62.682, -42.417, 61.901, -42.866, 61.074, -43.378, 60.098, -44.788, 60.037, -46.264, 60.853, -48.263, 60.858, -49.233, 61.407, -49.900, 62.383, -51.633, 63.627, -52.140, 64.278, -52.277, 65.177, -53.662, 66.100, -53.302, 66.837, -53.969, 67.189, -52.980, 68.358, -51.475, 68.730, -51.080, 69.148, -50.871, 69.929, -52.014, 69.575, -52.558, 69.426, -53.456, 69.284, -54.683, 69.610, -54.750, 70.289, -54.359, 70.821, -53.431, 70.836, -51.390, 70.570, -53.109, 71.205, -54.004, 71.547, -55.000, 71.407, -55.835, 71.654, -54.718, 72.586, -55.326, 72.959, -56.120, 73.650, -57.324, 74.710, -58.597, 75.099, -58.585, 75.517, -61.269, 76.102, -63.392, 76.175, -66.064, 76.135, -68.504, 76.061, -69.665, 76.380, -71.403, 77.009, -68.777, 77.323, -66.764, 77.376, -71.043, 77.636, -73.297, 78.044, -73.159, 78.433, -69.373, 78.914, -65.711, 79.394, -65.324, 79.758, -68.023, 80.117, -67.151, 80.516, -63.689, 81.214, -62.234, 81.321, -62.651, 81.770, -60.282, 82.034, -57.207, 82.191, -54.134, 82.200, -53.043, 81.888, -50.391, 82.439, -48.004, 82.065, -46.600, 81.986, -44.523, 81.661, -46.901, 82.200, -46.764, 82.628},
+	}},
+	{name: "Guatemala", rings: [][]float32{
+		{-92.228, 14.539, -92.203, 14.830, -92.087, 15.065, -92.229, 15.251, -91.748, 16.067, -90.464, 16.070, -90.439, 16.410, -90.601, 16.471, -90.712, 16.687, -91.082, 16.918, -91.454, 17.252, -91.002, 17.255, -91.002, 17.818, -90.068, 17.819, -89.143, 17.808, -89.151, 17.016, -89.229, 15.887, -88.931, 15.887, -88.605, 15.706, -88.518, 15.855, -88.225, 15.728, -88.681, 15.346, -89.155, 15.066, -89.225, 14.874, -89.146, 14.678, -89.353, 14.424, -89.587, 14.363, -89.534, 14.245, -89.722, 14.134, -90.065, 13.882, -90.096, 13.735, -90.609, 13.910, -91.232, 13.928, -91.690, 14.126, -92.228, 14.539},
+	}},
+	{name: "Guinea", rings: [][]float32{
+		{-13.700, 12.586, -13.218, 12.576, -12.499, 12.332, -12.279, 12.354, -12.204, 12.466, -11.658, 12.387, -11.514, 12.443, -11.456, 12.077, -11.298, 12.078, -11.037, 12.211, -10.871, 12.178, -10.593, 11.924, -10.165, 11.844, -9.891, 12.060, -9.568, 12.194, -9.328, 12.334, -9.127, 12.308, -8.905, 12.088, -8.786, 11.813, -8.376, 11.394, -8.581, 11.136, -8.620, 10.811, -8.407, 10.909, -8.282, 10.793, -8.335, 10.495, -8.030, 10.207, -8.229, 10.129, -8.310, 9.790, -8.079, 9.376, -7.832, 8.576, -8.203, 8.455, -8.299, 8.316, -8.222, 8.123, -8.281, 7.687, -8.439, 7.686, -8.722, 7.712, -8.926, 7.309, -9.209, 7.314, -9.403, 7.527, -9.337, 7.929, -9.755, 8.541, -10.017, 8.429, -10.230, 8.406, -10.505, 8.349, -10.494, 8.716, -10.655, 8.977, -10.622, 9.268, -10.839, 9.688, -11.117, 10.046, -11.917, 10.047, -12.150, 9.859, -12.426, 9.836, -12.597, 9.620, -12.712, 9.343, -13.247, 8.903, -13.685, 9.495, -14.074, 9.886, -14.330, 10.016, -14.580, 10.214, -14.693, 10.656, -14.840, 10.877, -15.130, 11.040, -14.686, 11.528, -14.382, 11.509, -14.121, 11.677, -13.901, 11.679, -13.743, 11.811, -13.828, 12.143, -13.719, 12.247, -13.700, 12.586},
+	}},
+	{name: "Guinea-Bissau", rings: [][]float32{
+		{-16.677, 12.385, -16.148, 12.548, -15.817, 12.516, -15.548, 12.628, -13.700, 12.586, -13.719, 12.247, -13.828, 12.143, -13.743, 11.811, -13.901, 11.679, -14.121, 11.677, -14.382, 11.509, -14.686, 11.528, -15.130, 11.040, -15.664, 11.458, -16.085, 11.525, -16.315, 11.807, -16.309, 11.959, -16.614, 12.171, -16.677, 12.385},
+	}},
+	{name: "Guyana", rings: [][]float32{
+		{-56.539, 1.900, -56.783, 1.864, -57.336, 1.949, -57.661, 1.683, -58.113, 1.507, -58.429, 1.464, -58.540, 1.268, -59.031, 1.318, -59.646, 1.787, -59.719, 2.250, -59.975, 2.755, -59.815, 3.606, -59.538, 3.959, -59.767, 4.424, -60.111, 4.575, -59.981, 5.014, -60.214, 5.244, -60.734, 5.200, -61.410, 5.959, -61.139, 6.234, -61.159, 6.696, -60.544, 6.857, -60.296, 7.044, -60.638, 7.415, -60.551, 7.780, -59.758, 8.367, -59.102, 7.999, -58.483, 7.348, -58.455, 6.833, -58.078, 6.809, -57.542, 6.321, -57.147, 5.973, -57.307, 5.074, -57.914, 4.813, -57.860, 4.577, -58.045, 4.061, -57.602, 3.335, -57.281, 3.333, -57.150, 2.769, -56.539, 1.900},
+	}},
+	{name: "Haiti", rings: [][]float32{
+		{-71.712, 19.714, -71.625, 19.170, -71.701, 18.785, -71.945, 18.617, -71.688, 18.317, -71.708, 18.045, -72.372, 18.215, -72.844, 18.146, -73.455, 18.218, -73.922, 18.031, -74.458, 18.343, -74.370, 18.665, -73.450, 18.526, -72.695, 18.446, -72.335, 18.668, -72.792, 19.102, -72.784, 19.484, -73.415, 19.640, -73.190, 19.916, -72.580, 19.872, -71.712, 19.714},
+	}},
+	{name: "Honduras", rings: [][]float32{
+		{-83.147, 14.996, -83.490, 15.016, -83.629, 14.880, -83.976, 14.749, -84.228, 14.749, -84.449, 14.622, -84.650, 14.667, -84.820, 14.820, -84.925, 14.790, -85.053, 14.552, -85.149, 14.560, -85.165, 14.354, -85.514, 14.079, -85.699, 13.960, -85.801, 13.836, -86.096, 14.038, -86.312, 13.771, -86.521, 13.778, -86.755, 13.755, -86.734, 13.263, -86.881, 13.254, -87.006, 13.026, -87.317, 12.985, -87.489, 13.298, -87.793, 13.384, -87.724, 13.785, -87.860, 13.893, -88.065, 13.965, -88.504, 13.845, -88.541, 13.980, -88.843, 14.141, -89.059, 14.340, -89.353, 14.424, -89.146, 14.678, -89.225, 14.874, -89.155, 15.066, -88.681, 15.346, -88.225, 15.728, -88.121, 15.689, -87.902, 15.864, -87.616, 15.879, -87.523, 15.797, -87.368, 15.847, -86.903, 15.757, -86.441, 15.783, -86.119, 15.893, -86.002, 16.005, -85.683, 15.954, -85.444, 15.886, -85.182, 15.909, -84.984, 15.996, -84.527, 15.857, -84.368, 15.835, -84.063, 15.648, -83.774, 15.424, -83.410, 15.271, -83.147, 14.996},
+	}},
+	{name: "Hungary", rings: [][]float32{
+		{22.086, 48.422, 22.641, 48.150, 22.711, 47.882, 22.100, 47.672, 21.627, 46.994, 21.022, 46.316, 20.220, 46.127, 19.596, 46.172, 18.830, 45.909, 18.830, 45.909, 18.456, 45.759, 17.630, 45.952, 16.883, 46.381, 16.565, 46.504, 16.371, 46.841, 16.202, 46.852, 16.534, 47.496, 16.341, 47.713, 16.904, 47.715, 16.980, 48.123, 17.488, 47.867, 17.857, 47.758, 18.697, 47.881, 18.777, 48.082, 19.174, 48.111, 19.661, 48.267, 19.769, 48.203, 20.239, 48.328, 20.474, 48.563, 20.801, 48.624, 21.872, 48.320, 22.086, 48.422},
+	}},
+	{name: "Iceland", rings: [][]float32{
+		{-14.509, 66.456, -14.740, 65.809, -13.610, 65.127, -14.910, 64.364, -17.794, 63.679, -18.656, 63.496, -19.973, 63.644, -22.763, 63.960, -21.778, 64.402, -23.955, 64.891, -22.184, 65.085, -22.227, 65.379, -24.326, 65.611, -23.651, 66.263, -22.135, 66.410, -20.576, 65.732, -19.057, 66.277, -17.799, 65.994, -16.168, 66.527, -14.509, 66.456},
+	}},
+	{name: "India", rings: [][]float32{
+		{97.327, 28.262, 97.403, 27.883, 97.052, 27.699, 97.134, 27.084, 96.419, 27.265, 95.125, 26.574, 95.155, 26.001, 94.603, 25.162, 94.553, 24.675, 94.107, 23.851, 93.325, 24.079, 93.286, 23.044, 93.060, 22.703, 93.166, 22.278, 92.673, 22.041, 92.146, 23.627, 91.870, 23.624, 91.706, 22.985, 91.159, 23.504, 91.468, 24.073, 91.915, 24.130, 92.376, 24.977, 91.800, 25.147, 90.872, 25.133, 89.921, 25.270, 89.832, 25.965, 89.355, 26.014, 88.563, 26.447, 88.210, 25.768, 88.932, 25.239, 88.306, 24.866, 88.084, 24.502, 88.700, 24.234, 88.530, 23.631, 88.876, 22.879, 89.032, 22.056, 88.889, 21.691, 88.208, 21.703, 86.976, 21.496, 87.033, 20.743, 86.499, 20.152, 85.060, 19.479, 83.941, 18.302, 83.189, 17.671, 82.193, 17.017, 82.191, 16.557, 81.693, 16.310, 80.792, 15.952, 80.325, 15.899, 80.025, 15.136, 80.233, 13.836, 80.286, 13.006, 79.863, 12.056, 79.858, 10.357, 79.341, 10.309, 78.885, 9.546, 79.190, 9.217, 78.278, 8.933, 77.941, 8.253, 77.540, 7.966, 76.593, 8.899, 76.130, 10.300, 75.746, 11.308, 75.396, 11.781, 74.865, 12.742, 74.617, 13.993, 74.444, 14.617, 73.534, 15.991, 73.120, 17.929, 72.821, 19.208, 72.824, 20.420, 72.631, 21.356, 71.175, 20.757, 70.470, 20.877, 69.164, 22.089, 69.645, 22.451, 69.350, 22.843, 68.177, 23.692, 68.843, 24.359, 71.043, 24.357, 70.845, 25.215, 70.283, 25.722, 70.169, 26.492, 69.514, 26.941, 70.616, 27.989, 71.778, 27.913, 72.824, 28.962, 73.451, 29.976, 74.421, 30.980, 74.406, 31.693, 75.259, 32.271, 74.452, 32.765, 74.104, 33.441, 73.750, 34.318, 74.240, 34.749, 75.757, 34.505, 76.872, 34.654, 77.837, 35.494, 78.912, 34.322, 78.811, 33.506, 79.209, 32.994, 79.176, 32.484, 78.458, 32.618, 78.739, 31.516, 79.721, 30.883, 81.111, 30.183, 80.477, 29.730, 80.088, 28.794, 81.057, 28.416, 82.000, 27.925, 83.304, 27.365, 84.675, 27.235, 85.252, 26.726, 86.024, 26.631, 87.227, 26.398, 88.060, 26.415, 88.175, 26.810, 88.043, 27.446, 88.120, 27.877, 88.730, 28.087, 88.814, 27.299, 88.836, 27.099, 89.745, 26.719, 90.373, 26.876, 91.218, 26.809, 92.033, 26.838, 92.104, 27.453, 91.697, 27.772, 92.503, 27.897, 93.413, 28.641, 94.566, 29.277, 95.405, 29.032, 96.118, 29.453, 96.587, 28.831, 96.249, 28.411, 97.327, 28.262},
+	}},
+	{name: "Indonesia", rings: [][]float32{
+		{141.000, -2.600, 141.017, -5.859, 141.034, -9.118, 140.143, -8.297, 139.128, -8.096, 138.881, -8.381, 137.614, -8.412, 138.039, -7.598, 138.669, -7.320, 138.408, -6.233, 137.928, -5.393, 135.989, -4.547, 135.165, -4.463, 133.663, -3.539, 133.368, -4.025, 132.984, -4.113, 132.757, -3.746, 132.754, -3.312, 131.990, -2.821, 133.067, -2.460, 133.780, -2.480, 133.696, -2.215, 132.232, -2.213, 131.836, -1.617, 130.943, -1.433, 130.520, -0.938, 131.868, -0.695, 132.380, -0.370, 133.986, -0.780, 134.143, -1.152, 134.423, -2.769, 135.458, -3.368, 136.293, -2.307, 137.441, -1.704, 138.330, -1.703, 139.185, -2.051, 139.927, -2.409, 141.000, -2.600},
+		{124.969, -8.893, 125.070, -9.090, 125.089, -9.393, 124.436, -10.140, 123.580, -10.360, 123.460, -10.240, 123.550, -9.900, 123.980, -9.290, 124.969, -8.893},
+		{134.210, -6.895, 134.113, -6.142, 134.290, -5.783, 134.500, -5.445, 134.727, -5.738, 134.725, -6.214, 134.210, -6.895},
+		{117.882, 4.138, 117.313, 3.234, 118.048, 2.288, 117.876, 1.828, 118.997, 0.902, 117.812, 0.784, 117.478, 0.102, 117.522, -0.804, 116.560, -1.488, 116.534, -2.484, 116.148, -4.013, 116.001, -3.657, 114.865, -4.107, 114.469, -3.496, 113.756, -3.439, 113.257, -3.119, 112.068, -3.478, 111.703, -2.994, 111.048, -3.049, 110.224, -2.934, 110.071, -1.593, 109.572, -1.315, 109.092, -0.460, 108.953, 0.415, 109.069, 1.342, 109.663, 2.006, 109.830, 1.338, 110.514, 0.773, 111.159, 0.976, 111.798, 0.904, 112.380, 1.410, 112.860, 1.498, 113.806, 1.218, 114.621, 1.431, 115.134, 2.821, 115.519, 3.169, 115.866, 4.307, 117.015, 4.306, 117.882, 4.138},
+		{129.371, -2.802, 130.471, -3.094, 130.835, -3.858, 129.991, -3.446, 129.155, -3.363, 128.591, -3.429, 127.899, -3.393, 128.136, -2.844, 129.371, -2.802},
+		{126.875, -3.791, 126.184, -3.607, 125.989, -3.177, 127.001, -3.129, 127.249, -3.459, 126.875, -3.791},
+		{127.932, 2.175, 128.004, 1.629, 128.595, 1.541, 128.688, 1.132, 128.636, 0.258, 128.120, 0.356, 127.968, -0.252, 128.380, -0.780, 128.100, -0.900, 127.696, -0.267, 127.399, 1.012, 127.601, 1.811, 127.932, 2.175},
+		{122.928, 0.875, 124.078, 0.917, 125.066, 1.643, 125.241, 1.420, 124.437, 0.428, 123.686, 0.236, 122.723, 0.431, 121.057, 0.381, 120.183, 0.237, 120.041, -0.520, 120.936, -1.409, 121.476, -0.956, 123.341, -0.616, 123.258, -1.076, 122.823, -0.931, 122.389, -1.517, 121.508, -1.904, 122.455, -3.186, 122.272, -3.530, 123.171, -4.684, 123.162, -5.341, 122.629, -5.635, 122.236, -5.283, 122.720, -4.464, 121.738, -4.851, 121.489, -4.575, 121.619, -4.188, 120.898, -3.602, 120.972, -2.628, 120.305, -2.932, 120.390, -4.098, 120.431, -5.528, 119.797, -5.673, 119.367, -5.380, 119.654, -4.459, 119.499, -3.494, 119.078, -3.487, 118.768, -2.802, 119.181, -2.147, 119.323, -1.353, 119.826, 0.154, 120.036, 0.566, 120.886, 1.309, 121.667, 1.014, 122.928, 0.875},
+		{120.295, -10.259, 118.968, -9.558, 119.900, -9.361, 120.426, -9.666, 120.776, -9.970, 120.716, -10.240, 120.295, -10.259},
+		{121.342, -8.537, 122.007, -8.461, 122.904, -8.094, 122.757, -8.650, 121.254, -8.934, 119.924, -8.810, 119.921, -8.445, 120.715, -8.237, 121.342, -8.537},
+		{118.261, -8.362, 118.878, -8.281, 119.127, -8.706, 117.970, -8.907, 117.278, -9.041, 116.740, -9.033, 117.084, -8.457, 117.632, -8.449, 117.900, -8.096, 118.261, -8.362},
+		{108.487, -6.422, 108.623, -6.778, 110.539, -6.877, 110.760, -6.465, 112.615, -6.946, 112.979, -7.594, 114.479, -7.777, 115.706, -8.371, 114.565, -8.752, 113.465, -8.349, 112.560, -8.376, 111.522, -8.302, 110.586, -8.123, 109.428, -7.741, 108.694, -7.642, 108.278, -7.767, 106.454, -7.355, 106.281, -6.925, 105.365, -6.851, 106.052, -5.896, 107.265, -5.955, 108.072, -6.346, 108.487, -6.422},
+		{104.370, -1.085, 104.539, -1.782, 104.888, -2.340, 105.622, -2.429, 106.109, -3.062, 105.857, -4.306, 105.818, -5.852, 104.710, -5.873, 103.868, -5.037, 102.584, -4.220, 102.156, -3.614, 101.399, -2.800, 100.903, -2.050, 100.142, -0.650, 99.264, 0.183, 98.970, 1.043, 98.601, 1.824, 97.700, 2.453, 97.177, 3.309, 96.424, 3.869, 95.381, 4.971, 95.293, 5.480, 95.937, 5.440, 97.485, 5.246, 98.369, 4.268, 99.143, 3.590, 99.694, 3.174, 100.641, 2.099, 101.658, 2.084, 102.498, 1.399, 103.077, 0.561, 103.838, 0.105, 103.438, -0.712, 104.011, -1.059, 104.370, -1.085},
+	}},
+	{name: "Iran", rings: [][]float32{
+		{48.568, 29.927, 48.015, 30.452, 48.005, 30.985, 47.685, 30.985, 47.849, 31.709, 47.335, 32.469, 46.109, 33.017, 45.417, 33.968, 45.648, 34.748, 46.152, 35.093, 46.076, 35.677, 45.421, 35.978, 44.773, 37.170, 44.773, 37.170, 44.226, 37.972, 44.421, 38.281, 44.109, 39.428, 44.794, 39.713, 44.953, 39.336, 45.458, 38.874, 46.144, 38.741, 46.506, 38.771, 47.685, 39.508, 48.060, 39.582, 48.356, 39.289, 48.011, 38.794, 48.634, 38.270, 48.883, 38.320, 49.200, 37.583, 50.148, 37.375, 50.842, 36.873, 52.264, 36.700, 53.826, 36.965, 53.922, 37.199, 54.800, 37.392, 55.512, 37.964, 56.180, 37.935, 56.619, 38.121, 57.330, 38.029, 58.436, 37.522, 59.235, 37.413, 60.378, 36.527, 61.123, 36.492, 61.211, 35.650, 60.803, 34.404, 60.528, 33.676, 60.964, 33.529, 60.536, 32.981, 60.864, 32.183, 60.942, 31.548, 61.699, 31.380, 61.781, 30.736, 60.874, 29.829, 61.369, 29.303, 61.772, 28.699, 62.728, 28.260, 62.755, 27.379, 63.234, 27.217, 63.317, 26.757, 61.874, 26.240, 61.497, 25.078, 59.616, 25.380, 58.526, 25.610, 57.397, 25.740, 56.971, 26.966, 56.492, 27.143, 55.724, 26.965, 54.715, 26.481, 53.493, 26.812, 52.484, 27.581, 51.521, 27.866, 50.853, 28.815, 50.115, 30.148, 49.577, 29.986, 48.941, 30.317, 48.568, 29.927},
+	}},
+	{name: "Iraq", rings: [][]float32{
+		{39.195, 32.161, 38.792, 33.379, 41.006, 34.419, 41.384, 35.628, 41.290, 36.359, 41.837, 36.606, 42.350, 37.230, 42.779, 37.385, 43.942, 37.256, 44.293, 37.002, 44.773, 37.170, 45.421, 35.978, 46.076, 35.677, 46.152, 35.093, 45.648, 34.748, 45.417, 33.968, 46.109, 33.017, 47.335, 32.469, 47.849, 31.709, 47.685, 30.985, 48.005, 30.985, 48.015, 30.452, 48.568, 29.927, 47.975, 29.976, 47.303, 30.059, 46.569, 29.099, 44.709, 29.179, 41.890, 31.190, 40.400, 31.890, 39.195, 32.161},
+	}},
+	{name: "Ireland", rings: [][]float32{
+		{-6.198, 53.868, -6.033, 53.153, -6.789, 52.260, -8.562, 51.669, -9.977, 51.820, -9.166, 52.865, -9.689, 53.881, -8.328, 54.665, -7.572, 55.132, -7.366, 54.596, -7.572, 54.060, -6.954, 54.074, -6.198, 53.868},
+	}},
+	{name: "Israel", rings: [][]float32{
+		{35.720, 32.709, 35.546, 32.394, 35.184, 32.533, 34.975, 31.867, 35.226, 31.754, 34.971, 31.617, 34.927, 31.353, 35.398, 31.489, 35.421, 31.100, 34.923, 29.501, 34.823, 29.761, 34.265, 31.219, 34.265, 31.219, 34.265, 31.219, 34.556, 31.549, 34.488, 31.606, 34.753, 32.073, 34.955, 32.827, 35.098, 33.081, 35.126, 33.091, 35.461, 33.089, 35.553, 33.264, 35.821, 33.277, 35.836, 32.868, 35.701, 32.716, 35.720, 32.709},
+	}},
+	{name: "Italy", rings: [][]float32{
+		{10.443, 46.894, 11.049, 46.751, 11.165, 46.942, 12.153, 47.115, 12.376, 46.768, 13.806, 46.509, 13.698, 46.017, 13.938, 45.591, 13.142, 45.737, 12.329, 45.382, 12.384, 44.885, 12.261, 44.600, 12.589, 44.091, 13.527, 43.588, 14.030, 42.761, 15.143, 41.955, 15.926, 41.961, 16.170, 41.740, 15.889, 41.541, 16.785, 41.180, 17.519, 40.877, 18.377, 40.356, 18.480, 40.169, 18.293, 39.811, 17.738, 40.278, 16.870, 40.442, 16.449, 39.795, 17.171, 39.425, 17.053, 38.903, 16.635, 38.844, 16.101, 37.986, 15.684, 37.909, 15.688, 38.215, 15.892, 38.751, 16.109, 38.965, 15.719, 39.544, 15.414, 40.048, 14.998, 40.173, 14.703, 40.605, 14.061, 40.786, 13.628, 41.188, 12.888, 41.253, 12.107, 41.705, 11.192, 42.355, 10.512, 42.931, 10.200, 43.920, 9.702, 44.036, 8.889, 44.366, 8.429, 44.231, 7.851, 43.767, 7.435, 43.694, 7.550, 44.128, 7.008, 44.255, 6.750, 45.029, 7.097, 45.333, 6.802, 45.709, 6.844, 45.991, 7.274, 45.777, 7.756, 45.824, 8.317, 46.164, 8.490, 46.005, 8.966, 46.037, 9.183, 46.440, 9.923, 46.315, 10.363, 46.484, 10.443, 46.894},
+		{14.761, 38.144, 15.520, 38.231, 15.160, 37.444, 15.310, 37.134, 15.100, 36.620, 14.335, 36.997, 13.827, 37.105, 12.431, 37.613, 12.571, 38.126, 13.741, 38.035, 14.761, 38.144},
+		{8.710, 40.900, 9.210, 41.210, 9.810, 40.500, 9.670, 39.177, 9.215, 39.240, 8.807, 38.907, 8.428, 39.172, 8.388, 40.378, 8.160, 40.950, 8.710, 40.900},
+	}},
+	{name: "Jamaica", rings: [][]float32{
+		{-77.570, 18.491, -76.897, 18.401, -76.365, 18.161, -76.200, 17.887, -76.903, 17.868, -77.206, 17.701, -77.766, 17.862, -78.338, 18.226, -78.218, 18.455, -77.797, 18.524, -77.570, 18.491},
+	}},
+	{name: "Japan", rings: [][]float32{
+		{141.885, 39.181, 140.959, 38.174, 140.976, 37.142, 140.600, 36.344, 140.774, 35.843, 140.253, 35.138, 138.976, 34.668, 137.218, 34.606, 135.793, 33.465, 135.121, 33.849, 135.079, 34.597, 133.340, 34.376, 132.157, 33.905, 130.986, 33.886, 132.000, 33.150, 131.333, 31.450, 130.686, 31.030, 130.202, 31.418, 130.448, 32.319, 129.815, 32.610, 129.408, 33.296, 130.354, 33.604, 130.878, 34.233, 131.884, 34.750, 132.618, 35.433, 134.608, 35.732, 135.678, 35.527, 136.724, 37.305, 137.391, 36.827, 138.858, 37.827, 139.426, 38.216, 140.055, 39.439, 139.883, 40.563, 140.306, 41.195, 141.369, 41.379, 141.914, 39.992, 141.885, 39.181},
+		{144.613, 43.961, 145.321, 44.385, 145.543, 43.262, 144.060, 42.988, 143.184, 41.995, 141.611, 42.679, 141.067, 41.585, 139.955, 41.570, 139.818, 42.564, 140.312, 43.333, 141.381, 43.389, 141.672, 44.772, 141.968, 45.551, 143.143, 44.510, 143.910, 44.174, 144.613, 43.961},
+		{132.371, 33.464, 132.924, 34.060, 133.493, 33.945, 133.904, 34.365, 134.638, 34.149, 134.766, 33.806, 134.203, 33.201, 133.793, 33.522, 133.280, 33.290, 133.015, 32.705, 132.363, 32.989, 132.371, 33.464},
+	}},
+	{name: "Jordan", rings: [][]float32{
+		{35.546, 32.394, 35.720, 32.709, 36.834, 32.313, 38.792, 33.379, 39.195, 32.161, 39.005, 32.010, 37.002, 31.508, 37.999, 30.509, 37.668, 30.339, 37.504, 30.004, 36.741, 29.865, 36.501, 29.505, 36.069, 29.197, 34.956, 29.357, 34.923, 29.501, 35.421, 31.100, 35.398, 31.489, 35.545, 31.783, 35.546, 32.394},
+	}},
+	{name: "Kazakhstan", rings: [][]float32{
+		{87.360, 49.215, 86.599, 48.549, 85.768, 48.456, 85.720, 47.453, 85.164, 47.001, 83.180, 47.330, 82.459, 45.540, 81.947, 45.317, 79.966, 44.918, 80.866, 43.180, 80.180, 42.920, 80.260, 42.350, 79.644, 42.497, 79.142, 42.856, 77.658, 42.961, 76.000, 42.988, 75.637, 42.878, 74.213, 43.298, 73.645, 43.091, 73.490, 42.501, 71.845, 42.845, 71.186, 42.704, 70.962, 42.266, 70.389, 42.081, 69.070, 41.384, 68.632, 40.669, 68.260, 40.662, 67.986, 41.136, 66.714, 41.168, 66.511, 41.988, 66.023, 41.995, 66.098, 42.998, 64.901, 43.728, 63.186, 43.650, 62.013, 43.504, 61.058, 44.406, 60.240, 44.784, 58.690, 45.500, 58.503, 45.587, 55.929, 44.996, 55.968, 41.309, 55.455, 41.260, 54.755, 42.044, 54.079, 42.324, 52.944, 42.116, 52.502, 41.783, 52.446, 42.027, 52.692, 42.444, 52.501, 42.792, 51.342, 43.133, 50.891, 44.031, 50.339, 44.284, 50.306, 44.610, 51.279, 44.515, 51.317, 45.246, 52.167, 45.408, 53.041, 45.259, 53.221, 46.235, 53.043, 46.853, 52.042, 46.805, 51.192, 47.049, 50.034, 46.609, 49.101, 46.399, 48.593, 46.561, 48.695, 47.076, 48.057, 47.744, 47.315, 47.716, 46.466, 48.394, 47.044, 49.152, 46.752, 49.356, 47.549, 50.455, 48.578, 49.875, 48.702, 50.605, 50.767, 51.693, 52.329, 51.719, 54.533, 51.026, 55.717, 50.622, 56.778, 51.044, 58.363, 51.064, 59.642, 50.545, 59.933, 50.842, 61.337, 50.799, 61.588, 51.273, 59.968, 51.960, 60.927, 52.448, 60.740, 52.720, 61.700, 52.980, 60.978, 53.665, 61.437, 54.006, 65.179, 54.354, 65.667, 54.601, 68.169, 54.970, 69.068, 55.385, 70.865, 55.170, 71.180, 54.133, 72.224, 54.377, 73.509, 54.036, 73.426, 53.490, 74.385, 53.547, 76.891, 54.491, 76.525, 54.177, 77.801, 53.404, 80.036, 50.865, 80.568, 51.388, 81.946, 50.812, 83.383, 51.069, 83.935, 50.889, 84.416, 50.311, 85.116, 50.117, 85.541, 49.693, 86.829, 49.827, 87.360, 49.215},
+	}},
+	{name: "Kenya", rings: [][]float32{
+		{39.202, -4.677, 37.767, -3.677, 37.699, -3.097, 34.073, -1.060, 33.904, -0.950, 33.894, 0.110, 34.180, 0.515, 34.672, 1.177, 35.036, 1.906, 34.596, 3.054, 34.479, 3.556, 34.005, 4.250, 34.620, 4.847, 35.298, 5.506, 35.817, 5.338, 35.817, 4.777, 36.159, 4.448, 36.855, 4.448, 38.121, 3.599, 38.437, 3.589, 38.671, 3.616, 38.893, 3.501, 39.559, 3.422, 39.855, 3.839, 40.768, 4.257, 41.172, 3.919, 41.855, 3.919, 40.981, 2.785, 40.993, -0.858, 41.585, -1.683, 40.885, -2.083, 40.638, -2.500, 40.263, -2.573, 40.121, -3.278, 39.800, -3.681, 39.605, -4.347, 39.202, -4.677},
+	}},
+	{name: "Kosovo", rings: [][]float32{
+		{20.590, 41.855, 20.523, 42.218, 20.284, 42.320, 20.071, 42.589, 20.258, 42.813, 20.497, 42.885, 20.635, 43.217, 20.814, 43.272, 20.957, 43.131, 21.143, 43.069, 21.274, 42.910, 21.439, 42.863, 21.633, 42.677, 21.775, 42.683, 21.663, 42.439, 21.543, 42.320, 21.577, 42.245, 21.353, 42.207, 20.762, 42.052, 20.717, 41.847, 20.590, 41.855},
+	}},
+	{name: "Kuwait", rings: [][]float32{
+		{47.975, 29.976, 48.183, 29.534, 48.094, 29.306, 48.416, 28.552, 47.709, 28.526, 47.460, 29.003, 46.569, 29.099, 47.303, 30.059, 47.975, 29.976},
+	}},
+	{name: "Kyrgyzstan", rings: [][]float32{
+		{70.962, 42.266, 71.186, 42.704, 71.845, 42.845, 73.490, 42.501, 73.645, 43.091, 74.213, 43.298, 75.637, 42.878, 76.000, 42.988, 77.658, 42.961, 79.142, 42.856, 79.644, 42.497, 80.260, 42.350, 80.119, 42.124, 78.544, 41.582, 78.187, 41.185, 76.904, 41.066, 76.526, 40.428, 75.468, 40.562, 74.777, 40.366, 73.822, 39.894, 73.960, 39.660, 73.675, 39.431, 71.785, 39.279, 70.549, 39.604, 69.465, 39.527, 69.560, 40.103, 70.648, 39.936, 71.014, 40.244, 71.775, 40.146, 73.055, 40.866, 71.870, 41.393, 71.158, 41.144, 70.420, 41.520, 71.259, 42.168, 70.962, 42.266},
+	}},
+	{name: "Laos", rings: [][]float32{
+		{107.383, 14.202, 106.496, 14.571, 106.044, 13.881, 105.219, 14.273, 105.544, 14.724, 105.589, 15.570, 104.779, 16.442, 104.717, 17.429, 103.956, 18.241, 103.200, 18.310, 102.999, 17.962, 102.413, 17.933, 102.114, 18.109, 101.060, 17.512, 101.036, 18.409, 101.282, 19.463, 100.606, 19.508, 100.549, 20.109, 100.116, 20.418, 100.329, 20.786, 101.180, 21.437, 101.270, 21.202, 101.803, 21.174, 101.652, 22.318, 102.170, 22.465, 102.755, 21.675, 103.204, 20.767, 104.435, 20.759, 104.823, 19.887, 104.183, 19.625, 103.897, 19.265, 105.095, 18.667, 105.926, 17.485, 106.556, 16.604, 107.313, 15.909, 107.565, 15.202, 107.383, 14.202},
+	}},
+	{name: "Latvia", rings: [][]float32{
+		{27.288, 57.475, 27.770, 57.244, 27.855, 56.759, 28.177, 56.169, 27.102, 55.783, 26.494, 55.615, 25.533, 56.100, 25.001, 56.165, 24.861, 56.373, 23.878, 56.274, 22.201, 56.338, 21.056, 56.031, 21.090, 56.784, 21.582, 57.412, 22.524, 57.753, 23.318, 57.006, 24.121, 57.026, 24.313, 57.793, 25.165, 57.970, 25.603, 57.848, 26.464, 57.476, 27.288, 57.475},
+	}},
+	{name: "Lebanon", rings: [][]float32{
+		{35.821, 33.277, 35.553, 33.264, 35.461, 33.089, 35.126, 33.091, 35.482, 33.905, 35.980, 34.610, 35.998, 34.645, 36.448, 34.594, 36.612, 34.202, 36.066, 33.825, 35.821, 33.277},
+	}},
+	{name: "Lesotho", rings: [][]float32{
+		{28.978, -28.956, 29.325, -29.257, 29.018, -29.744, 28.848, -30.070, 28.291, -30.226, 28.107, -30.546, 27.749, -30.645, 26.999, -29.876, 27.533, -29.243, 28.074, -28.851, 28.542, -28.648, 28.978, -28.956},
+	}},
+	{name: "Liberia", rings: [][]float32{
+		{-8.439, 7.686, -8.485, 7.395, -8.385, 6.912, -8.603, 6.468, -8.311, 6.193, -7.994, 6.126, -7.570, 5.707, -7.540, 5.313, -7.635, 5.188, -7.712, 4.365, -7.974, 4.356, -9.005, 4.832, -9.913, 5.594, -10.765, 6.141, -11.439, 6.786, -11.200, 7.106, -11.147, 7.397, -10.696, 7.939, -10.230, 8.406, -10.017, 8.429, -9.755, 8.541, -9.337, 7.929, -9.403, 7.527, -9.209, 7.314, -8.926, 7.309, -8.722, 7.712, -8.439, 7.686},
+	}},
+	{name: "Libya", rings: [][]float32{
+		{25.000, 22.000, 25.000, 20.003, 23.850, 20.000, 23.838, 19.580, 19.849, 21.495, 15.861, 23.410, 14.851, 22.863, 14.144, 22.491, 13.581, 23.041, 12.000, 23.472, 11.561, 24.098, 10.771, 24.563, 10.304, 24.379, 9.948, 24.937, 9.911, 25.365, 9.319, 26.094, 9.716, 26.512, 9.629, 27.141, 9.756, 27.688, 9.684, 28.144, 9.860, 28.960, 9.806, 29.425, 9.482, 30.308, 9.970, 30.539, 10.057, 30.962, 9.950, 31.376, 10.637, 31.761, 10.945, 32.082, 11.432, 32.369, 11.489, 33.137, 12.663, 32.793, 13.083, 32.879, 13.919, 32.712, 15.246, 32.265, 15.714, 31.376, 16.612, 31.182, 18.021, 30.764, 19.086, 30.266, 19.574, 30.526, 20.053, 30.986, 19.820, 31.752, 20.134, 32.238, 20.855, 32.707, 21.543, 32.843, 22.896, 32.639, 23.237, 32.191, 23.609, 32.187, 23.927, 32.017, 24.921, 31.899, 25.165, 31.569, 24.803, 31.089, 24.958, 30.662, 24.700, 30.044, 25.000, 29.239, 25.000, 25.683, 25.000, 22.000},
+	}},
+	{name: "Lithuania", rings: [][]float32{
+		{26.494, 55.615, 26.588, 55.167, 25.768, 54.847, 25.536, 54.282, 24.451, 53.906, 23.484, 53.912, 23.244, 54.221, 22.731, 54.328, 22.651, 54.583, 22.758, 54.857, 22.316, 55.015, 21.268, 55.190, 21.056, 56.031, 22.201, 56.338, 23.878, 56.274, 24.861, 56.373, 25.001, 56.165, 25.533, 56.100, 26.494, 55.615},
+	}},
+	{name: "Luxembourg", rings: [][]float32{
+		{6.043, 50.128, 6.243, 49.902, 6.186, 49.464, 5.898, 49.443, 5.674, 49.529, 5.782, 50.090, 6.043, 50.128},
+	}},
+	{name: "Madagascar", rings: [][]float32{
+		{49.544, -12.470, 49.809, -12.895, 50.057, -13.556, 50.217, -14.759, 50.477, -15.227, 50.377, -15.706, 50.200, -16.000, 49.861, -15.414, 49.673, -15.710, 49.863, -16.451, 49.775, -16.875, 49.499, -17.106, 49.436, -17.953, 49.042, -19.119, 48.549, -20.497, 47.931, -22.392, 47.548, -23.782, 47.096, -24.942, 46.282, -25.178, 45.410, -25.601, 44.834, -25.346, 44.040, -24.988, 43.764, -24.461, 43.698, -23.574, 43.346, -22.777, 43.254, -22.057, 43.433, -21.336, 43.894, -21.163, 43.896, -20.830, 44.374, -20.072, 44.464, -19.435, 44.232, -18.962, 44.043, -18.331, 43.963, -17.410, 44.312, -16.850, 44.447, -16.216, 44.945, -16.179, 45.503, -15.974, 45.873, -15.793, 46.312, -15.780, 46.882, -15.210, 47.705, -14.594, 48.005, -14.091, 47.869, -13.664, 48.294, -13.784, 48.845, -13.089, 48.864, -12.488, 49.195, -12.041, 49.544, -12.470},
+	}},
+	{name: "Malawi", rings: [][]float32{
+		{32.759, -9.231, 33.740, -9.417, 33.941, -9.694, 34.280, -10.160, 34.560, -11.520, 34.280, -12.280, 34.560, -13.580, 34.907, -13.565, 35.268, -13.888, 35.687, -14.611, 35.772, -15.897, 35.339, -16.107, 35.034, -16.801, 34.381, -16.184, 34.307, -15.479, 34.518, -15.014, 34.460, -14.613, 34.065, -14.360, 33.790, -14.452, 33.214, -13.972, 32.688, -13.713, 32.992, -12.784, 33.306, -12.436, 33.114, -11.607, 33.315, -10.797, 33.486, -10.526, 33.231, -9.677, 32.759, -9.231},
+	}},
+	{name: "Malaysia", rings: [][]float32{
+		{100.086, 6.464, 100.260, 6.643, 101.076, 6.205, 101.154, 5.691, 101.814, 5.811, 102.141, 6.222, 102.371, 6.128, 102.962, 5.524, 103.381, 4.855, 103.439, 4.182, 103.332, 3.727, 103.429, 3.383, 103.502, 2.791, 103.855, 2.515, 104.248, 1.631, 104.229, 1.293, 103.520, 1.226, 102.574, 1.967, 101.391, 2.761, 101.274, 3.270, 100.695, 3.939, 100.557, 4.767, 100.197, 5.312, 100.306, 6.041, 100.086, 6.464},
+		{117.882, 4.138, 117.015, 4.306, 115.866, 4.307, 115.519, 3.169, 115.134, 2.821, 114.621, 1.431, 113.806, 1.218, 112.860, 1.498, 112.380, 1.410, 111.798, 0.904, 111.159, 0.976, 110.514, 0.773, 109.830, 1.338, 109.663, 2.006, 110.396, 1.664, 111.169, 1.851, 111.370, 2.697, 111.797, 2.886, 112.996, 3.102, 113.713, 3.894, 114.204, 4.526, 114.660, 4.008, 114.870, 4.348, 115.347, 4.317, 115.406, 4.955, 115.451, 5.448, 116.221, 6.143, 116.725, 6.925, 117.130, 6.928, 117.643, 6.422, 117.689, 5.987, 118.348, 5.709, 119.182, 5.408, 119.111, 5.016, 118.440, 4.967, 118.618, 4.478, 117.882, 4.138},
+	}},
+	{name: "Mali", rings: [][]float32{
+		{-11.514, 12.443, -11.468, 12.755, -11.553, 13.141, -11.928, 13.422, -12.125, 13.995, -12.171, 14.617, -11.834, 14.799, -11.666, 15.388, -11.349, 15.411, -10.651, 15.133, -10.087, 15.330, -9.700, 15.264, -9.550, 15.486, -5.538, 15.502, -5.315, 16.202, -5.489, 16.325, -5.971, 20.641, -6.454, 24.957, -4.923, 24.975, -1.550, 22.793, 1.823, 20.611, 2.061, 20.142, 2.684, 19.856, 3.147, 19.694, 3.158, 19.057, 4.267, 19.155, 4.270, 16.852, 3.723, 16.184, 3.638, 15.568, 2.750, 15.410, 1.386, 15.324, 1.016, 14.968, 0.375, 14.929, -0.266, 14.924, -0.516, 15.116, -1.066, 14.974, -2.001, 14.559, -2.192, 14.246, -2.968, 13.798, -3.104, 13.541, -3.523, 13.338, -4.006, 13.472, -4.280, 13.228, -4.427, 12.543, -5.221, 11.714, -5.198, 11.375, -5.471, 10.951, -5.404, 10.371, -5.817, 10.223, -6.050, 10.096, -6.205, 10.524, -6.494, 10.411, -6.666, 10.431, -6.851, 10.139, -7.623, 10.147, -7.900, 10.297, -8.030, 10.207, -8.335, 10.495, -8.282, 10.793, -8.407, 10.909, -8.620, 10.811, -8.581, 11.136, -8.376, 11.394, -8.786, 11.813, -8.905, 12.088, -9.127, 12.308, -9.328, 12.334, -9.568, 12.194, -9.891, 12.060, -10.165, 11.844, -10.593, 11.924, -10.871, 12.178, -11.037, 12.211, -11.298, 12.078, -11.456, 12.077, -11.514, 12.443},
+	}},
+	{name: "Mauritania", rings: [][]float32{
+		{-17.063, 21.000, -16.845, 21.333, -12.929, 21.327, -13.119, 22.771, -12.874, 23.285, -11.937, 23.375, -11.969, 25.933, -8.687, 25.881, -8.684, 27.396, -4.923, 24.975, -6.454, 24.957, -5.971, 20.641, -5.489, 16.325, -5.315, 16.202, -5.538, 15.502, -9.550, 15.486, -9.700, 15.264, -10.087, 15.330, -10.651, 15.133, -11.349, 15.411, -11.666, 15.388, -11.834, 14.799, -12.171, 14.617, -12.831, 15.304, -13.436, 16.039, -14.100, 16.304, -14.577, 16.598, -15.136, 16.587, -15.624, 16.369, -16.121, 16.456, -16.463, 16.135, -16.550, 16.674, -16.271, 17.167, -16.146, 18.108, -16.257, 19.097, -16.378, 19.594, -16.278, 20.093, -16.536, 20.568, -17.063, 21.000},
+	}},
+	{name: "Mexico", rings: [][]float32{
+		{-117.128, 32.535, -115.991, 32.612, -114.721, 32.721, -114.815, 32.525, -113.305, 32.039, -111.024, 31.335, -109.035, 31.342, -108.242, 31.342, -108.240, 31.755, -106.508, 31.755, -106.143, 31.400, -105.632, 31.084, -105.037, 30.644, -104.706, 30.122, -104.457, 29.572, -103.940, 29.270, -103.110, 28.970, -102.480, 29.760, -101.662, 29.779, -100.958, 29.381, -100.456, 28.696, -100.110, 28.110, -99.520, 27.540, -99.300, 26.840, -99.020, 26.370, -98.240, 26.060, -97.530, 25.840, -97.140, 25.870, -97.528, 24.992, -97.703, 24.272, -97.776, 22.933, -97.872, 22.444, -97.699, 21.899, -97.389, 21.411, -97.189, 20.635, -96.526, 19.891, -96.292, 19.320, -95.901, 18.828, -94.839, 18.563, -94.426, 18.144, -93.549, 18.424, -92.786, 18.525, -92.037, 18.705, -91.408, 18.876, -90.772, 19.284, -90.534, 19.867, -90.451, 20.708, -90.279, 21.000, -89.601, 21.262, -88.544, 21.494, -87.658, 21.459, -87.052, 21.544, -86.812, 21.332, -86.846, 20.850, -87.383, 20.255, -87.621, 19.647, -87.437, 19.472, -87.587, 19.040, -87.837, 18.260, -88.091, 18.517, -88.300, 18.500, -88.490, 18.487, -88.848, 17.883, -89.030, 18.002, -89.151, 17.955, -89.143, 17.808, -90.068, 17.819, -91.002, 17.818, -91.002, 17.255, -91.454, 17.252, -91.082, 16.918, -90.712, 16.687, -90.601, 16.471, -90.439, 16.410, -90.464, 16.070, -91.748, 16.067, -92.229, 15.251, -92.087, 15.065, -92.203, 14.830, -92.228, 14.539, -93.359, 15.615, -93.875, 15.940, -94.692, 16.201, -95.250, 16.128, -96.053, 15.752, -96.557, 15.654, -97.264, 15.917, -98.013, 16.107, -98.948, 16.566, -99.697, 16.706, -100.829, 17.171, -101.666, 17.649, -101.919, 17.916, -102.478, 17.976, -103.501, 18.292, -103.918, 18.749, -104.992, 19.316, -105.493, 19.947, -105.731, 20.434, -105.398, 20.532, -105.501, 20.817, -105.271, 21.076, -105.266, 21.422, -105.603, 21.871, -105.693, 22.269, -106.029, 22.774, -106.910, 23.768, -107.915, 24.549, -108.402, 25.172, -109.260, 25.581, -109.444, 25.825, -109.292, 26.443, -109.801, 26.676, -110.392, 27.162, -110.641, 27.860, -111.179, 27.941, -111.760, 28.468, -112.228, 28.954, -112.272, 29.267, -112.810, 30.021, -113.164, 30.787, -113.149, 31.171, -113.872, 31.568, -114.206, 31.524, -114.776, 31.800, -114.937, 31.393, -114.771, 30.914, -114.674, 30.163, -114.331, 29.750, -113.589, 29.062, -113.424, 28.826, -113.272, 28.755, -113.140, 28.411, -112.962, 28.425, -112.762, 27.780, -112.458, 27.526, -112.245, 27.172, -111.616, 26.663, -111.285, 25.733, -110.988, 25.295, -110.710, 24.826, -110.655, 24.299, -110.173, 24.266, -109.772, 23.811, -109.409, 23.365, -109.433, 23.186, -109.854, 22.818, -110.031, 22.823, -110.295, 23.431, -110.950, 24.001, -111.671, 24.484, -112.182, 24.738, -112.149, 25.470, -112.301, 26.012, -112.777, 26.322, -113.465, 26.768, -113.597, 26.639, -113.849, 26.900, -114.466, 27.142, -115.055, 27.723, -114.982, 27.798, -114.570, 27.741, -114.199, 28.115, -114.162, 28.566, -114.932, 29.279, -115.519, 29.556, -115.887, 30.181, -116.258, 30.836, -116.722, 31.636, -117.128, 32.535},
+	}},
+	{name: "Moldova", rings: [][]float32{
+		{26.619, 48.221, 26.858, 48.368, 27.523, 48.467, 28.260, 48.156, 28.671, 48.118, 29.123, 47.849, 29.051, 47.510, 29.415, 47.347, 29.560, 46.929, 29.909, 46.674, 29.838, 46.525, 30.025, 46.424, 29.760, 46.350, 29.171, 46.379, 29.072, 46.518, 28.863, 46.438, 28.934, 46.259, 28.660, 45.940, 28.485, 45.597, 28.234, 45.488, 28.054, 45.945, 28.160, 46.372, 28.128, 46.810, 27.551, 47.405, 27.234, 47.827, 26.924, 48.123, 26.619, 48.221},
+	}},
+	{name: "Mongolia", rings: [][]float32{
+		{87.751, 49.297, 88.806, 49.471, 90.714, 50.332, 92.235, 50.802, 93.104, 50.495, 94.148, 50.481, 94.816, 50.013, 95.814, 49.977, 97.260, 49.726, 98.232, 50.422, 97.826, 51.011, 98.861, 52.047, 99.982, 51.634, 100.889, 51.517, 102.065, 51.260, 102.256, 50.511, 103.677, 50.090, 104.622, 50.275, 105.887, 50.406, 106.889, 50.274, 107.868, 49.794, 108.475, 49.283, 109.402, 49.293, 110.662, 49.130, 111.581, 49.378, 112.898, 49.544, 114.362, 50.248, 114.962, 50.140, 115.486, 49.805, 116.679, 49.889, 116.192, 49.135, 115.485, 48.135, 115.743, 47.727, 116.309, 47.853, 117.296, 47.698, 118.064, 48.067, 118.867, 47.747, 119.773, 47.048, 119.663, 46.693, 118.874, 46.805, 117.422, 46.673, 116.718, 46.388, 115.985, 45.727, 114.460, 45.340, 113.464, 44.809, 112.436, 45.012, 111.873, 45.102, 111.348, 44.457, 111.668, 44.073, 111.830, 43.743, 111.130, 43.407, 110.412, 42.871, 109.244, 42.519, 107.745, 42.482, 106.129, 42.134, 104.965, 41.597, 104.522, 41.908, 103.312, 41.907, 101.833, 42.515, 100.846, 42.664, 99.516, 42.525, 97.452, 42.749, 96.349, 42.726, 95.762, 43.319, 95.307, 44.241, 94.689, 44.352, 93.481, 44.975, 92.134, 45.115, 90.946, 45.286, 90.586, 45.720, 90.971, 46.888, 90.281, 47.694, 88.854, 48.069, 88.014, 48.599, 87.751, 49.297},
+	}},
+	{name: "Montenegro", rings: [][]float32{
+		{20.071, 42.589, 19.802, 42.500, 19.738, 42.688, 19.304, 42.196, 19.372, 41.878, 19.162, 41.955, 18.882, 42.282, 18.450, 42.480, 18.560, 42.650, 18.706, 43.200, 19.032, 43.433, 19.219, 43.524, 19.484, 43.352, 19.630, 43.214, 19.959, 43.106, 20.340, 42.899, 20.258, 42.813, 20.071, 42.589},
+	}},
+	{name: "Morocco", rings: [][]float32{
+		{-2.170, 35.168, -1.793, 34.528, -1.733, 33.920, -1.388, 32.864, -1.125, 32.652, -1.308, 32.263, -2.617, 32.094, -3.069, 31.724, -3.647, 31.637, -3.690, 30.897, -4.860, 30.501, -5.242, 30.000, -6.061, 29.732, -7.059, 29.579, -8.674, 28.841, -8.666, 27.656, -8.818, 27.656, -8.795, 27.121, -9.413, 27.088, -9.735, 26.861, -10.189, 26.861, -10.551, 26.991, -11.393, 26.883, -11.718, 26.104, -12.031, 26.031, -12.501, 24.770, -13.891, 23.691, -14.221, 22.310, -14.631, 21.861, -14.751, 21.501, -17.003, 21.421, -17.020, 21.422, -16.973, 21.886, -16.589, 22.158, -16.262, 22.679, -16.326, 23.018, -15.983, 23.723, -15.426, 24.359, -15.089, 24.520, -14.825, 25.104, -14.801, 25.636, -14.440, 26.254, -13.774, 26.619, -13.140, 27.640, -13.122, 27.654, -12.619, 28.038, -11.689, 28.149, -10.901, 28.832, -10.400, 29.099, -9.565, 29.934, -9.815, 31.178, -9.435, 32.038, -9.301, 32.565, -8.657, 33.240, -7.654, 33.697, -6.913, 34.110, -6.244, 35.146, -5.930, 35.760, -5.194, 35.755, -4.591, 35.331, -3.640, 35.400, -2.604, 35.179, -2.170, 35.168},
+	}},
+	{name: "Mozambique", rings: [][]float32{
+		{34.560, -11.520, 35.312, -11.439, 36.514, -11.721, 36.775, -11.595, 37.471, -11.569, 37.828, -11.269, 38.428, -11.285, 39.521, -10.897, 40.317, -10.317, 40.317, -10.317, 40.317, -10.317, 40.478, -10.765, 40.437, -11.762, 40.561, -12.639, 40.600, -14.202, 40.775, -14.692, 40.477, -15.406, 40.089, -16.101, 39.453, -16.721, 38.538, -17.101, 37.411, -17.586, 36.281, -18.660, 35.896, -18.842, 35.198, -19.553, 34.786, -19.784, 34.702, -20.497, 35.176, -21.254, 35.373, -21.841, 35.386, -22.140, 35.563, -22.090, 35.534, -23.071, 35.372, -23.535, 35.607, -23.707, 35.459, -24.123, 35.041, -24.478, 34.216, -24.816, 33.013, -25.358, 32.575, -25.727, 32.660, -26.149, 32.916, -26.216, 32.830, -26.742, 32.072, -26.734, 31.986, -26.292, 31.838, -25.843, 31.752, -25.484, 31.931, -24.369, 31.670, -23.659, 31.191, -22.252, 32.245, -21.116, 32.509, -20.395, 32.660, -20.304, 32.773, -19.716, 32.612, -19.419, 32.655, -18.672, 32.850, -17.979, 32.848, -16.713, 32.328, -16.392, 31.852, -16.319, 31.636, -16.072, 31.173, -15.861, 30.339, -15.881, 30.274, -15.508, 30.179, -14.796, 33.214, -13.972, 33.790, -14.452, 34.065, -14.360, 34.460, -14.613, 34.518, -15.014, 34.307, -15.479, 34.381, -16.184, 35.034, -16.801, 35.339, -16.107, 35.772, -15.897, 35.687, -14.611, 35.268, -13.888, 34.907, -13.565, 34.560, -13.580, 34.280, -12.280, 34.560, -11.520},
+	}},
+	{name: "Myanmar", rings: [][]float32{
+		{100.116, 20.418, 99.543, 20.187, 98.960, 19.753, 98.254, 19.708, 97.798, 18.627, 97.376, 18.445, 97.859, 17.568, 98.494, 16.838, 98.903, 16.178, 98.537, 15.308, 98.192, 15.124, 98.431, 14.622, 99.098, 13.828, 99.212, 13.269, 99.196, 12.805, 99.587, 11.893, 99.038, 10.961, 98.554, 9.933, 98.457, 10.675, 98.765, 11.441, 98.428, 12.033, 98.510, 13.122, 98.104, 13.640, 97.778, 14.837, 97.597, 16.101, 97.165, 16.929, 96.506, 16.427, 95.369, 15.714, 94.808, 15.803, 94.189, 16.038, 94.533, 17.277, 94.325, 18.214, 93.541, 19.366, 93.663, 19.727, 93.078, 19.855, 92.369, 20.671, 92.303, 21.475, 92.652, 21.324, 92.673, 22.041, 93.166, 22.278, 93.060, 22.703, 93.286, 23.044, 93.325, 24.079, 94.107, 23.851, 94.553, 24.675, 94.603, 25.162, 95.155, 26.001, 95.125, 26.574, 96.419, 27.265, 97.134, 27.084, 97.052, 27.699, 97.403, 27.883, 97.327, 28.262, 97.912, 28.336, 98.246, 27.747, 98.683, 27.509, 98.712, 26.744, 98.672, 25.919, 97.725, 25.084, 97.605, 23.897, 98.660, 24.063, 98.899, 23.143, 99.532, 22.949, 99.241, 22.118, 99.983, 21.743, 100.417, 21.559, 101.150, 21.850, 101.180, 21.437, 100.329, 20.786, 100.116, 20.418},
+	}},
+	{name: "N. Cyprus", rings: [][]float32{
+		{32.732, 35.140, 32.802, 35.146, 32.947, 35.387, 33.667, 35.373, 34.576, 35.672, 33.901, 35.246, 33.974, 35.059, 33.866, 35.094, 33.675, 35.018, 33.526, 35.039, 33.476, 35.000, 33.456, 35.101, 33.384, 35.163, 33.191, 35.173, 32.920, 35.088, 32.732, 35.140},
+	}},
+	{name: "Namibia", rings: [][]float32{
+		{19.896, -24.768, 19.895, -28.461, 19.002, -28.972, 18.465, -29.045, 17.836, -28.856, 17.387, -28.784, 17.219, -28.356, 16.824, -28.082, 16.345, -28.577, 15.602, -27.821, 15.210, -27.091, 14.990, -26.117, 14.743, -25.393, 14.408, -23.853, 14.386, -22.657, 14.258, -22.111, 13.869, -21.699, 13.352, -20.873, 12.827, -19.673, 12.609, -19.045, 11.795, -18.069, 11.734, -17.302, 12.215, -17.112, 12.814, -16.941, 13.462, -16.971, 14.059, -17.423, 14.210, -17.353, 18.263, -17.310, 18.956, -17.789, 21.377, -17.931, 23.215, -17.523, 24.034, -17.296, 24.682, -17.353, 25.077, -17.579, 25.084, -17.662, 24.521, -17.887, 24.217, -17.889, 23.579, -18.281, 23.197, -17.869, 21.655, -18.219, 20.911, -18.252, 20.881, -21.814, 19.895, -21.849, 19.896, -24.768},
+	}},
+	{name: "Nepal", rings: [][]float32{
+		{88.120, 27.877, 88.043, 27.446, 88.175, 26.810, 88.060, 26.415, 87.227, 26.398, 86.024, 26.631, 85.252, 26.726, 84.675, 27.235, 83.304, 27.365, 82.000, 27.925, 81.057, 28.416, 80.088, 28.794, 80.477, 29.730, 81.111, 30.183, 81.526, 30.423, 82.328, 30.115, 83.337, 29.464, 83.899, 29.320, 84.235, 28.840, 85.012, 28.643, 85.823, 28.204, 86.955, 27.974, 88.120, 27.877},
+	}},
+	{name: "Netherlands", rings: [][]float32{
+		{6.905, 53.482, 7.092, 53.144, 6.843, 52.228, 6.589, 51.852, 5.989, 51.852, 6.157, 50.804, 5.607, 51.037, 4.974, 51.475, 4.047, 51.267, 3.315, 51.346, 3.315, 51.346, 3.830, 51.621, 4.706, 53.092, 6.074, 53.510, 6.905, 53.482},
+	}},
+	{name: "New Caledonia", rings: [][]float32{
+		{165.780, -21.080, 166.600, -21.700, 167.120, -22.160, 166.740, -22.400, 166.190, -22.130, 165.474, -21.680, 164.830, -21.150, 164.168, -20.445, 164.030, -20.106, 164.460, -20.120, 165.020, -20.460, 165.460, -20.800, 165.780, -21.080},
+	}},
+	{name: "New Zealand", rings: [][]float32{
+		{176.886, -40.066, 176.508, -40.605, 176.012, -41.290, 175.240, -41.688, 175.068, -41.426, 174.651, -41.282, 175.228, -40.459, 174.900, -39.909, 173.824, -39.509, 173.852, -39.147, 174.575, -38.798, 174.743, -38.028, 174.697, -37.381, 174.292, -36.711, 174.319, -36.535, 173.841, -36.122, 173.054, -35.237, 172.636, -34.529, 173.007, -34.451, 173.551, -35.006, 174.329, -35.265, 174.612, -36.156, 175.337, -37.209, 175.358, -36.526, 175.809, -36.799, 175.958, -37.555, 176.763, -37.881, 177.439, -37.961, 178.010, -37.580, 178.517, -37.695, 178.275, -38.583, 177.970, -39.166, 177.207, -39.146, 176.940, -39.450, 177.033, -39.880, 176.886, -40.066},
+		{169.668, -43.555, 170.525, -43.032, 171.125, -42.513, 171.570, -41.767, 171.949, -41.514, 172.097, -40.956, 172.799, -40.494, 173.020, -40.919, 173.247, -41.332, 173.958, -40.927, 174.248, -41.349, 174.249, -41.770, 173.876, -42.233, 173.223, -42.970, 172.711, -43.372, 173.080, -43.853, 172.309, -43.866, 171.453, -44.243, 171.185, -44.897, 170.617, -45.909, 169.831, -46.356, 169.332, -46.641, 168.411, -46.620, 167.764, -46.290, 166.677, -46.220, 166.509, -45.853, 167.046, -45.111, 168.304, -44.124, 168.949, -43.936, 169.668, -43.555},
+	}},
+	{name: "Nicaragua", rings: [][]float32{
+		{-83.656, 10.939, -83.895, 10.727, -84.190, 10.793, -84.356, 10.999, -84.673, 11.083, -84.903, 10.952, -85.562, 11.217, -85.713, 11.088, -86.058, 11.403, -86.526, 11.807, -86.746, 12.144, -87.168, 12.458, -87.668, 12.910, -87.557, 13.065, -87.392, 12.914, -87.317, 12.985, -87.006, 13.026, -86.881, 13.254, -86.734, 13.263, -86.755, 13.755, -86.521, 13.778, -86.312, 13.771, -86.096, 14.038, -85.801, 13.836, -85.699, 13.960, -85.514, 14.079, -85.165, 14.354, -85.149, 14.560, -85.053, 14.552, -84.925, 14.790, -84.820, 14.820, -84.650, 14.667, -84.449, 14.622, -84.228, 14.749, -83.976, 14.749, -83.629, 14.880, -83.490, 15.016, -83.147, 14.996, -83.233, 14.900, -83.284, 14.677, -83.182, 14.311, -83.412, 13.970, -83.520, 13.568, -83.552, 13.127, -83.499, 12.869, -83.473, 12.419, -83.626, 12.321, -83.720, 11.893, -83.651, 11.629, -83.855, 11.373, -83.809, 11.103, -83.656, 10.939},
+	}},
+	{name: "Niger", rings: [][]float32{
+		{14.851, 22.863, 15.097, 21.309, 15.471, 21.048, 15.487, 20.730, 15.903, 20.388, 15.686, 19.957, 15.300, 17.928, 15.248, 16.627, 13.972, 15.684, 13.540, 14.367, 13.957, 13.997, 13.954, 13.353, 14.596, 13.330, 14.496, 12.859, 14.214, 12.802, 14.181, 12.484, 13.995, 12.462, 13.319, 13.556, 13.084, 13.596, 12.302, 13.037, 11.528, 13.329, 10.990, 13.387, 10.701, 13.247, 10.115, 13.277, 9.525, 12.851, 9.015, 12.827, 7.805, 13.344, 7.331, 13.098, 6.820, 13.115, 6.445, 13.493, 5.443, 13.866, 4.368, 13.747, 4.108, 13.531, 3.967, 12.956, 3.681, 12.553, 3.611, 11.660, 2.849, 12.236, 2.490, 12.233, 2.154, 11.940, 2.177, 12.625, 1.024, 12.852, 0.993, 13.336, 0.430, 13.989, 0.296, 14.444, 0.375, 14.929, 1.016, 14.968, 1.386, 15.324, 2.750, 15.410, 3.638, 15.568, 3.723, 16.184, 4.270, 16.852, 4.267, 19.155, 5.678, 19.601, 8.573, 21.566, 12.000, 23.472, 13.581, 23.041, 14.144, 22.491, 14.851, 22.863},
+	}},
+	{name: "Nigeria", rings: [][]float32{
+		{2.692, 6.259, 2.749, 7.871, 2.724, 8.507, 2.912, 9.138, 3.220, 9.444, 3.705, 10.063, 3.600, 10.332, 3.797, 10.735, 3.572, 11.328, 3.611, 11.660, 3.681, 12.553, 3.967, 12.956, 4.108, 13.531, 4.368, 13.747, 5.443, 13.866, 6.445, 13.493, 6.820, 13.115, 7.331, 13.098, 7.805, 13.344, 9.015, 12.827, 9.525, 12.851, 10.115, 13.277, 10.701, 13.247, 10.990, 13.387, 11.528, 13.329, 12.302, 13.037, 13.084, 13.596, 13.319, 13.556, 13.995, 12.462, 14.181, 12.484, 14.577, 12.085, 14.468, 11.905, 14.415, 11.572, 13.573, 10.799, 13.309, 10.160, 13.168, 9.641, 12.955, 9.418, 12.754, 8.718, 12.219, 8.306, 12.064, 7.800, 11.839, 7.397, 11.746, 6.981, 11.059, 6.644, 10.497, 7.055, 10.118, 7.039, 9.523, 6.453, 9.233, 6.444, 8.758, 5.480, 8.500, 4.772, 7.462, 4.412, 7.083, 4.465, 6.698, 4.241, 5.898, 4.262, 5.363, 4.888, 5.034, 5.612, 4.326, 6.271, 3.574, 6.258, 2.692, 6.259},
+	}},
+	{name: "North Korea", rings: [][]float32{
+		{130.780, 42.220, 130.780, 42.220, 130.780, 42.220, 130.780, 42.220},
+		{130.640, 42.395, 130.640, 42.395, 130.780, 42.220, 130.400, 42.280, 129.966, 41.941, 129.667, 41.601, 129.705, 40.883, 129.188, 40.662, 129.010, 40.485, 128.633, 40.190, 127.967, 40.025, 127.533, 39.757, 127.502, 39.324, 127.385, 39.213, 127.783, 39.051, 128.350, 38.612, 128.206, 38.370, 127.780, 38.305, 127.073, 38.256, 126.684, 37.805, 126.237, 37.840, 126.175, 37.750, 125.689, 37.940, 125.568, 37.752, 125.275, 37.669, 125.240, 37.857, 124.981, 37.949, 124.712, 38.108, 124.986, 38.548, 125.222, 38.666, 125.133, 38.849, 125.387, 39.388, 125.321, 39.551, 124.737, 39.660, 124.266, 39.928, 125.080, 40.570, 126.182, 41.107, 126.869, 41.817, 127.344, 41.503, 128.208, 41.467, 128.052, 41.994, 129.597, 42.425, 129.994, 42.985, 130.640, 42.395},
+	}},
+	{name: "North Macedonia", rings: [][]float32{
+		{22.381, 42.320, 22.881, 41.999, 22.952, 41.338, 22.762, 41.305, 22.597, 41.130, 22.055, 41.150, 21.674, 40.931, 21.020, 40.843, 20.605, 41.086, 20.463, 41.515, 20.590, 41.855, 20.590, 41.855, 20.717, 41.847, 20.762, 42.052, 21.353, 42.207, 21.577, 42.245, 21.917, 42.304, 22.381, 42.320},
+	}},
+	{name: "Norway", rings: [][]float32{
+		{15.143, 79.674, 15.523, 80.016, 16.991, 80.051, 18.252, 79.702, 21.544, 78.956, 19.027, 78.563, 18.472, 77.827, 17.594, 77.638, 17.118, 76.809, 15.913, 76.770, 13.763, 77.380, 14.670, 77.736, 13.171, 78.025, 11.222, 78.869, 10.445, 79.652, 13.171, 80.010, 13.719, 79.660, 15.143, 79.674},
+		{31.101, 69.558, 29.400, 69.157, 28.592, 69.065, 29.016, 69.766, 27.732, 70.164, 26.180, 69.825, 25.689, 69.092, 24.736, 68.650, 23.662, 68.891, 22.356, 68.842, 21.245, 69.370, 20.646, 69.106, 20.025, 69.065, 19.879, 68.407, 17.994, 68.567, 17.729, 68.011, 16.769, 68.014, 16.109, 67.302, 15.108, 66.194, 13.556, 64.787, 13.920, 64.445, 13.572, 64.049, 12.580, 64.066, 11.931, 63.128, 11.992, 61.800, 12.631, 61.294, 12.300, 60.118, 11.468, 59.432, 11.027, 58.856, 10.357, 59.470, 8.382, 58.313, 7.049, 58.079, 5.666, 58.588, 5.308, 59.663, 4.992, 61.971, 5.913, 62.614, 8.553, 63.454, 10.528, 64.486, 12.358, 65.880, 14.761, 67.811, 16.436, 68.563, 19.184, 69.817, 21.378, 70.255, 23.024, 70.202, 24.547, 71.030, 26.370, 70.986, 28.166, 71.185, 31.293, 70.454, 30.005, 70.186, 31.101, 69.558},
+		{27.408, 80.056, 25.925, 79.518, 23.024, 79.400, 20.075, 79.567, 19.897, 79.842, 18.462, 79.860, 17.368, 80.319, 20.456, 80.598, 21.908, 80.358, 22.919, 80.657, 25.448, 80.407, 27.408, 80.056},
+		{24.724, 77.854, 22.490, 77.445, 20.726, 77.677, 21.416, 77.935, 20.812, 78.255, 22.884, 78.455, 23.281, 78.080, 24.724, 77.854},
+	}},
+	{name: "Oman", rings: [][]float32{
+		{55.208, 22.708, 55.234, 23.111, 55.526, 23.525, 55.529, 23.934, 55.981, 24.131, 55.804, 24.270, 55.886, 24.921, 56.397, 24.925, 56.845, 24.242, 57.403, 23.879, 58.137, 23.748, 58.729, 23.566, 59.181, 22.992, 59.450, 22.660, 59.808, 22.534, 59.806, 22.311, 59.442, 21.715, 59.282, 21.434, 58.861, 21.114, 58.488, 20.429, 58.034, 20.481, 57.826, 20.243, 57.666, 19.736, 57.789, 19.068, 57.694, 18.945, 57.234, 18.948, 56.610, 18.574, 56.512, 18.087, 56.284, 17.876, 55.661, 17.884, 55.270, 17.632, 55.275, 17.228, 54.791, 16.951, 54.239, 17.045, 53.571, 16.708, 53.109, 16.651, 52.782, 17.350, 52.000, 19.000, 55.000, 20.000, 55.667, 22.000, 55.208, 22.708},
+		{56.261, 25.715, 56.071, 26.055, 56.362, 26.396, 56.486, 26.309, 56.391, 25.896, 56.261, 25.715},
+	}},
+	{name: "Pakistan", rings: [][]float32{
+		{77.837, 35.494, 76.872, 34.654, 75.757, 34.505, 74.240, 34.749, 73.750, 34.318, 74.104, 33.441, 74.452, 32.765, 75.259, 32.271, 74.406, 31.693, 74.421, 30.980, 73.451, 29.976, 72.824, 28.962, 71.778, 27.913, 70.616, 27.989, 69.514, 26.941, 70.169, 26.492, 70.283, 25.722, 70.845, 25.215, 71.043, 24.357, 68.843, 24.359, 68.177, 23.692, 67.444, 23.945, 67.145, 24.664, 66.373, 25.425, 64.530, 25.237, 62.906, 25.218, 61.497, 25.078, 61.874, 26.240, 63.317, 26.757, 63.234, 27.217, 62.755, 27.379, 62.728, 28.260, 61.772, 28.699, 61.369, 29.303, 60.874, 29.829, 62.550, 29.319, 63.550, 29.468, 64.148, 29.341, 64.350, 29.560, 65.047, 29.472, 66.346, 29.888, 66.381, 30.739, 66.939, 31.305, 67.683, 31.303, 67.793, 31.583, 68.557, 31.713, 68.927, 31.620, 69.318, 31.901, 69.263, 32.502, 69.687, 33.105, 70.324, 33.359, 69.931, 34.020, 70.882, 33.989, 71.157, 34.349, 71.115, 34.733, 71.613, 35.153, 71.499, 35.651, 71.262, 36.074, 71.846, 36.510, 72.920, 36.720, 74.068, 36.836, 74.576, 37.021, 75.158, 37.133, 75.897, 36.667, 76.193, 35.898, 77.837, 35.494},
+	}},
+	{name: "Palestine", rings: [][]float32{
+		{35.398, 31.489, 34.927, 31.353, 34.971, 31.617, 35.226, 31.754, 34.975, 31.867, 35.184, 32.533, 35.546, 32.394, 35.545, 31.783, 35.398, 31.489},
+	}},
+	{name: "Panama", rings: [][]float32{
+		{-77.353, 8.671, -77.475, 8.524, -77.243, 7.935, -77.431, 7.638, -77.753, 7.710, -77.882, 7.224, -78.215, 7.512, -78.429, 8.052, -78.182, 8.319, -78.435, 8.388, -78.622, 8.718, -79.120, 8.996, -79.558, 8.932, -79.761, 8.585, -80.164, 8.333, -80.383, 8.298, -80.481, 8.090, -80.004, 7.548, -80.277, 7.420, -80.421, 7.272, -80.886, 7.221, -81.060, 7.818, -81.190, 7.648, -81.520, 7.707, -81.721, 8.109, -82.131, 8.175, -82.391, 8.292, -82.820, 8.291, -82.851, 8.074, -82.966, 8.225, -82.913, 8.424, -82.830, 8.626, -82.869, 8.807, -82.719, 8.926, -82.927, 9.074, -82.933, 9.477, -82.546, 9.566, -82.187, 9.207, -82.208, 8.996, -81.809, 8.951, -81.714, 9.032, -81.439, 8.786, -80.947, 8.859, -80.522, 9.111, -79.915, 9.313, -79.573, 9.612, -79.021, 9.553, -79.058, 9.455, -78.501, 9.420, -78.056, 9.248, -77.730, 8.947, -77.353, 8.671},
+	}},
+	{name: "Papua New Guinea", rings: [][]float32{
+		{141.000, -2.600, 142.735, -3.289, 144.584, -3.861, 145.273, -4.374, 145.830, -4.876, 145.982, -5.466, 147.648, -6.084, 147.891, -6.614, 146.971, -6.722, 147.192, -7.388, 148.085, -8.044, 148.734, -9.105, 149.307, -9.071, 149.267, -9.514, 150.039, -9.684, 149.739, -9.873, 150.802, -10.294, 150.691, -10.583, 150.028, -10.652, 149.782, -10.393, 148.923, -10.281, 147.913, -10.130, 147.135, -9.492, 146.568, -8.943, 146.048, -8.067, 144.744, -7.630, 143.897, -7.915, 143.286, -8.245, 143.414, -8.983, 142.628, -9.327, 142.068, -9.160, 141.034, -9.118, 141.017, -5.859, 141.000, -2.600},
+		{152.640, -3.660, 153.020, -3.980, 153.140, -4.500, 152.827, -4.766, 152.639, -4.176, 152.406, -3.790, 151.953, -3.462, 151.384, -3.035, 150.662, -2.741, 150.940, -2.500, 151.480, -2.780, 151.820, -3.000, 152.240, -3.240, 152.640, -3.660},
+		{151.301, -5.841, 150.754, -6.084, 150.241, -6.318, 149.710, -6.317, 148.890, -6.026, 148.319, -5.747, 148.402, -5.438, 149.298, -5.584, 149.846, -5.506, 149.996, -5.026, 150.140, -5.001, 150.237, -5.532, 150.807, -5.456, 151.090, -5.114, 151.648, -4.757, 151.538, -4.168, 152.137, -4.149, 152.339, -4.313, 152.319, -4.868, 151.983, -5.478, 151.459, -5.560, 151.301, -5.841},
+		{154.760, -5.340, 155.063, -5.567, 155.548, -6.201, 156.020, -6.540, 155.880, -6.820, 155.600, -6.920, 155.167, -6.536, 154.729, -5.901, 154.514, -5.139, 154.653, -5.042, 154.760, -5.340},
+	}},
+	{name: "Paraguay", rings: [][]float32{
+		{-58.166, -20.177, -57.871, -20.733, -57.937, -22.090, -56.882, -22.282, -56.473, -22.086, -55.798, -22.357, -55.611, -22.656, -55.518, -23.572, -55.401, -23.957, -55.028, -24.001, -54.653, -23.840, -54.293, -24.021, -54.293, -24.571, -54.429, -25.162, -54.625, -25.739, -54.789, -26.622, -55.696, -27.388, -56.487, -27.548, -57.610, -27.396, -58.618, -27.124, -57.634, -25.604, -57.777, -25.162, -58.807, -24.771, -60.029, -24.033, -60.847, -23.881, -62.685, -22.249, -62.291, -21.052, -62.266, -20.514, -61.786, -19.634, -60.044, -19.343, -59.115, -19.357, -58.183, -19.868, -58.166, -20.177},
+	}},
+	{name: "Peru", rings: [][]float32{
+		{-69.894, -4.298, -70.795, -4.251, -70.929, -4.402, -71.748, -4.594, -72.892, -5.275, -72.965, -5.741, -73.220, -6.089, -73.120, -6.630, -73.724, -6.919, -73.723, -7.341, -73.987, -7.524, -73.571, -8.424, -73.015, -9.033, -73.227, -9.462, -72.563, -9.520, -72.185, -10.054, -71.302, -10.079, -70.482, -9.490, -70.549, -11.009, -70.094, -11.124, -69.530, -10.952, -68.665, -12.561, -68.880, -12.900, -68.929, -13.603, -68.949, -14.454, -69.340, -14.953, -69.160, -15.324, -69.390, -15.660, -68.960, -16.501, -69.590, -17.580, -69.858, -18.093, -70.373, -18.348, -71.375, -17.774, -71.462, -17.363, -73.445, -16.359, -75.238, -15.266, -76.009, -14.649, -76.423, -13.823, -76.259, -13.535, -77.106, -12.223, -78.092, -10.378, -79.037, -8.387, -79.446, -7.931, -79.761, -7.194, -80.537, -6.542, -81.250, -6.137, -80.926, -5.691, -81.411, -4.737, -81.100, -4.036, -80.303, -3.405, -80.184, -3.821, -80.469, -4.059, -80.442, -4.426, -80.029, -4.346, -79.625, -4.454, -79.205, -4.959, -78.640, -4.548, -78.451, -3.873, -77.838, -3.003, -76.635, -2.609, -75.545, -1.562, -75.234, -0.911, -75.373, -0.152, -75.107, -0.057, -74.442, -0.531, -74.122, -1.003, -73.660, -1.260, -73.070, -2.309, -72.326, -2.434, -71.775, -2.170, -71.414, -2.343, -70.813, -2.257, -70.048, -2.725, -70.693, -3.743, -70.394, -3.767, -69.894, -4.298},
+	}},
+	{name: "Philippines", rings: [][]float32{
+		{120.834, 12.704, 120.323, 13.466, 121.180, 13.430, 121.527, 13.070, 121.262, 12.206, 120.834, 12.704},
+		{122.586, 9.981, 122.837, 10.261, 122.947, 10.882, 123.499, 10.941, 123.338, 10.267, 124.078, 11.233, 123.982, 10.279, 123.623, 9.950, 123.310, 9.318, 122.996, 9.022, 122.380, 9.713, 122.586, 9.981},
+		{126.377, 8.415, 126.479, 7.750, 126.537, 7.189, 126.197, 6.274, 125.831, 7.294, 125.364, 6.786, 125.683, 6.050, 125.397, 5.581, 124.220, 6.161, 123.939, 6.885, 124.244, 7.361, 123.610, 7.834, 123.296, 7.419, 122.826, 7.457, 122.085, 6.899, 121.920, 7.192, 122.312, 8.035, 122.942, 8.316, 123.488, 8.693, 123.841, 8.240, 124.601, 8.514, 124.765, 8.960, 125.471, 8.987, 125.412, 9.760, 126.223, 9.286, 126.307, 8.782, 126.377, 8.415},
+		{118.505, 9.316, 117.174, 8.367, 117.664, 9.067, 118.387, 9.684, 118.987, 10.376, 119.511, 11.370, 119.690, 10.554, 119.029, 10.004, 118.505, 9.316},
+		{122.337, 18.225, 122.174, 17.810, 122.516, 17.094, 122.252, 16.262, 121.663, 15.931, 121.505, 15.125, 121.729, 14.328, 122.259, 14.218, 122.701, 14.337, 123.950, 13.782, 123.855, 13.238, 124.181, 12.998, 124.077, 12.537, 123.298, 13.028, 122.929, 13.553, 122.671, 13.186, 122.035, 13.784, 121.126, 13.637, 120.629, 13.858, 120.679, 14.271, 120.992, 14.525, 120.693, 14.757, 120.564, 14.396, 120.070, 14.971, 119.921, 15.406, 119.884, 16.364, 120.286, 16.035, 120.390, 17.599, 120.716, 18.505, 121.321, 18.504, 121.938, 18.219, 122.246, 18.479, 122.337, 18.225},
+		{122.038, 11.416, 121.884, 11.892, 122.484, 11.582, 123.120, 11.584, 123.101, 11.166, 122.638, 10.741, 122.003, 10.441, 121.967, 10.906, 122.038, 11.416},
+		{125.503, 12.163, 125.783, 11.046, 125.012, 11.311, 125.033, 10.976, 125.277, 10.359, 124.802, 10.135, 124.760, 10.838, 124.459, 10.890, 124.303, 11.495, 124.891, 11.416, 124.878, 11.794, 124.267, 12.558, 125.227, 12.536, 125.503, 12.163},
+	}},
+	{name: "Poland", rings: [][]float32{
+		{23.484, 53.912, 23.528, 53.470, 23.805, 53.090, 23.799, 52.691, 23.199, 52.487, 23.508, 52.024, 23.527, 51.578, 24.030, 50.705, 23.923, 50.425, 23.427, 50.309, 22.518, 49.477, 22.776, 49.027, 22.558, 49.086, 21.608, 49.470, 20.888, 49.329, 20.416, 49.431, 19.825, 49.217, 19.321, 49.572, 18.910, 49.436, 18.853, 49.496, 18.393, 49.989, 17.649, 50.049, 17.555, 50.362, 16.869, 50.474, 16.719, 50.216, 16.176, 50.423, 16.239, 50.698, 15.491, 50.785, 15.017, 51.107, 14.607, 51.745, 14.685, 52.090, 14.438, 52.625, 14.075, 52.981, 14.353, 53.248, 14.120, 53.757, 14.803, 54.051, 16.363, 54.513, 17.623, 54.852, 18.621, 54.683, 18.696, 54.439, 19.661, 54.426, 20.892, 54.313, 22.731, 54.328, 23.244, 54.221, 23.484, 53.912},
+	}},
+	{name: "Portugal", rings: [][]float32{
+		{-9.035, 41.881, -8.672, 42.135, -8.264, 42.280, -8.013, 41.791, -7.423, 41.792, -7.251, 41.918, -6.669, 41.883, -6.389, 41.382, -6.851, 41.111, -6.864, 40.331, -7.026, 40.185, -7.067, 39.712, -7.499, 39.630, -7.098, 39.030, -7.374, 38.373, -7.029, 38.076, -7.167, 37.804, -7.537, 37.429, -7.454, 37.098, -7.856, 36.838, -8.383, 36.979, -8.899, 36.869, -8.746, 37.651, -8.840, 38.266, -9.287, 38.358, -9.527, 38.737, -9.447, 39.392, -9.048, 39.755, -8.977, 40.159, -8.769, 40.761, -8.791, 41.184, -8.991, 41.543, -9.035, 41.881},
+	}},
+	{name: "Puerto Rico", rings: [][]float32{
+		{-66.282, 18.515, -65.771, 18.427, -65.591, 18.228, -65.847, 17.976, -66.600, 17.982, -67.184, 17.947, -67.242, 18.374, -67.101, 18.521, -66.282, 18.515},
+	}},
+	{name: "Qatar", rings: [][]float32{
+		{50.810, 24.755, 50.744, 25.482, 51.013, 26.007, 51.286, 26.115, 51.589, 25.801, 51.607, 25.216, 51.390, 24.627, 51.112, 24.556, 50.810, 24.755},
+	}},
+	{name: "Romania", rings: [][]float32{
+		{28.234, 45.488, 28.680, 45.304, 29.150, 45.465, 29.603, 45.293, 29.627, 45.035, 29.142, 44.820, 28.838, 44.914, 28.558, 43.707, 27.970, 43.812, 27.242, 44.176, 26.065, 43.943, 25.569, 43.688, 24.101, 43.741, 23.332, 43.897, 22.945, 43.824, 22.657, 44.235, 22.474, 44.409, 22.706, 44.578, 22.459, 44.703, 22.145, 44.478, 21.562, 44.769, 21.484, 45.181, 20.874, 45.416, 20.762, 45.735, 20.220, 46.127, 21.022, 46.316, 21.627, 46.994, 22.100, 47.672, 22.711, 47.882, 23.142, 48.096, 23.761, 47.986, 24.402, 47.982, 24.866, 47.738, 25.208, 47.891, 25.946, 47.987, 26.197, 48.221, 26.619, 48.221, 26.924, 48.123, 27.234, 47.827, 27.551, 47.405, 28.128, 46.810, 28.160, 46.372, 28.054, 45.945, 28.234, 45.488},
+	}},
+	{name: "Russia", rings: [][]float32{
+		{178.725, 71.099, 180.000, 71.516, 180.000, 70.832, 178.903, 70.781, 178.725, 71.099},
+		{49.101, 46.399, 48.645, 45.806, 47.676, 45.641, 46.682, 44.609, 47.591, 43.660, 47.493, 42.987, 48.584, 41.809, 48.584, 41.809, 47.987, 41.406, 47.816, 41.151, 47.373, 41.220, 46.686, 41.827, 46.405, 41.861, 45.776, 42.092, 45.470, 42.503, 44.538, 42.712, 43.931, 42.555, 43.756, 42.741, 42.394, 43.220, 40.922, 43.382, 40.077, 43.553, 39.955, 43.435, 38.680, 44.280, 37.539, 44.657, 36.675, 45.245, 37.403, 45.405, 38.233, 46.241, 37.674, 46.637, 39.148, 47.045, 39.121, 47.263, 38.224, 47.102, 38.255, 47.546, 38.771, 47.826, 39.738, 47.899, 39.896, 48.232, 39.675, 48.784, 40.081, 49.307, 40.069, 49.601, 38.595, 49.926, 38.011, 49.916, 37.393, 50.384, 36.626, 50.226, 35.356, 50.577, 35.378, 50.774, 35.022, 51.208, 34.225, 51.256, 34.142, 51.566, 34.392, 51.769, 33.753, 52.335, 32.716, 52.238, 32.412, 52.289, 32.159, 52.061, 31.786, 52.102, 31.786, 52.102, 31.540, 52.742, 31.305, 53.074, 31.498, 53.167, 32.305, 53.133, 32.694, 53.351, 32.406, 53.618, 31.731, 53.794, 31.791, 53.975, 31.384, 54.157, 30.758, 54.812, 30.972, 55.082, 30.874, 55.551, 29.896, 55.789, 29.372, 55.670, 29.230, 55.918, 28.177, 56.169, 27.855, 56.759, 27.770, 57.244, 27.288, 57.475, 27.717, 57.792, 27.420, 58.725, 28.132, 59.301, 27.981, 59.475, 27.981, 59.475, 29.118, 60.028, 28.070, 60.504, 28.070, 60.504, 30.211, 61.780, 31.140, 62.358, 31.516, 62.868, 30.036, 63.553, 30.445, 64.204, 29.544, 64.949, 30.218, 65.806, 29.055, 66.944, 29.977, 67.698, 28.446, 68.365, 28.592, 69.065, 29.400, 69.157, 31.101, 69.558, 31.101, 69.558, 32.133, 69.906, 33.775, 69.301, 36.514, 69.063, 40.292, 67.932, 41.060, 67.457, 41.126, 66.792, 40.016, 66.266, 38.383, 66.000, 33.919, 66.760, 33.184, 66.633, 34.815, 65.900, 34.879, 65.436, 34.944, 64.414, 36.231, 64.109, 37.013, 63.850, 37.142, 64.335, 36.540, 64.764, 37.176, 65.143, 39.593, 64.521, 40.436, 64.764, 39.763, 65.497, 42.093, 66.476, 43.016, 66.419, 43.950, 66.069, 44.532, 66.756, 43.698, 67.352, 44.188, 67.951, 43.453, 68.571, 46.250, 68.250, 46.821, 67.690, 45.555, 67.567, 45.562, 67.010, 46.349, 66.668, 47.894, 66.885, 48.139, 67.522, 50.228, 67.999, 53.717, 68.857, 54.472, 68.808, 53.486, 68.201, 54.726, 68.097, 55.443, 68.439, 57.317, 68.466, 58.802, 68.881, 59.941, 68.278, 61.078, 68.941, 60.030, 69.520, 60.550, 69.850, 63.504, 69.547, 64.888, 69.235, 68.512, 68.092, 69.181, 68.616, 68.164, 69.144, 68.135, 69.356, 66.930, 69.455, 67.260, 69.929, 66.725, 70.709, 66.695, 71.029, 68.540, 71.934, 69.196, 72.843, 69.940, 73.040, 72.588, 72.776, 72.796, 72.220, 71.848, 71.409, 72.470, 71.090, 72.792, 70.391, 72.565, 69.021, 73.668, 68.408, 73.239, 67.740, 71.280, 66.320, 72.423, 66.173, 72.821, 66.533, 73.921, 66.789, 74.187, 67.284, 75.052, 67.760, 74.469, 68.329, 74.936, 68.989, 73.842, 69.071, 73.602, 69.628, 74.400, 70.632, 73.101, 71.447, 74.891, 72.121, 74.659, 72.832, 75.158, 72.855, 75.684, 72.301, 75.289, 71.336, 76.359, 71.153, 75.903, 71.874, 77.577, 72.267, 79.652, 72.320, 81.500, 71.750, 80.611, 72.583, 80.511, 73.648, 82.250, 73.850, 84.655, 73.806, 86.822, 73.937, 86.010, 74.460, 87.167, 75.116, 88.316, 75.144, 90.260, 75.640, 92.901, 75.773, 93.234, 76.047, 95.860, 76.140, 96.678, 75.915, 98.923, 76.447, 100.760, 76.430, 101.035, 76.862, 101.991, 77.288, 104.352, 77.698, 106.067, 77.374, 104.705, 77.127, 106.970, 76.974, 107.240, 76.480, 108.154, 76.723, 111.077, 76.710, 113.332, 76.222, 114.134, 75.848, 113.885, 75.328, 112.779, 75.032, 110.151, 74.477, 109.400, 74.180, 110.640, 74.040, 112.119, 73.788, 113.020, 73.977, 113.530, 73.335, 113.969, 73.595, 115.568, 73.753, 118.776, 73.588, 119.020, 73.120, 123.201, 72.971, 123.258, 73.735, 125.380, 73.560, 126.976, 73.565, 128.591, 73.039, 129.052, 72.399, 128.460, 71.980, 129.716, 71.193, 131.289, 70.787, 132.254, 71.836, 133.858, 71.386, 135.562, 71.655, 137.498, 71.348, 138.234, 71.628, 139.870, 71.488, 139.148, 72.416, 140.468, 72.849, 149.500, 72.200, 150.351, 71.606, 152.969, 70.842, 157.007, 71.031, 158.998, 70.867, 159.830, 70.453, 159.709, 69.722, 160.941, 69.437, 162.279, 69.642, 164.052, 69.668, 165.940, 69.472, 167.836, 69.583, 169.578, 68.694, 170.817, 69.014, 170.008, 69.653, 170.453, 70.097, 173.644, 69.817, 175.724, 69.877, 178.600, 69.400, 180.000, 68.964, 180.000, 64.980, 179.993, 64.974, 178.707, 64.535, 177.411, 64.608, 178.313, 64.076, 178.908, 63.252, 179.370, 62.983, 179.486, 62.569, 179.228, 62.304, 177.364, 62.522, 174.569, 61.769, 173.680, 61.653, 172.150, 60.950, 170.698, 60.336, 170.331, 59.882, 168.900, 60.574, 166.295, 59.789, 165.840, 60.160, 164.877, 59.732, 163.539, 59.869, 163.217, 59.211, 162.017, 58.243, 162.053, 57.839, 163.192, 57.615, 163.058, 56.159, 162.130, 56.122, 161.701, 55.286, 162.117, 54.855, 160.369, 54.344, 160.022, 53.203, 158.531, 52.959, 158.231, 51.943, 156.790, 51.011, 156.420, 51.700, 155.992, 53.159, 155.434, 55.381, 155.914, 56.768, 156.758, 57.365, 156.810, 57.832, 158.364, 58.056, 160.151, 59.315, 161.872, 60.343, 163.670, 61.141, 164.474, 62.551, 163.258, 62.466, 162.658, 61.642, 160.121, 60.544, 159.302, 61.774, 156.721, 61.434, 154.218, 59.758, 155.044, 59.145, 152.812, 58.884, 151.266, 58.781, 151.338, 59.504, 149.784, 59.656, 148.545, 59.164, 145.487, 59.336, 142.198, 59.040, 138.958, 57.088, 135.126, 54.730, 136.702, 54.604, 137.193, 53.977, 138.165, 53.755, 138.805, 54.255, 139.902, 54.190, 141.345, 53.090, 141.379, 52.239, 140.597, 51.240, 140.513, 50.046, 140.062, 48.447, 138.555, 47.000, 138.220, 46.308, 136.862, 45.144, 135.515, 43.989, 134.869, 43.398, 133.537, 42.811, 132.906, 42.798, 132.278, 43.285, 130.936, 42.553, 130.780, 42.220, 130.780, 42.220, 130.780, 42.220, 130.780, 42.220, 130.640, 42.395, 130.640, 42.395, 130.634, 42.903, 131.145, 42.930, 131.289, 44.112, 131.025, 44.968, 131.883, 45.321, 133.097, 45.144, 133.770, 46.117, 134.112, 47.212, 134.501, 47.578, 135.026, 48.478, 133.374, 48.183, 132.507, 47.789, 130.987, 47.790, 130.582, 48.730, 129.398, 49.441, 127.657, 49.760, 127.287, 50.740, 126.939, 51.354, 126.564, 51.784, 125.946, 52.793, 125.068, 53.161, 123.571, 53.459, 122.246, 53.432, 121.003, 53.251, 120.177, 52.754, 120.726, 52.516, 120.738, 51.964, 120.182, 51.644, 119.279, 50.583, 119.288, 50.143, 117.879, 49.511, 116.679, 49.889, 115.486, 49.805, 114.962, 50.140, 114.362, 50.248, 112.898, 49.544, 111.581, 49.378, 110.662, 49.130, 109.402, 49.293, 108.475, 49.283, 107.868, 49.794, 106.889, 50.274, 105.887, 50.406, 104.622, 50.275, 103.677, 50.090, 102.256, 50.511, 102.065, 51.260, 100.889, 51.517, 99.982, 51.634, 98.861, 52.047, 97.826, 51.011, 98.232, 50.422, 97.260, 49.726, 95.814, 49.977, 94.816, 50.013, 94.148, 50.481, 93.104, 50.495, 92.235, 50.802, 90.714, 50.332, 88.806, 49.471, 87.751, 49.297, 87.360, 49.215, 86.829, 49.827, 85.541, 49.693, 85.116, 50.117, 84.416, 50.311, 83.935, 50.889, 83.383, 51.069, 81.946, 50.812, 80.568, 51.388, 80.036, 50.865, 77.801, 53.404, 76.525, 54.177, 76.891, 54.491, 74.385, 53.547, 73.426, 53.490, 73.509, 54.036, 72.224, 54.377, 71.180, 54.133, 70.865, 55.170, 69.068, 55.385, 68.169, 54.970, 65.667, 54.601, 65.179, 54.354, 61.437, 54.006, 60.978, 53.665, 61.700, 52.980, 60.740, 52.720, 60.927, 52.448, 59.968, 51.960, 61.588, 51.273, 61.337, 50.799, 59.933, 50.842, 59.642, 50.545, 58.363, 51.064, 56.778, 51.044, 55.717, 50.622, 54.533, 51.026, 52.329, 51.719, 50.767, 51.693, 48.702, 50.605, 48.578, 49.875, 47.549, 50.455, 46.752, 49.356, 47.044, 49.152, 46.466, 48.394, 47.315, 47.716, 48.057, 47.744, 48.695, 47.076, 48.593, 46.561, 49.101, 46.399},
+		{93.778, 81.025, 95.941, 81.250, 97.884, 80.747, 100.187, 79.780, 99.940, 78.881, 97.758, 78.756, 94.973, 79.045, 93.313, 79.427, 92.545, 80.144, 91.181, 80.341, 93.778, 81.025},
+		{102.838, 79.281, 105.372, 78.713, 105.075, 78.307, 99.438, 77.921, 101.265, 79.234, 102.086, 79.346, 102.838, 79.281},
+		{138.831, 76.137, 141.472, 76.093, 145.086, 75.563, 144.300, 74.820, 140.614, 74.848, 138.955, 74.611, 136.974, 75.262, 137.512, 75.949, 138.831, 76.137},
+		{148.222, 75.346, 150.732, 75.084, 149.576, 74.689, 147.977, 74.778, 146.119, 75.173, 146.358, 75.497, 148.222, 75.346},
+		{139.863, 73.370, 140.812, 73.765, 142.062, 73.858, 143.483, 73.475, 143.604, 73.212, 142.088, 73.205, 140.038, 73.317, 139.863, 73.370},
+		{44.847, 80.590, 46.799, 80.772, 48.318, 80.784, 48.523, 80.515, 49.097, 80.754, 50.040, 80.919, 51.523, 80.700, 51.136, 80.547, 49.794, 80.415, 48.894, 80.340, 48.755, 80.175, 47.586, 80.010, 46.503, 80.247, 47.072, 80.559, 44.847, 80.590},
+		{22.731, 54.328, 20.892, 54.313, 19.661, 54.426, 19.888, 54.866, 21.268, 55.190, 22.316, 55.015, 22.758, 54.857, 22.651, 54.583, 22.731, 54.328},
+		{53.508, 73.750, 55.902, 74.627, 55.632, 75.081, 57.869, 75.609, 61.170, 76.252, 64.498, 76.439, 66.211, 76.810, 68.157, 76.940, 68.852, 76.545, 68.181, 76.234, 64.637, 75.738, 61.584, 75.261, 58.477, 74.309, 56.987, 73.333, 55.419, 72.371, 55.623, 71.541, 57.536, 70.720, 56.945, 70.633, 53.677, 70.763, 53.412, 71.207, 51.602, 71.475, 51.456, 72.015, 52.478, 72.229, 52.444, 72.775, 54.428, 73.628, 53.508, 73.750},
+		{142.915, 53.705, 143.261, 52.741, 143.235, 51.757, 143.648, 50.748, 144.654, 48.976, 143.174, 49.307, 142.559, 47.862, 143.533, 46.837, 143.505, 46.138, 142.748, 46.741, 142.092, 45.967, 141.907, 46.806, 142.018, 47.780, 141.904, 48.859, 142.136, 49.615, 142.180, 50.952, 141.594, 51.935, 141.683, 53.302, 142.607, 53.762, 142.210, 54.225, 142.655, 54.366, 142.915, 53.705},
+		{-174.928, 67.206, -175.014, 66.584, -174.340, 66.336, -174.572, 67.062, -171.857, 66.913, -169.900, 65.977, -170.891, 65.541, -172.530, 65.438, -172.555, 64.461, -172.955, 64.253, -173.892, 64.283, -174.654, 64.631, -175.984, 64.923, -176.207, 65.357, -177.223, 65.520, -178.360, 65.391, -178.903, 65.740, -178.686, 66.112, -179.884, 65.875, -179.433, 65.404, -180.000, 64.980, -180.000, 68.964, -177.550, 68.200, -174.928, 67.206},
+		{-178.694, 70.893, -180.000, 70.832, -180.000, 71.516, -179.872, 71.558, -179.024, 71.556, -177.578, 71.269, -177.664, 71.133, -178.694, 70.893},
+		{33.436, 45.972, 33.699, 46.220, 34.410, 46.005, 34.732, 45.966, 34.862, 45.768, 35.013, 45.738, 35.021, 45.651, 35.510, 45.410, 36.530, 45.470, 36.335, 45.113, 35.240, 44.940, 33.883, 44.361, 33.326, 44.565, 33.547, 45.035, 32.454, 45.327, 32.631, 45.519, 33.588, 45.852, 33.436, 45.972},
+	}},
+	{name: "Rwanda", rings: [][]float32{
+		{30.419, -1.135, 30.816, -1.699, 30.758, -2.287, 30.470, -2.414, 30.470, -2.414, 29.938, -2.348, 29.632, -2.918, 29.025, -2.839, 29.117, -2.292, 29.255, -2.215, 29.292, -1.620, 29.579, -1.341, 29.822, -1.443, 30.419, -1.135},
+	}},
+	{name: "S. Sudan", rings: [][]float32{
+		{30.834, 3.509, 29.953, 4.174, 29.716, 4.601, 29.159, 4.389, 28.697, 4.455, 28.429, 4.287, 27.980, 4.408, 27.374, 5.234, 27.213, 5.551, 26.466, 5.947, 26.213, 6.547, 25.797, 6.979, 25.124, 7.500, 25.115, 7.825, 24.567, 8.229, 23.887, 8.620, 24.194, 8.729, 24.537, 8.918, 24.795, 9.810, 25.070, 10.274, 25.791, 10.411, 25.962, 10.136, 26.477, 9.553, 26.752, 9.467, 27.113, 9.639, 27.834, 9.604, 27.971, 9.398, 28.967, 9.398, 29.001, 9.604, 29.516, 9.793, 29.619, 10.085, 29.997, 10.291, 30.838, 9.707, 31.353, 9.810, 31.851, 10.531, 32.400, 11.081, 32.314, 11.681, 32.074, 11.973, 32.675, 12.025, 32.743, 12.248, 33.207, 12.179, 33.087, 11.441, 33.207, 10.720, 33.722, 10.325, 33.842, 9.982, 33.825, 9.484, 33.963, 9.464, 33.975, 8.685, 33.825, 8.379, 33.295, 8.355, 32.954, 7.785, 33.568, 7.713, 34.075, 7.226, 34.250, 6.826, 34.707, 6.594, 35.298, 5.506, 34.620, 4.847, 34.005, 4.250, 33.390, 3.790, 32.686, 3.792, 31.881, 3.558, 31.246, 3.782, 30.834, 3.509},
+	}},
+	{name: "Saudi Arabia", rings: [][]float32{
+		{34.956, 29.357, 36.069, 29.197, 36.501, 29.505, 36.741, 29.865, 37.504, 30.004, 37.668, 30.339, 37.999, 30.509, 37.002, 31.508, 39.005, 32.010, 39.195, 32.161, 40.400, 31.890, 41.890, 31.190, 44.709, 29.179, 46.569, 29.099, 47.460, 29.003, 47.709, 28.526, 48.416, 28.552, 48.808, 27.690, 49.300, 27.461, 49.471, 27.110, 50.152, 26.690, 50.213, 26.277, 50.113, 25.944, 50.240, 25.608, 50.527, 25.328, 50.661, 25.000, 50.810, 24.755, 51.112, 24.556, 51.390, 24.627, 51.580, 24.245, 51.618, 24.014, 52.001, 23.001, 55.007, 22.497, 55.208, 22.708, 55.667, 22.000, 55.000, 20.000, 52.000, 19.000, 49.117, 18.617, 48.183, 18.167, 47.467, 17.117, 47.000, 16.950, 46.750, 17.283, 46.367, 17.233, 45.400, 17.333, 45.217, 17.433, 44.063, 17.410, 43.792, 17.320, 43.381, 17.580, 43.116, 17.088, 43.218, 16.667, 42.779, 16.348, 42.650, 16.775, 42.348, 17.076, 42.271, 17.475, 41.754, 17.833, 41.221, 18.672, 40.939, 19.486, 40.248, 20.175, 39.802, 20.339, 39.139, 21.292, 39.024, 21.987, 39.066, 22.580, 38.493, 23.688, 38.024, 24.079, 37.484, 24.285, 37.155, 24.858, 37.209, 25.085, 36.932, 25.603, 36.640, 25.826, 36.249, 26.570, 35.640, 27.377, 35.130, 28.063, 34.632, 28.059, 34.788, 28.607, 34.832, 28.957, 34.956, 29.357},
+	}},
+	{name: "Senegal", rings: [][]float32{
+		{-16.714, 13.595, -17.126, 14.374, -17.625, 14.730, -17.185, 14.919, -16.701, 15.622, -16.463, 16.135, -16.121, 16.456, -15.624, 16.369, -15.136, 16.587, -14.577, 16.598, -14.100, 16.304, -13.436, 16.039, -12.831, 15.304, -12.171, 14.617, -12.125, 13.995, -11.928, 13.422, -11.553, 13.141, -11.468, 12.755, -11.514, 12.443, -11.658, 12.387, -12.204, 12.466, -12.279, 12.354, -12.499, 12.332, -13.218, 12.576, -13.700, 12.586, -15.548, 12.628, -15.817, 12.516, -16.148, 12.548, -16.677, 12.385, -16.842, 13.151, -15.931, 13.130, -15.691, 13.270, -15.512, 13.279, -15.141, 13.510, -14.712, 13.298, -14.278, 13.281, -13.845, 13.505, -14.047, 13.794, -14.377, 13.626, -14.687, 13.630, -15.082, 13.876, -15.399, 13.860, -15.625, 13.624, -16.714, 13.595},
+	}},
+	{name: "Serbia", rings: [][]float32{
+		{18.830, 45.909, 18.830, 45.909, 19.596, 46.172, 20.220, 46.127, 20.762, 45.735, 20.874, 45.416, 21.484, 45.181, 21.562, 44.769, 22.145, 44.478, 22.459, 44.703, 22.706, 44.578, 22.474, 44.409, 22.657, 44.235, 22.410, 44.008, 22.500, 43.643, 22.986, 43.211, 22.605, 42.899, 22.437, 42.580, 22.545, 42.461, 22.381, 42.320, 21.917, 42.304, 21.577, 42.245, 21.543, 42.320, 21.663, 42.439, 21.775, 42.683, 21.633, 42.677, 21.439, 42.863, 21.274, 42.910, 21.143, 43.069, 20.957, 43.131, 20.814, 43.272, 20.635, 43.217, 20.497, 42.885, 20.258, 42.813, 20.340, 42.899, 19.959, 43.106, 19.630, 43.214, 19.484, 43.352, 19.219, 43.524, 19.454, 43.568, 19.600, 44.038, 19.118, 44.423, 19.368, 44.863, 19.005, 44.860, 19.005, 44.860, 19.390, 45.237, 19.073, 45.522, 18.830, 45.909},
+	}},
+	{name: "Sierra Leone", rings: [][]float32{
+		{-13.247, 8.903, -12.712, 9.343, -12.597, 9.620, -12.426, 9.836, -12.150, 9.859, -11.917, 10.047, -11.117, 10.046, -10.839, 9.688, -10.622, 9.268, -10.655, 8.977, -10.494, 8.716, -10.505, 8.349, -10.230, 8.406, -10.696, 7.939, -11.147, 7.397, -11.200, 7.106, -11.439, 6.786, -11.708, 6.860, -12.428, 7.263, -12.949, 7.799, -13.124, 8.164, -13.247, 8.903},
+	}},
+	{name: "Slovakia", rings: [][]float32{
+		{22.558, 49.086, 22.281, 48.825, 22.086, 48.422, 21.872, 48.320, 20.801, 48.624, 20.474, 48.563, 20.239, 48.328, 19.769, 48.203, 19.661, 48.267, 19.174, 48.111, 18.777, 48.082, 18.697, 47.881, 17.857, 47.758, 17.488, 47.867, 16.980, 48.123, 16.880, 48.470, 16.960, 48.597, 17.102, 48.817, 17.545, 48.800, 17.886, 48.903, 17.914, 48.996, 18.105, 49.044, 18.170, 49.272, 18.400, 49.315, 18.555, 49.495, 18.853, 49.496, 18.910, 49.436, 19.321, 49.572, 19.825, 49.217, 20.416, 49.431, 20.888, 49.329, 21.608, 49.470, 22.558, 49.086},
+	}},
+	{name: "Slovenia", rings: [][]float32{
+		{13.806, 46.509, 14.632, 46.432, 15.137, 46.659, 16.012, 46.684, 16.202, 46.852, 16.371, 46.841, 16.565, 46.504, 15.769, 46.238, 15.672, 45.834, 15.324, 45.732, 15.328, 45.452, 14.935, 45.472, 14.595, 45.635, 14.412, 45.466, 13.715, 45.500, 13.938, 45.591, 13.698, 46.017, 13.806, 46.509},
+	}},
+	{name: "Solomon Is.", rings: [][]float32{
+		{162.119, -10.483, 162.399, -10.826, 161.700, -10.820, 161.320, -10.205, 161.917, -10.447, 162.119, -10.483},
+		{161.680, -9.600, 161.529, -9.784, 160.788, -8.918, 160.580, -8.320, 160.920, -8.320, 161.280, -9.120, 161.680, -9.600},
+		{160.852, -9.873, 160.463, -9.895, 159.849, -9.794, 159.640, -9.640, 159.703, -9.243, 160.363, -9.400, 160.689, -9.610, 160.852, -9.873},
+		{159.640, -8.020, 159.875, -8.337, 159.917, -8.538, 159.134, -8.114, 158.586, -7.755, 158.211, -7.422, 158.360, -7.320, 158.820, -7.560, 159.640, -8.020},
+		{157.140, -7.022, 157.538, -7.348, 157.339, -7.405, 156.902, -7.177, 156.491, -6.766, 156.543, -6.599, 157.140, -7.022},
+	}},
+	{name: "Somalia", rings: [][]float32{
+		{41.585, -1.683, 40.993, -0.858, 40.981, 2.785, 41.855, 3.919, 42.129, 4.234, 42.770, 4.253, 43.661, 4.958, 44.964, 5.002, 47.789, 8.003, 48.487, 8.838, 48.938, 9.452, 48.938, 9.973, 48.938, 10.982, 48.942, 11.394, 48.948, 11.411, 48.948, 11.411, 49.268, 11.430, 49.729, 11.579, 50.259, 11.680, 50.732, 12.022, 51.111, 12.025, 51.134, 11.748, 51.042, 11.167, 51.045, 10.641, 50.834, 10.280, 50.552, 9.199, 50.071, 8.082, 49.453, 6.805, 48.595, 5.339, 47.741, 4.219, 46.565, 2.855, 45.564, 2.046, 44.068, 1.053, 43.136, 0.292, 42.042, -0.919, 41.811, -1.446, 41.585, -1.683},
+	}},
+	{name: "Somaliland", rings: [][]float32{
+		{48.948, 11.411, 48.948, 11.411, 48.942, 11.394, 48.938, 10.982, 48.938, 9.973, 48.938, 9.452, 48.487, 8.838, 47.789, 8.003, 46.948, 7.997, 43.679, 9.184, 43.297, 9.540, 42.928, 10.022, 42.559, 10.573, 42.777, 10.927, 43.145, 11.462, 43.471, 11.278, 43.667, 10.864, 44.118, 10.446, 44.614, 10.442, 45.557, 10.698, 46.645, 10.817, 47.526, 11.127, 48.022, 11.193, 48.379, 11.375, 48.948, 11.411, 48.948, 11.411},
+	}},
+	{name: "South Africa", rings: [][]float32{
+		{16.345, -28.577, 16.824, -28.082, 17.219, -28.356, 17.387, -28.784, 17.836, -28.856, 18.465, -29.045, 19.002, -28.972, 19.895, -28.461, 19.896, -24.768, 20.166, -24.918, 20.759, -25.868, 20.666, -26.477, 20.890, -26.829, 21.606, -26.727, 22.106, -26.280, 22.580, -25.979, 22.824, -25.500, 23.312, -25.269, 23.734, -25.390, 24.211, -25.670, 25.025, -25.720, 25.665, -25.487, 25.766, -25.175, 25.942, -24.696, 26.486, -24.616, 26.786, -24.241, 27.119, -23.574, 28.017, -22.828, 29.432, -22.091, 29.839, -22.102, 30.323, -22.272, 30.660, -22.152, 31.191, -22.252, 31.670, -23.659, 31.931, -24.369, 31.752, -25.484, 31.838, -25.843, 31.333, -25.660, 31.044, -25.731, 30.950, -26.023, 30.677, -26.398, 30.686, -26.744, 31.283, -27.286, 31.868, -27.178, 32.072, -26.734, 32.830, -26.742, 32.580, -27.470, 32.462, -28.301, 32.203, -28.752, 31.521, -29.257, 31.326, -29.402, 30.902, -29.910, 30.623, -30.424, 30.056, -31.140, 28.926, -32.172, 28.220, -32.772, 27.465, -33.227, 26.419, -33.615, 25.910, -33.667, 25.781, -33.945, 25.173, -33.797, 24.678, -33.987, 23.594, -33.794, 22.988, -33.916, 22.574, -33.864, 21.543, -34.259, 20.689, -34.417, 20.071, -34.795, 19.616, -34.819, 19.193, -34.463, 18.855, -34.444, 18.425, -33.998, 18.377, -34.137, 18.244, -33.868, 18.250, -33.281, 17.925, -32.611, 18.248, -32.429, 18.222, -31.662, 17.567, -30.726, 17.064, -29.879, 17.063, -29.876, 16.345, -28.577},
+		{28.978, -28.956, 28.542, -28.648, 28.074, -28.851, 27.533, -29.243, 26.999, -29.876, 27.749, -30.645, 28.107, -30.546, 28.291, -30.226, 28.848, -30.070, 29.018, -29.744, 29.325, -29.257, 28.978, -28.956},
+	}},
+	{name: "South Korea", rings: [][]float32{
+		{126.175, 37.750, 126.237, 37.840, 126.684, 37.805, 127.073, 38.256, 127.780, 38.305, 128.206, 38.370, 128.350, 38.612, 129.213, 37.432, 129.460, 36.784, 129.468, 35.632, 129.091, 35.082, 128.186, 34.890, 127.387, 34.476, 126.486, 34.390, 126.374, 34.935, 126.559, 35.685, 126.117, 36.725, 126.860, 36.894, 126.175, 37.750},
+	}},
+	{name: "Spain", rings: [][]float32{
+		{-7.454, 37.098, -7.537, 37.429, -7.167, 37.804, -7.029, 38.076, -7.374, 38.373, -7.098, 39.030, -7.499, 39.630, -7.067, 39.712, -7.026, 40.185, -6.864, 40.331, -6.851, 41.111, -6.389, 41.382, -6.669, 41.883, -7.251, 41.918, -7.423, 41.792, -8.013, 41.791, -8.264, 42.280, -8.672, 42.135, -9.035, 41.881, -8.984, 42.593, -9.393, 43.027, -7.978, 43.748, -6.754, 43.568, -5.412, 43.574, -4.348, 43.403, -3.518, 43.456, -1.901, 43.423, -1.503, 43.034, 0.338, 42.580, 0.702, 42.796, 1.827, 42.343, 2.986, 42.473, 3.039, 41.892, 2.092, 41.226, 0.811, 41.015, 0.721, 40.678, 0.107, 40.124, -0.279, 39.310, 0.111, 38.739, -0.467, 38.292, -0.683, 37.642, -1.438, 37.443, -2.146, 36.674, -3.416, 36.659, -4.369, 36.678, -4.995, 36.325, -5.377, 35.947, -5.866, 36.030, -6.237, 36.368, -6.520, 36.943, -7.454, 37.098},
+	}},
+	{name: "Sri Lanka", rings: [][]float32{
+		{81.788, 7.523, 81.637, 6.482, 81.218, 6.197, 80.348, 5.968, 79.872, 6.763, 79.695, 8.201, 80.148, 9.824, 80.839, 9.268, 81.304, 8.564, 81.788, 7.523},
+	}},
+	{name: "Sudan", rings: [][]float32{
+		{24.567, 8.229, 23.806, 8.666, 23.459, 8.954, 23.395, 9.265, 23.557, 9.681, 23.554, 10.089, 22.978, 10.714, 22.864, 11.142, 22.876, 11.385, 22.509, 11.679, 22.498, 12.260, 22.288, 12.646, 21.937, 12.588, 22.038, 12.955, 22.297, 13.372, 22.183, 13.786, 22.512, 14.093, 22.304, 14.327, 22.568, 14.944, 23.025, 15.681, 23.887, 15.611, 23.838, 19.580, 23.850, 20.000, 25.000, 20.003, 25.000, 22.000, 29.020, 22.000, 32.900, 22.000, 36.866, 22.000, 37.189, 21.019, 36.969, 20.837, 37.115, 19.808, 37.482, 18.614, 37.863, 18.368, 38.410, 17.998, 37.904, 17.428, 37.167, 17.263, 36.853, 16.957, 36.754, 16.292, 36.323, 14.822, 36.430, 14.422, 36.270, 13.563, 35.864, 12.578, 35.260, 12.083, 34.832, 11.319, 34.731, 10.910, 34.257, 10.630, 33.962, 9.584, 33.975, 8.685, 33.963, 9.464, 33.825, 9.484, 33.842, 9.982, 33.722, 10.325, 33.207, 10.720, 33.087, 11.441, 33.207, 12.179, 32.743, 12.248, 32.675, 12.025, 32.074, 11.973, 32.314, 11.681, 32.400, 11.081, 31.851, 10.531, 31.353, 9.810, 30.838, 9.707, 29.997, 10.291, 29.619, 10.085, 29.516, 9.793, 29.001, 9.604, 28.967, 9.398, 27.971, 9.398, 27.834, 9.604, 27.113, 9.639, 26.752, 9.467, 26.477, 9.553, 25.962, 10.136, 25.791, 10.411, 25.070, 10.274, 24.795, 9.810, 24.537, 8.918, 24.194, 8.729, 23.887, 8.620, 24.567, 8.229},
+	}},
+	{name: "Suriname", rings: [][]float32{
+		{-54.525, 2.312, -55.098, 2.524, -55.570, 2.422, -55.973, 2.510, -56.073, 2.221, -55.906, 2.022, -55.996, 1.818, -56.539, 1.900, -57.150, 2.769, -57.281, 3.333, -57.602, 3.335, -58.045, 4.061, -57.860, 4.577, -57.914, 4.813, -57.307, 5.074, -57.147, 5.973, -55.949, 5.773, -55.842, 5.953, -55.033, 6.025, -53.958, 5.757, -54.479, 4.897, -54.400, 4.213, -54.007, 3.620, -54.182, 3.190, -54.270, 2.732, -54.525, 2.312},
+	}},
+	{name: "Sweden", rings: [][]float32{
+		{11.027, 58.856, 11.468, 59.432, 12.300, 60.118, 12.631, 61.294, 11.992, 61.800, 11.931, 63.128, 12.580, 64.066, 13.572, 64.049, 13.920, 64.445, 13.556, 64.787, 15.108, 66.194, 16.109, 67.302, 16.769, 68.014, 17.729, 68.011, 17.994, 68.567, 19.879, 68.407, 20.025, 69.065, 20.646, 69.106, 21.979, 68.617, 23.539, 67.936, 23.566, 66.396, 23.903, 66.007, 22.183, 65.724, 21.214, 65.026, 21.370, 64.414, 19.779, 63.610, 17.848, 62.749, 17.120, 61.341, 17.831, 60.637, 18.788, 60.082, 17.869, 58.954, 16.829, 58.720, 16.448, 57.041, 15.880, 56.104, 14.667, 56.201, 14.101, 55.408, 12.943, 55.362, 12.625, 56.307, 11.788, 57.442, 11.027, 58.856},
+	}},
+	{name: "Switzerland", rings: [][]float32{
+		{9.594, 47.525, 9.633, 47.348, 9.480, 47.103, 9.932, 46.921, 10.443, 46.894, 10.363, 46.484, 9.923, 46.315, 9.183, 46.440, 8.966, 46.037, 8.490, 46.005, 8.317, 46.164, 7.756, 45.824, 7.274, 45.777, 6.844, 45.991, 6.500, 46.430, 6.023, 46.273, 6.037, 46.726, 6.769, 47.288, 6.737, 47.542, 7.192, 47.450, 7.467, 47.621, 8.317, 47.614, 8.523, 47.831, 9.594, 47.525},
+	}},
+	{name: "Syria", rings: [][]float32{
+		{35.720, 32.709, 35.701, 32.716, 35.836, 32.868, 35.821, 33.277, 36.066, 33.825, 36.612, 34.202, 36.448, 34.594, 35.998, 34.645, 35.905, 35.410, 36.150, 35.822, 36.418, 36.041, 36.685, 36.260, 36.739, 36.818, 37.067, 36.623, 38.168, 36.901, 38.700, 36.713, 39.523, 36.716, 40.673, 37.091, 41.212, 37.074, 42.350, 37.230, 41.837, 36.606, 41.290, 36.359, 41.384, 35.628, 41.006, 34.419, 38.792, 33.379, 36.834, 32.313, 35.720, 32.709},
+	}},
+	{name: "Taiwan", rings: [][]float32{
+		{121.778, 24.394, 121.176, 22.791, 120.747, 21.971, 120.220, 22.815, 120.106, 23.556, 120.695, 24.538, 121.495, 25.295, 121.951, 24.998, 121.778, 24.394},
+	}},
+	{name: "Tajikistan", rings: [][]float32{
+		{67.830, 37.145, 68.392, 38.157, 68.176, 38.902, 67.442, 39.140, 67.701, 39.580, 68.536, 39.533, 69.012, 40.086, 69.329, 40.728, 70.667, 40.960, 70.458, 40.496, 70.601, 40.219, 71.014, 40.244, 70.648, 39.936, 69.560, 40.103, 69.465, 39.527, 70.549, 39.604, 71.785, 39.279, 73.675, 39.431, 73.929, 38.506, 74.258, 38.607, 74.865, 38.379, 74.830, 37.990, 74.980, 37.420, 73.949, 37.422, 73.260, 37.495, 72.637, 37.048, 72.193, 36.948, 71.845, 36.738, 71.449, 37.066, 71.542, 37.906, 71.239, 37.953, 71.348, 38.259, 70.807, 38.486, 70.376, 38.138, 70.271, 37.735, 70.117, 37.588, 69.519, 37.609, 69.196, 37.151, 68.859, 37.344, 68.136, 37.023, 67.830, 37.145},
+	}},
+	{name: "Tanzania", rings: [][]float32{
+		{33.904, -0.950, 34.073, -1.060, 37.699, -3.097, 37.767, -3.677, 39.202, -4.677, 38.741, -5.909, 38.800, -6.476, 39.440, -6.840, 39.470, -7.100, 39.195, -7.704, 39.252, -8.008, 39.187, -8.486, 39.536, -9.112, 39.950, -10.098, 40.317, -10.317, 40.317, -10.317, 39.521, -10.897, 38.428, -11.285, 37.828, -11.269, 37.471, -11.569, 36.775, -11.595, 36.514, -11.721, 35.312, -11.439, 34.560, -11.520, 34.280, -10.160, 33.941, -9.694, 33.740, -9.417, 32.759, -9.231, 32.192, -8.930, 31.556, -8.762, 31.158, -8.595, 30.740, -8.340, 30.740, -8.340, 30.200, -7.080, 29.620, -6.520, 29.420, -5.940, 29.520, -5.420, 29.340, -4.500, 29.754, -4.452, 30.116, -4.090, 30.506, -3.569, 30.752, -3.359, 30.743, -3.034, 30.528, -2.808, 30.470, -2.414, 30.470, -2.414, 30.758, -2.287, 30.816, -1.699, 30.419, -1.135, 30.770, -1.015, 31.866, -1.027, 33.904, -0.950},
+	}},
+	{name: "Thailand", rings: [][]float32{
+		{105.219, 14.273, 104.281, 14.417, 102.988, 14.226, 102.348, 13.394, 102.585, 12.187, 101.687, 12.646, 100.832, 12.627, 100.978, 13.413, 100.098, 13.407, 100.019, 12.307, 99.479, 10.846, 99.154, 9.963, 99.222, 9.239, 99.874, 9.208, 100.280, 8.295, 100.459, 7.430, 101.017, 6.857, 101.623, 6.741, 102.141, 6.222, 101.814, 5.811, 101.154, 5.691, 101.076, 6.205, 100.260, 6.643, 100.086, 6.464, 99.691, 6.848, 99.520, 7.343, 98.988, 7.908, 98.504, 8.382, 98.340, 7.795, 98.150, 8.350, 98.259, 8.974, 98.554, 9.933, 99.038, 10.961, 99.587, 11.893, 99.196, 12.805, 99.212, 13.269, 99.098, 13.828, 98.431, 14.622, 98.192, 15.124, 98.537, 15.308, 98.903, 16.178, 98.494, 16.838, 97.859, 17.568, 97.376, 18.445, 97.798, 18.627, 98.254, 19.708, 98.960, 19.753, 99.543, 20.187, 100.116, 20.418, 100.549, 20.109, 100.606, 19.508, 101.282, 19.463, 101.036, 18.409, 101.060, 17.512, 102.114, 18.109, 102.413, 17.933, 102.999, 17.962, 103.200, 18.310, 103.956, 18.241, 104.717, 17.429, 104.779, 16.442, 105.589, 15.570, 105.544, 14.724, 105.219, 14.273},
+	}},
+	{name: "Timor-Leste", rings: [][]float32{
+		{124.969, -8.893, 125.086, -8.657, 125.947, -8.432, 126.645, -8.398, 126.957, -8.273, 127.336, -8.397, 126.968, -8.668, 125.926, -9.106, 125.089, -9.393, 125.070, -9.090, 124.969, -8.893},
+	}},
+	{name: "Togo", rings: [][]float32{
+		{0.900, 10.997, 0.772, 10.471, 1.078, 10.176, 1.425, 9.825, 1.463, 9.335, 1.664, 9.129, 1.619, 6.832, 1.865, 6.142, 1.060, 5.929, 0.837, 6.280, 0.570, 6.914, 0.491, 7.412, 0.712, 8.312, 0.461, 8.677, 0.366, 9.465, 0.368, 10.191, -0.050, 10.707, 0.024, 11.019, 0.900, 10.997},
+	}},
+	{name: "Trinidad and Tobago", rings: [][]float32{
+		{-61.680, 10.760, -61.105, 10.890, -60.895, 10.855, -60.935, 10.110, -61.770, 10.000, -61.950, 10.090, -61.660, 10.365, -61.680, 10.760},
+	}},
+	{name: "Tunisia", rings: [][]float32{
+		{9.482, 30.308, 9.056, 32.103, 8.439, 32.506, 8.430, 32.748, 7.613, 33.344, 7.524, 34.097, 8.141, 34.655, 8.376, 35.480, 8.218, 36.433, 8.421, 36.946, 9.510, 37.350, 10.210, 37.230, 10.181, 36.724, 11.029, 37.092, 11.100, 36.900, 10.600, 36.410, 10.593, 35.947, 10.940, 35.699, 10.808, 34.834, 10.150, 34.331, 10.340, 33.786, 10.857, 33.769, 11.109, 33.293, 11.489, 33.137, 11.432, 32.369, 10.945, 32.082, 10.637, 31.761, 9.950, 31.376, 10.057, 30.962, 9.970, 30.539, 9.482, 30.308},
+	}},
+	{name: "Turkey", rings: [][]float32{
+		{44.773, 37.170, 44.293, 37.002, 43.942, 37.256, 42.779, 37.385, 42.350, 37.230, 41.212, 37.074, 40.673, 37.091, 39.523, 36.716, 38.700, 36.713, 38.168, 36.901, 37.067, 36.623, 36.739, 36.818, 36.685, 36.260, 36.418, 36.041, 36.150, 35.822, 35.782, 36.275, 36.161, 36.651, 35.551, 36.565, 34.715, 36.796, 34.027, 36.220, 32.509, 36.108, 31.700, 36.644, 30.622, 36.678, 30.391, 36.263, 29.700, 36.144, 28.733, 36.677, 27.641, 36.659, 27.049, 37.653, 26.318, 38.208, 26.805, 38.986, 26.171, 39.464, 27.280, 40.420, 28.820, 40.460, 29.240, 41.220, 31.146, 41.088, 32.348, 41.736, 33.513, 42.019, 35.168, 42.040, 36.913, 41.335, 38.348, 40.949, 39.513, 41.103, 40.373, 41.014, 41.554, 41.536, 42.620, 41.583, 43.583, 41.092, 43.753, 40.740, 43.656, 40.254, 44.400, 40.005, 44.794, 39.713, 44.109, 39.428, 44.421, 38.281, 44.226, 37.972, 44.773, 37.170, 44.773, 37.170},
+		{26.117, 41.827, 27.136, 42.141, 27.997, 42.007, 28.116, 41.623, 28.988, 41.300, 28.806, 41.055, 27.619, 41.000, 27.192, 40.691, 26.358, 40.152, 26.043, 40.618, 26.057, 40.824, 26.295, 40.936, 26.604, 41.562, 26.117, 41.827},
+	}},
+	{name: "Turkmenistan", rings: [][]float32{
+		{52.502, 41.783, 52.944, 42.116, 54.079, 42.324, 54.755, 42.044, 55.455, 41.260, 55.968, 41.309, 57.096, 41.322, 56.932, 41.826, 57.787, 42.171, 58.629, 42.752, 59.976, 42.223, 60.083, 41.425, 60.466, 41.220, 61.547, 41.266, 61.883, 41.085, 62.374, 40.054, 63.518, 39.363, 64.170, 38.892, 65.216, 38.403, 66.546, 37.975, 66.519, 37.363, 66.217, 37.394, 65.746, 37.661, 65.589, 37.305, 64.746, 37.112, 64.546, 36.312, 63.983, 36.008, 63.194, 35.857, 62.985, 35.404, 62.231, 35.271, 61.211, 35.650, 61.123, 36.492, 60.378, 36.527, 59.235, 37.413, 58.436, 37.522, 57.330, 38.029, 56.619, 38.121, 56.180, 37.935, 55.512, 37.964, 54.800, 37.392, 53.922, 37.199, 53.736, 37.906, 53.881, 38.952, 53.101, 39.291, 53.358, 39.975, 52.694, 40.034, 52.915, 40.877, 53.858, 40.631, 54.737, 40.951, 54.008, 41.551, 53.722, 42.123, 52.917, 41.868, 52.815, 41.135, 52.502, 41.783},
+	}},
+	{name: "Uganda", rings: [][]float32{
+		{33.904, -0.950, 31.866, -1.027, 30.770, -1.015, 30.419, -1.135, 29.822, -1.443, 29.579, -1.341, 29.588, -0.587, 29.820, -0.205, 29.876, 0.597, 30.086, 1.062, 30.469, 1.584, 30.853, 1.849, 31.174, 2.204, 30.773, 2.340, 30.834, 3.509, 30.834, 3.509, 31.246, 3.782, 31.881, 3.558, 32.686, 3.792, 33.390, 3.790, 34.005, 4.250, 34.479, 3.556, 34.596, 3.054, 35.036, 1.906, 34.672, 1.177, 34.180, 0.515, 33.894, 0.110, 33.904, -0.950},
+	}},
+	{name: "Ukraine", rings: [][]float32{
+		{31.786, 52.102, 32.159, 52.061, 32.412, 52.289, 32.716, 52.238, 33.753, 52.335, 34.392, 51.769, 34.142, 51.566, 34.225, 51.256, 35.022, 51.208, 35.378, 50.774, 35.356, 50.577, 36.626, 50.226, 37.393, 50.384, 38.011, 49.916, 38.595, 49.926, 40.069, 49.601, 40.081, 49.307, 39.675, 48.784, 39.896, 48.232, 39.738, 47.899, 38.771, 47.826, 38.255, 47.546, 38.224, 47.102, 37.425, 47.022, 36.760, 46.699, 35.824, 46.646, 34.962, 46.273, 35.013, 45.738, 34.862, 45.768, 34.732, 45.966, 34.410, 46.005, 33.699, 46.220, 33.436, 45.972, 33.299, 46.081, 31.744, 46.333, 31.675, 46.706, 30.749, 46.583, 30.378, 46.032, 29.603, 45.293, 29.150, 45.465, 28.680, 45.304, 28.234, 45.488, 28.485, 45.597, 28.660, 45.940, 28.934, 46.259, 28.863, 46.438, 29.072, 46.518, 29.171, 46.379, 29.760, 46.350, 30.025, 46.424, 29.838, 46.525, 29.909, 46.674, 29.560, 46.929, 29.415, 47.347, 29.051, 47.510, 29.123, 47.849, 28.671, 48.118, 28.260, 48.156, 27.523, 48.467, 26.858, 48.368, 26.619, 48.221, 26.197, 48.221, 25.946, 47.987, 25.208, 47.891, 24.866, 47.738, 24.402, 47.982, 23.761, 47.986, 23.142, 48.096, 22.711, 47.882, 22.641, 48.150, 22.086, 48.422, 22.281, 48.825, 22.558, 49.086, 22.776, 49.027, 22.518, 49.477, 23.427, 50.309, 23.923, 50.425, 24.030, 50.705, 23.527, 51.578, 24.005, 51.617, 24.553, 51.888, 25.328, 51.911, 26.338, 51.832, 27.454, 51.592, 28.242, 51.572, 28.618, 51.428, 28.993, 51.602, 29.255, 51.368, 30.157, 51.416, 30.555, 51.320, 30.619, 51.823, 30.928, 52.042, 31.786, 52.102},
+	}},
+	{name: "United Arab Emirates", rings: [][]float32{
+		{51.580, 24.245, 51.757, 24.294, 51.794, 24.020, 52.577, 24.177, 53.404, 24.151, 54.008, 24.122, 54.693, 24.798, 55.439, 25.439, 56.071, 26.055, 56.261, 25.715, 56.397, 24.925, 55.886, 24.921, 55.804, 24.270, 55.981, 24.131, 55.529, 23.934, 55.526, 23.525, 55.234, 23.111, 55.208, 22.708, 55.007, 22.497, 52.001, 23.001, 51.618, 24.014, 51.580, 24.245},
+	}},
+	{name: "United Kingdom", rings: [][]float32{
+		{-6.198, 53.868, -6.954, 54.074, -7.572, 54.060, -7.366, 54.596, -7.572, 55.132, -6.734, 55.173, -5.662, 54.555, -6.198, 53.868},
+		{-3.094, 53.405, -3.092, 53.404, -2.945, 53.985, -3.615, 54.601, -3.630, 54.615, -4.844, 54.791, -5.083, 55.062, -4.719, 55.508, -5.048, 55.784, -5.586, 55.311, -5.645, 56.275, -6.150, 56.785, -5.787, 57.819, -5.010, 58.630, -4.211, 58.551, -3.005, 58.635, -4.074, 57.553, -3.055, 57.690, -1.959, 57.685, -2.220, 56.870, -3.119, 55.974, -2.085, 55.910, -2.006, 55.805, -1.115, 54.625, -0.430, 54.464, 0.185, 53.325, 0.470, 52.930, 1.682, 52.740, 1.560, 52.100, 1.051, 51.807, 1.450, 51.289, 0.550, 50.766, -0.788, 50.775, -2.490, 50.500, -2.956, 50.697, -3.617, 50.228, -4.543, 50.342, -5.245, 49.960, -5.777, 50.160, -4.310, 51.210, -3.415, 51.426, -3.423, 51.427, -4.984, 51.593, -5.267, 51.991, -4.222, 52.301, -4.770, 52.840, -4.580, 53.495, -3.094, 53.405},
+	}},
+	{name: "United States of America", rings: [][]float32{
+		{-122.840, 49.000, -120.000, 49.000, -117.031, 49.000, -116.048, 49.000, -113.000, 49.000, -110.050, 49.000, -107.050, 49.000, -104.048, 49.000, -100.650, 49.000, -97.229, 49.001, -95.159, 49.000, -95.156, 49.384, -94.818, 49.389, -94.640, 48.840, -94.329, 48.671, -93.631, 48.609, -92.610, 48.450, -91.640, 48.140, -90.830, 48.270, -89.600, 48.010, -89.273, 48.020, -88.378, 48.303, -87.440, 47.940, -86.462, 47.553, -85.652, 47.220, -84.876, 46.900, -84.779, 46.637, -84.544, 46.539, -84.605, 46.440, -84.337, 46.409, -84.142, 46.512, -84.092, 46.275, -83.891, 46.117, -83.616, 46.117, -83.470, 45.995, -83.593, 45.817, -82.551, 45.348, -82.338, 44.440, -82.138, 43.571, -82.430, 42.980, -82.900, 42.430, -83.120, 42.080, -83.142, 41.976, -83.030, 41.833, -82.690, 41.675, -82.439, 41.675, -81.278, 42.209, -80.247, 42.366, -78.939, 42.864, -78.920, 42.965, -79.010, 43.270, -79.172, 43.466, -78.720, 43.625, -77.738, 43.629, -76.820, 43.629, -76.500, 44.018, -76.375, 44.096, -75.318, 44.816, -74.867, 45.000, -73.348, 45.007, -71.505, 45.008, -71.405, 45.255, -71.085, 45.305, -70.660, 45.460, -70.305, 45.915, -70.000, 46.693, -69.237, 47.448, -68.905, 47.185, -68.234, 47.355, -67.790, 47.066, -67.791, 45.703, -67.137, 45.138, -66.965, 44.810, -68.033, 44.325, -69.060, 43.980, -70.116, 43.684, -70.645, 43.090, -70.815, 42.865, -70.825, 42.335, -70.495, 41.805, -70.080, 41.780, -70.185, 42.145, -69.885, 41.923, -69.965, 41.637, -70.640, 41.475, -71.120, 41.494, -71.860, 41.320, -72.295, 41.270, -72.876, 41.221, -73.710, 40.931, -72.241, 41.119, -71.945, 40.930, -73.345, 40.630, -73.982, 40.628, -73.952, 40.751, -74.257, 40.474, -73.962, 40.428, -74.178, 39.709, -74.906, 38.940, -74.980, 39.196, -75.200, 39.248, -75.528, 39.498, -75.320, 38.960, -75.072, 38.782, -75.057, 38.404, -75.377, 38.016, -75.940, 37.217, -76.031, 37.257, -75.722, 37.937, -76.233, 38.319, -76.350, 39.150, -76.543, 38.718, -76.329, 38.083, -76.990, 38.240, -76.302, 37.918, -76.259, 36.966, -75.972, 36.897, -75.868, 36.551, -75.727, 35.551, -76.363, 34.809, -77.398, 34.512, -78.055, 33.925, -78.554, 33.861, -79.061, 33.494, -79.204, 33.158, -80.301, 32.509, -80.865, 32.033, -81.336, 31.440, -81.490, 30.730, -81.314, 30.036, -80.980, 29.180, -80.536, 28.472, -80.530, 28.040, -80.057, 26.880, -80.088, 26.206, -80.132, 25.817, -80.381, 25.206, -80.680, 25.080, -81.172, 25.201, -81.330, 25.640, -81.710, 25.870, -82.240, 26.730, -82.705, 27.495, -82.855, 27.886, -82.650, 28.550, -82.930, 29.100, -83.710, 29.937, -84.100, 30.090, -85.109, 29.636, -85.288, 29.686, -85.773, 30.153, -86.400, 30.400, -87.530, 30.274, -88.418, 30.385, -89.180, 30.316, -89.594, 30.160, -89.414, 29.894, -89.430, 29.489, -89.218, 29.291, -89.408, 29.160, -89.779, 29.307, -90.155, 29.117, -90.880, 29.149, -91.627, 29.677, -92.499, 29.552, -93.226, 29.784, -93.848, 29.714, -94.690, 29.480, -95.600, 28.739, -96.594, 28.307, -97.140, 27.830, -97.370, 27.380, -97.380, 26.690, -97.330, 26.210, -97.140, 25.870, -97.530, 25.840, -98.240, 26.060, -99.020, 26.370, -99.300, 26.840, -99.520, 27.540, -100.110, 28.110, -100.456, 28.696, -100.958, 29.381, -101.662, 29.779, -102.480, 29.760, -103.110, 28.970, -103.940, 29.270, -104.457, 29.572, -104.706, 30.122, -105.037, 30.644, -105.632, 31.084, -106.143, 31.400, -106.508, 31.755, -108.240, 31.755, -108.242, 31.342, -109.035, 31.342, -111.024, 31.335, -113.305, 32.039, -114.815, 32.525, -114.721, 32.721, -115.991, 32.612, -117.128, 32.535, -117.296, 33.046, -117.944, 33.621, -118.411, 33.741, -118.520, 34.028, -119.081, 34.078, -119.439, 34.348, -120.368, 34.447, -120.623, 34.609, -120.744, 35.157, -121.715, 36.162, -122.547, 37.552, -122.512, 37.783, -122.953, 38.114, -123.727, 38.952, -123.865, 39.767, -124.398, 40.313, -124.179, 41.142, -124.214, 42.000, -124.533, 42.766, -124.142, 43.708, -124.021, 44.616, -123.899, 45.523, -124.080, 46.865, -124.396, 47.720, -124.687, 48.184, -124.566, 48.380, -123.120, 48.040, -122.587, 47.096, -122.340, 47.360, -122.500, 48.180, -122.840, 49.000},
+		{-155.402, 20.080, -155.225, 19.993, -155.062, 19.859, -154.807, 19.509, -154.831, 19.453, -155.222, 19.240, -155.542, 19.083, -155.688, 18.916, -155.937, 19.059, -155.908, 19.339, -156.073, 19.703, -156.024, 19.814, -155.850, 19.977, -155.919, 20.174, -155.861, 20.267, -155.785, 20.249, -155.402, 20.080},
+		{-155.996, 20.764, -156.079, 20.644, -156.414, 20.572, -156.587, 20.783, -156.702, 20.864, -156.711, 20.927, -156.613, 21.012, -156.257, 20.917, -155.996, 20.764},
+		{-156.758, 21.177, -156.789, 21.069, -157.325, 21.098, -157.250, 21.220, -156.758, 21.177},
+		{-158.025, 21.717, -157.942, 21.653, -157.653, 21.322, -157.707, 21.264, -157.779, 21.277, -158.127, 21.312, -158.254, 21.539, -158.293, 21.579, -158.025, 21.717},
+		{-159.366, 22.215, -159.345, 21.982, -159.464, 21.883, -159.801, 22.065, -159.749, 22.138, -159.596, 22.236, -159.366, 22.215},
+		{-166.468, 60.384, -165.674, 60.294, -165.579, 59.910, -166.193, 59.754, -166.848, 59.941, -167.455, 60.213, -166.468, 60.384},
+		{-153.229, 57.969, -152.565, 57.901, -152.141, 57.591, -153.006, 57.116, -154.005, 56.735, -154.516, 56.993, -154.671, 57.461, -153.763, 57.817, -153.229, 57.969},
+		{-140.986, 69.712, -140.986, 69.712, -140.993, 66.000, -140.998, 60.306, -140.013, 60.277, -139.039, 60.000, -138.341, 59.562, -137.452, 58.905, -136.480, 59.464, -135.476, 59.788, -134.945, 59.271, -134.271, 58.861, -133.356, 58.410, -132.730, 57.693, -131.708, 56.552, -130.008, 55.916, -129.980, 55.285, -130.536, 54.803, -130.536, 54.803, -130.536, 54.803, -131.086, 55.179, -131.967, 55.498, -132.250, 56.370, -133.539, 57.179, -134.078, 58.123, -135.038, 58.188, -136.628, 58.212, -137.800, 58.500, -139.868, 59.538, -140.825, 59.728, -142.574, 60.084, -143.959, 59.999, -145.926, 60.459, -147.114, 60.885, -148.224, 60.673, -148.018, 59.978, -148.571, 59.914, -149.728, 59.706, -150.608, 59.368, -151.716, 59.156, -151.859, 59.745, -151.410, 60.726, -150.347, 61.034, -150.621, 61.284, -151.896, 60.727, -152.578, 60.062, -154.019, 59.350, -153.288, 58.865, -154.232, 58.146, -155.307, 57.728, -156.308, 57.423, -156.556, 56.980, -158.117, 56.464, -158.433, 55.994, -159.603, 55.567, -160.290, 55.644, -161.223, 55.365, -162.238, 55.024, -163.069, 54.690, -164.786, 54.404, -164.942, 54.572, -163.848, 55.039, -162.870, 55.348, -161.804, 55.895, -160.564, 56.008, -160.071, 56.418, -158.684, 57.017, -158.461, 57.217, -157.723, 57.570, -157.550, 58.328, -157.042, 58.919, -158.195, 58.616, -158.517, 58.788, -159.059, 58.424, -159.712, 58.931, -159.981, 58.573, -160.355, 59.071, -161.355, 58.671, -161.969, 58.672, -162.055, 59.267, -161.874, 59.634, -162.518, 59.990, -163.818, 59.798, -164.662, 60.267, -165.346, 60.507, -165.351, 61.074, -166.121, 61.500, -165.734, 62.075, -164.919, 62.633, -164.563, 63.146, -163.753, 63.219, -163.067, 63.059, -162.261, 63.542, -161.534, 63.456, -160.773, 63.766, -160.958, 64.223, -161.518, 64.403, -160.778, 64.789, -161.392, 64.777, -162.453, 64.559, -162.758, 64.339, -163.546, 64.559, -164.961, 64.447, -166.425, 64.687, -166.845, 65.089, -168.111, 65.670, -166.705, 66.088, -164.475, 66.577, -163.653, 66.577, -163.789, 66.077, -161.678, 66.116, -162.490, 66.736, -163.720, 67.116, -164.431, 67.616, -165.390, 68.043, -166.764, 68.359, -166.205, 68.883, -164.431, 68.916, -163.169, 69.371, -162.931, 69.858, -161.909, 70.333, -160.935, 70.448, -159.039, 70.892, -158.120, 70.825, -156.581, 71.358, -155.068, 71.148, -154.344, 70.696, -153.900, 70.890, -152.210, 70.830, -152.270, 70.600, -150.740, 70.430, -149.720, 70.530, -147.613, 70.214, -145.690, 70.120, -144.920, 69.990, -143.589, 70.153, -142.073, 69.852, -140.986, 69.712, -140.986, 69.712},
+		{-171.732, 63.783, -171.114, 63.592, -170.491, 63.695, -169.683, 63.431, -168.689, 63.298, -168.772, 63.189, -169.529, 62.977, -170.291, 63.194, -170.671, 63.376, -171.553, 63.318, -171.791, 63.406, -171.732, 63.783},
+	}},
+	{name: "Uruguay", rings: [][]float32{
+		{-57.625, -30.216, -56.976, -30.110, -55.973, -30.883, -55.602, -30.854, -54.572, -31.495, -53.788, -32.047, -53.210, -32.728, -53.651, -33.202, -53.374, -33.768, -53.806, -34.397, -54.936, -34.953, -55.674, -34.753, -56.215, -34.860, -57.140, -34.430, -57.818, -34.463, -58.427, -33.909, -58.350, -33.263, -58.133, -33.041, -58.142, -32.045, -57.875, -31.017, -57.625, -30.216},
+	}},
+	{name: "Uzbekistan", rings: [][]float32{
+		{55.968, 41.309, 55.929, 44.996, 58.503, 45.587, 58.690, 45.500, 60.240, 44.784, 61.058, 44.406, 62.013, 43.504, 63.186, 43.650, 64.901, 43.728, 66.098, 42.998, 66.023, 41.995, 66.511, 41.988, 66.714, 41.168, 67.986, 41.136, 68.260, 40.662, 68.632, 40.669, 69.070, 41.384, 70.389, 42.081, 70.962, 42.266, 71.259, 42.168, 70.420, 41.520, 71.158, 41.144, 71.870, 41.393, 73.055, 40.866, 71.775, 40.146, 71.014, 40.244, 70.601, 40.219, 70.458, 40.496, 70.667, 40.960, 69.329, 40.728, 69.012, 40.086, 68.536, 39.533, 67.701, 39.580, 67.442, 39.140, 68.176, 38.902, 68.392, 38.157, 67.830, 37.145, 67.076, 37.356, 66.519, 37.363, 66.546, 37.975, 65.216, 38.403, 64.170, 38.892, 63.518, 39.363, 62.374, 40.054, 61.883, 41.085, 61.547, 41.266, 60.466, 41.220, 60.083, 41.425, 59.976, 42.223, 58.629, 42.752, 57.787, 42.171, 56.932, 41.826, 57.096, 41.322, 55.968, 41.309},
+	}},
+	{name: "Vanuatu", rings: [][]float32{
+		{167.217, -15.892, 167.845, -16.466, 167.515, -16.598, 167.180, -16.160, 167.217, -15.892},
+		{166.793, -15.669, 166.650, -15.393, 166.629, -14.626, 167.108, -14.934, 167.270, -15.740, 167.001, -15.615, 166.793, -15.669},
+	}},
+	{name: "Venezuela", rings: [][]float32{
+		{-60.734, 5.200, -60.601, 4.918, -60.967, 4.536, -62.085, 4.162, -62.805, 4.007, -63.093, 3.771, -63.888, 4.021, -64.629, 4.148, -64.816, 4.056, -64.368, 3.797, -64.409, 3.127, -64.270, 2.497, -63.423, 2.411, -63.369, 2.201, -64.083, 1.916, -64.199, 1.493, -64.611, 1.329, -65.355, 1.095, -65.548, 0.789, -66.326, 0.724, -66.876, 1.253, -67.181, 2.251, -67.447, 2.600, -67.810, 2.821, -67.303, 3.318, -67.338, 3.542, -67.622, 3.839, -67.823, 4.504, -67.745, 5.221, -67.522, 5.557, -67.341, 6.095, -67.695, 6.267, -68.265, 6.153, -68.985, 6.207, -69.389, 6.100, -70.093, 6.960, -70.674, 7.088, -71.960, 6.992, -72.198, 7.340, -72.444, 7.424, -72.480, 7.633, -72.361, 8.003, -72.440, 8.405, -72.660, 8.625, -72.789, 9.085, -73.305, 9.152, -73.028, 9.737, -72.905, 10.450, -72.615, 10.822, -72.228, 11.109, -71.974, 11.609, -71.332, 11.776, -71.360, 11.540, -71.947, 11.423, -71.621, 10.969, -71.633, 10.446, -72.074, 9.866, -71.696, 9.072, -71.265, 9.137, -71.040, 9.860, -71.350, 10.212, -71.401, 10.969, -70.155, 11.375, -70.294, 11.847, -69.943, 12.162, -69.584, 11.460, -68.883, 11.443, -68.233, 10.886, -68.194, 10.555, -67.296, 10.546, -66.228, 10.649, -65.655, 10.201, -64.890, 10.077, -64.329, 10.390, -64.318, 10.641, -63.079, 10.702, -61.881, 10.716, -62.730, 10.420, -62.389, 9.948, -61.589, 9.873, -60.831, 9.381, -60.671, 8.580, -60.150, 8.603, -59.758, 8.367, -60.551, 7.780, -60.638, 7.415, -60.296, 7.044, -60.544, 6.857, -61.159, 6.696, -61.139, 6.234, -61.410, 5.959, -60.734, 5.200},
+	}},
+	{name: "Vietnam", rings: [][]float32{
+		{104.334, 10.487, 105.200, 10.889, 106.250, 10.962, 105.811, 11.568, 107.491, 12.337, 107.615, 13.536, 107.383, 14.202, 107.565, 15.202, 107.313, 15.909, 106.556, 16.604, 105.926, 17.485, 105.095, 18.667, 103.897, 19.265, 104.183, 19.625, 104.823, 19.887, 104.435, 20.759, 103.204, 20.767, 102.755, 21.675, 102.170, 22.465, 102.707, 22.709, 103.505, 22.704, 104.477, 22.819, 105.329, 23.352, 105.811, 22.977, 106.725, 22.794, 106.567, 22.218, 107.043, 21.812, 108.050, 21.552, 106.715, 20.697, 105.882, 19.752, 105.662, 19.058, 106.427, 18.004, 107.362, 16.697, 108.269, 16.080, 108.877, 15.277, 109.335, 13.426, 109.200, 11.667, 108.366, 11.008, 107.221, 10.364, 106.405, 9.531, 105.158, 8.600, 104.795, 9.241, 105.076, 9.918, 104.334, 10.487},
+	}},
+	{name: "W. Sahara", rings: [][]float32{
+		{-8.666, 27.656, -8.665, 27.589, -8.684, 27.396, -8.687, 25.881, -11.969, 25.933, -11.937, 23.375, -12.874, 23.285, -13.119, 22.771, -12.929, 21.327, -16.845, 21.333, -17.063, 21.000, -17.020, 21.422, -17.003, 21.421, -14.751, 21.501, -14.631, 21.861, -14.221, 22.310, -13.891, 23.691, -12.501, 24.770, -12.031, 26.031, -11.718, 26.104, -11.393, 26.883, -10.551, 26.991, -10.189, 26.861, -9.735, 26.861, -9.413, 27.088, -8.795, 27.121, -8.818, 27.656, -8.666, 27.656},
+	}},
+	{name: "Yemen", rings: [][]float32{
+		{52.000, 19.000, 52.782, 17.350, 53.109, 16.651, 52.385, 16.382, 52.192, 15.938, 52.168, 15.597, 51.173, 15.175, 49.575, 14.709, 48.679, 14.003, 48.239, 13.948, 47.939, 14.007, 47.354, 13.592, 46.717, 13.400, 45.878, 13.348, 45.625, 13.291, 45.406, 13.027, 45.144, 12.954, 44.990, 12.700, 44.495, 12.722, 44.175, 12.586, 43.483, 12.637, 43.223, 13.221, 43.251, 13.768, 43.088, 14.063, 42.892, 14.802, 42.605, 15.213, 42.805, 15.262, 42.702, 15.719, 42.824, 15.912, 42.779, 16.348, 43.218, 16.667, 43.116, 17.088, 43.381, 17.580, 43.792, 17.320, 44.063, 17.410, 45.217, 17.433, 45.400, 17.333, 46.367, 17.233, 46.750, 17.283, 47.000, 16.950, 47.467, 17.117, 48.183, 18.167, 49.117, 18.617, 52.000, 19.000},
+	}},
+	{name: "Zambia", rings: [][]float32{
+		{30.740, -8.340, 31.158, -8.595, 31.556, -8.762, 32.192, -8.930, 32.759, -9.231, 33.231, -9.677, 33.486, -10.526, 33.315, -10.797, 33.114, -11.607, 33.306, -12.436, 32.992, -12.784, 32.688, -13.713, 33.214, -13.972, 30.179, -14.796, 30.274, -15.508, 29.517, -15.645, 28.947, -16.043, 28.826, -16.390, 28.468, -16.468, 27.598, -17.291, 27.044, -17.938, 26.707, -17.961, 26.382, -17.846, 25.264, -17.737, 25.084, -17.662, 25.077, -17.579, 24.682, -17.353, 24.034, -17.296, 23.215, -17.523, 22.562, -16.898, 21.888, -16.080, 21.934, -12.898, 24.016, -12.911, 23.931, -12.566, 24.080, -12.191, 23.904, -11.722, 24.018, -11.237, 23.912, -10.927, 24.257, -10.952, 24.315, -11.263, 24.783, -11.239, 25.418, -11.331, 25.752, -11.785, 26.553, -11.924, 27.164, -11.609, 27.389, -12.133, 28.155, -12.272, 28.524, -12.699, 28.934, -13.249, 29.700, -13.257, 29.616, -12.179, 29.342, -12.361, 28.642, -11.972, 28.372, -11.794, 28.496, -10.790, 28.674, -9.606, 28.450, -9.165, 28.735, -8.527, 29.003, -8.407, 30.346, -8.238, 30.740, -8.340},
+	}},
+	{name: "Zimbabwe", rings: [][]float32{
+		{31.191, -22.252, 30.660, -22.152, 30.323, -22.272, 29.839, -22.102, 29.432, -22.091, 28.795, -21.639, 28.021, -21.486, 27.727, -20.852, 27.725, -20.499, 27.297, -20.392, 26.165, -19.293, 25.850, -18.714, 25.649, -18.536, 25.264, -17.737, 26.382, -17.846, 26.707, -17.961, 27.044, -17.938, 27.598, -17.291, 28.468, -16.468, 28.826, -16.390, 28.947, -16.043, 29.517, -15.645, 30.274, -15.508, 30.339, -15.881, 31.173, -15.861, 31.636, -16.072, 31.852, -16.319, 32.328, -16.392, 32.848, -16.713, 32.850, -17.979, 32.655, -18.672, 32.612, -19.419, 32.773, -19.716, 32.660, -20.304, 32.509, -20.395, 32.245, -21.116, 31.191, -22.252},
+	}},
+	{name: "eSwatini", rings: [][]float32{
+		{32.072, -26.734, 31.868, -27.178, 31.283, -27.286, 30.686, -26.744, 30.677, -26.398, 30.950, -26.023, 31.044, -25.731, 31.333, -25.660, 31.838, -25.843, 31.986, -26.292, 32.072, -26.734},
+	}},
+}
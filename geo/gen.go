@@ -0,0 +1,160 @@
+//go:build ignore
+// +build ignore
+
+// gen generates countries_data.go and cities_data.go from Natural Earth GeoJSON files
+// (public domain, https://www.naturalearthdata.com/), plain or gzipped:
+//
+//	go run gen.go -countries ne_110m_admin_0_countries.geojson -cities ne_10m_urban_areas_landscan.geojson
+//
+// The inputs are not in the repository, the cities are too big. The data is generated from Natural Earth v5.1.2:
+//
+//	https://raw.githubusercontent.com/nvkelso/natural-earth-vector/v5.1.2/geojson/ne_110m_admin_0_countries.geojson
+//	  SHA-256 6be60adda1f036a32302d9213a3c9c1137abf866266ae5dbb94b5c63dfed704f
+//	https://raw.githubusercontent.com/nvkelso/natural-earth-vector/v5.1.2/geojson/ne_10m_urban_areas_landscan.geojson
+//	  SHA-256 2720f2cb7a74add877f13c312c22fff0996f39110591b37950e47b30222693ad
+//
+// go generate in this directory expects them gzipped next to gen.go.
+package main
+
+import (
+	"bytes"
+	"compress/gzip"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"go/format"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+type featureCollection struct {
+	Features []struct {
+		Geometry struct {
+			Type        string          `json:"type"`
+			Coordinates json.RawMessage `json:"coordinates"`
+		} `json:"geometry"`
+		Properties map[string]interface{} `json:"properties"`
+	} `json:"features"`
+}
+
+func check(err error) {
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+func read(path string) *featureCollection {
+	f, err := os.Open(path)
+	check(err)
+	defer f.Close()
+	var r io.Reader = f
+	if strings.HasSuffix(path, ".gz") {
+		r, err = gzip.NewReader(f)
+		check(err)
+	}
+	var fc featureCollection
+	check(json.NewDecoder(r).Decode(&fc))
+	return &fc
+}
+
+func write(path string, buf *bytes.Buffer) {
+	src, err := format.Source(buf.Bytes())
+	check(err)
+	check(ioutil.WriteFile(path, src, 0644))
+}
+
+func header(buf *bytes.Buffer, source string) {
+	fmt.Fprintf(buf, "// Code generated by gen.go from Natural Earth %s; DO NOT EDIT.\n", filepath.Base(source))
+	fmt.Fprintln(buf)
+	fmt.Fprintln(buf, "package geo")
+	fmt.Fprintln(buf)
+}
+
+func genCountries(path string) {
+	fc := read(path)
+	buf := new(bytes.Buffer)
+	header(buf, path)
+	fmt.Fprintln(buf, "var countries = []country{")
+	sort.Slice(fc.Features, func(i, j int) bool {
+		return fc.Features[i].Properties["NAME"].(string) < fc.Features[j].Properties["NAME"].(string)
+	})
+	for _, feature := range fc.Features {
+		var polygons [][][][2]float64
+		switch feature.Geometry.Type {
+		case "Polygon":
+			var polygon [][][2]float64
+			check(json.Unmarshal(feature.Geometry.Coordinates, &polygon))
+			polygons = append(polygons, polygon)
+		case "MultiPolygon":
+			check(json.Unmarshal(feature.Geometry.Coordinates, &polygons))
+		default:
+			log.Fatalf("unexpected geometry %s", feature.Geometry.Type)
+		}
+		fmt.Fprintf(buf, "{name: %q, rings: [][]float32{\n", feature.Properties["NAME"])
+		for _, polygon := range polygons {
+			for _, ring := range polygon {
+				fmt.Fprint(buf, "{")
+				for _, point := range ring {
+					fmt.Fprintf(buf, "%.3f, %.3f, ", point[0], point[1])
+				}
+				fmt.Fprintln(buf, "},")
+			}
+		}
+		fmt.Fprintln(buf, "}},")
+	}
+	fmt.Fprintln(buf, "}")
+	write("countries_data.go", buf)
+}
+
+func genCities(path string, minPopulation float64) {
+	fc := read(path)
+	type city struct {
+		name       string
+		lat, lon   float64
+		population float64
+	}
+	var cities []city
+	for _, feature := range fc.Features {
+		p := feature.Properties
+		population, _ := p["max_pop_al"].(float64)
+		if population < minPopulation {
+			continue
+		}
+		// Homonyms are disambiguated with digits like "Albany1", "Albany2".
+		name := strings.TrimRight(p["name_conve"].(string), "0123456789")
+		cities = append(cities, city{name, p["mean_bb_yc"].(float64), p["mean_bb_xc"].(float64), population})
+	}
+	sort.Slice(cities, func(i, j int) bool {
+		if cities[i].name != cities[j].name {
+			return cities[i].name < cities[j].name
+		}
+		return cities[i].lat < cities[j].lat
+	})
+
+	buf := new(bytes.Buffer)
+	header(buf, path)
+	fmt.Fprintln(buf, "var cities = []city{")
+	for _, c := range cities {
+		fmt.Fprintf(buf, "{%q, %.3f, %.3f},\n", c.name, c.lat, c.lon)
+	}
+	fmt.Fprintln(buf, "}")
+	write("cities_data.go", buf)
+}
+
+func main() {
+	countriesPath := flag.String("countries", "", "Natural Earth admin 0 countries GeoJSON")
+	citiesPath := flag.String("cities", "", "Natural Earth urban areas (landscan) GeoJSON")
+	minPopulation := flag.Float64("min-population", 50000, "minimal population of a city")
+	flag.Parse()
+	if *countriesPath != "" {
+		genCountries(*countriesPath)
+	}
+	if *citiesPath != "" {
+		genCities(*citiesPath, *minPopulation)
+	}
+}
@@ -0,0 +1,74 @@
+// Package geo implements the random walk of the daily coordinates on the sphere.
+package geo
+
+import "math"
+
+// Initial is the point the walk starts from.
+var Initial = Point{Latitude: 60.0, Longitude: 30.0}
+
+// Point is a point on the sphere in degrees.
+// Latitude is in [-90, 90], longitude is in (-180, 180].
+type Point struct {
+	Latitude  float64
+	Longitude float64
+}
+
+// Normalize brings latitude into [-90, 90] and longitude into (-180, 180].
+// Latitude beyond a pole means going over the pole, so longitude turns by 180°.
+func Normalize(p Point) Point {
+	lat := math.Mod(p.Latitude, 360)
+	lon := p.Longitude
+	if lat > 180 {
+		lat -= 360
+	} else if lat <= -180 {
+		lat += 360
+	}
+	if lat > 90 {
+		lat = 180 - lat
+		lon += 180
+	} else if lat < -90 {
+		lat = -180 - lat
+		lon += 180
+	}
+
+	lon = math.Mod(lon, 360)
+	if lon > 180 {
+		lon -= 360
+	} else if lon <= -180 {
+		lon += 360
+	}
+	return Point{Latitude: lat, Longitude: lon}
+}
+
+// Step moves p by the displacement which is east degrees of arc eastwards and north degrees of arc
+// northwards (negative values mean westwards and southwards). The move goes along the great circle
+// with initial bearing atan2(east, north) for the distance hypot(east, north), so it crosses poles
+// and the antimeridian naturally.
+func Step(p Point, east, north float64) Point {
+	distance := math.Hypot(east, north) * math.Pi / 180
+	if distance == 0 {
+		return Normalize(p)
+	}
+	bearing := math.Atan2(east, north)
+	lat1 := p.Latitude * math.Pi / 180
+	lon1 := p.Longitude * math.Pi / 180
+
+	sinLat2 := math.Sin(lat1)*math.Cos(distance) + math.Cos(lat1)*math.Sin(distance)*math.Cos(bearing)
+	sinLat2 = math.Max(-1, math.Min(1, sinLat2))
+	lat2 := math.Asin(sinLat2)
+	lon2 := lon1 + math.Atan2(math.Sin(bearing)*math.Sin(distance)*math.Cos(lat1), math.Cos(distance)-math.Sin(lat1)*sinLat2)
+
+	return Normalize(Point{Latitude: lat2 * 180 / math.Pi, Longitude: lon2 * 180 / math.Pi})
+}
+
+// Delta returns the change of coordinates from p to q. The change of longitude is the shortest one,
+// in (-180, 180], so crossing the antimeridian doesn't make it almost 360°.
+func Delta(p, q Point) Point {
+	lon := math.Mod(q.Longitude-p.Longitude, 360)
+	if lon > 180 {
+		lon -= 360
+	} else if lon <= -180 {
+		lon += 360
+	}
+	return Point{Latitude: q.Latitude - p.Latitude, Longitude: lon}
+}
@@ -0,0 +1,77 @@
+package geo
+
+import (
+	"math"
+	"testing"
+)
+
+const epsilon = 1e-9
+
+func equal(p, q Point) bool {
+	return math.Abs(p.Latitude-q.Latitude) < epsilon && math.Abs(p.Longitude-q.Longitude) < epsilon
+}
+
+func TestNormalize(t *testing.T) {
+	tests := []struct {
+		name string
+		p    Point
+		want Point
+	}{
+		{"inside", Point{60, 30}, Point{60, 30}},
+		{"north pole", Point{90, 30}, Point{90, 30}},
+		{"over north pole", Point{91, 10}, Point{89, -170}},
+		{"over south pole", Point{-95, -30}, Point{-85, 150}},
+		{"over both poles", Point{270, 0}, Point{-90, 0}},
+		{"full turn of latitude", Point{370, 20}, Point{10, 20}},
+		{"antimeridian", Point{0, 180}, Point{0, 180}},
+		{"antimeridian from west", Point{0, -180}, Point{0, 180}},
+		{"past 180 eastwards", Point{0, 181}, Point{0, -179}},
+		{"past -180 westwards", Point{0, -181}, Point{0, 179}},
+		{"several turns of longitude", Point{10, 540}, Point{10, 180}},
+	}
+	for _, test := range tests {
+		if got := Normalize(test.p); !equal(got, test.want) {
+			t.Errorf("%s: Normalize(%v) = %v, want %v", test.name, test.p, got, test.want)
+		}
+	}
+}
+
+func TestStep(t *testing.T) {
+	tests := []struct {
+		name        string
+		p           Point
+		east, north float64
+		want        Point
+	}{
+		{"zero step", Point{60, 30}, 0, 0, Point{60, 30}},
+		{"zero step normalizes", Point{0, 200}, 0, 0, Point{0, -160}},
+		{"northwards", Point{0, 0}, 0, 10, Point{10, 0}},
+		{"southwards", Point{0, 0}, 0, -10, Point{-10, 0}},
+		{"eastwards along equator", Point{0, 0}, 10, 0, Point{0, 10}},
+		{"over north pole", Point{89, 30}, 0, 2, Point{89, -150}},
+		{"over south pole", Point{-89, 30}, 0, -2, Point{-89, -150}},
+		{"past 180 eastwards", Point{0, 179}, 2, 0, Point{0, -179}},
+		{"past -180 westwards", Point{0, -179}, -2, 0, Point{0, 179}},
+	}
+	for _, test := range tests {
+		if got := Step(test.p, test.east, test.north); !equal(got, test.want) {
+			t.Errorf("%s: Step(%v, %v, %v) = %v, want %v", test.name, test.p, test.east, test.north, got, test.want)
+		}
+	}
+}
+
+func TestDelta(t *testing.T) {
+	tests := []struct {
+		p, q Point
+		want Point
+	}{
+		{Point{60, 30}, Point{60.5, 29.5}, Point{0.5, -0.5}},
+		{Point{0, 179}, Point{0, -179}, Point{0, 2}},
+		{Point{0, -179}, Point{0, 179}, Point{0, -2}},
+	}
+	for _, test := range tests {
+		if got := Delta(test.p, test.q); !equal(got, test.want) {
+			t.Errorf("Delta(%v, %v) = %v, want %v", test.p, test.q, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,192 @@
+package geo
+
+// The inputs are not in the repository, see gen.go for where to download them.
+//go:generate go run gen.go -countries ne_110m_admin_0_countries.geojson.gz -cities ne_10m_urban_areas_landscan.geojson.gz
+
+import (
+	"fmt"
+	"math"
+)
+
+// EarthRadius is the mean radius of the Earth in kilometres.
+const EarthRadius = 6371.0
+
+// country is a country boundary: rings are polygons and holes of flattened lon, lat pairs.
+type country struct {
+	name   string
+	rings  [][]float32
+	minLat float32
+	maxLat float32
+	minLon float32
+	maxLon float32
+}
+
+// city is a populated place of the gazetteer.
+type city struct {
+	name      string
+	latitude  float64
+	longitude float64
+}
+
+// water is a named sea with a coarse bounding box. Seas are checked in order after countries,
+// so boxes may cover land and smaller seas go first.
+type water struct {
+	name           string
+	minLat, maxLat float64
+	minLon, maxLon float64
+}
+
+var seas = []water{
+	{"Caspian Sea", 36, 47.5, 46, 55},
+	{"Black Sea", 40, 47, 27, 42},
+	{"Baltic Sea", 53, 66, 9, 30.5},
+	{"North Sea", 51, 61, -4, 9},
+	{"Mediterranean Sea", 30, 46, -6, 36.5},
+	{"Red Sea", 12, 30, 32, 44},
+	{"Persian Gulf", 23, 30.5, 47, 57},
+	{"Hudson Bay", 51, 66, -95, -76},
+	{"Gulf of Mexico", 18, 31, -98, -80},
+	{"Caribbean Sea", 9, 22, -88, -60},
+	{"Sea of Japan", 33, 52, 127, 142},
+	{"South China Sea", 0, 23, 99, 121},
+}
+
+func init() {
+	for i := range countries {
+		c := &countries[i]
+		c.minLat, c.maxLat, c.minLon, c.maxLon = 90, -90, 180, -180
+		for _, ring := range c.rings {
+			for j := 0; j+1 < len(ring); j += 2 {
+				lon, lat := ring[j], ring[j+1]
+				c.minLon = min32(c.minLon, lon)
+				c.maxLon = max32(c.maxLon, lon)
+				c.minLat = min32(c.minLat, lat)
+				c.maxLat = max32(c.maxLat, lat)
+			}
+		}
+	}
+}
+
+func min32(a, b float32) float32 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max32(a, b float32) float32 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// Place describes where a point is: a country or, on water, a sea or an ocean,
+// and the nearest city of the gazetteer.
+type Place struct {
+	Country    string  `json:"country,omitempty"`
+	Water      string  `json:"water,omitempty"`
+	City       string  `json:"city"`
+	DistanceKm float64 `json:"distanceKm"`
+}
+
+// String returns e.g. "Finland, Helsinki, 12 km" or "Pacific Ocean, Honolulu, 830 km".
+func (p Place) String() string {
+	area := p.Country
+	if area == "" {
+		area = p.Water
+	}
+	return fmt.Sprintf("%s, %s, %.0f km", area, p.City, p.DistanceKm)
+}
+
+// ReverseGeocode finds the country or the water containing p and the nearest city.
+// It uses the embedded Natural Earth 1:110m boundaries and urban areas, so results near coasts
+// and borders are approximate.
+func ReverseGeocode(p Point) Place {
+	p = Normalize(p)
+	var place Place
+	if c, ok := findCountry(p); ok {
+		place.Country = c
+	} else {
+		place.Water = findWater(p)
+	}
+	place.City, place.DistanceKm = nearestCity(p)
+	return place
+}
+
+func findCountry(p Point) (string, bool) {
+	lat, lon := float32(p.Latitude), float32(p.Longitude)
+	for _, c := range countries {
+		if lat < c.minLat || lat > c.maxLat || lon < c.minLon || lon > c.maxLon {
+			continue
+		}
+		inside := false
+		for _, ring := range c.rings {
+			if inRing(ring, lon, lat) {
+				inside = !inside
+			}
+		}
+		if inside {
+			return c.name, true
+		}
+	}
+	return "", false
+}
+
+// inRing reports whether the point lon, lat is inside ring using the even-odd rule.
+func inRing(ring []float32, lon, lat float32) bool {
+	inside := false
+	n := len(ring) / 2
+	for i, j := 0, n-1; i < n; j, i = i, i+1 {
+		xi, yi := ring[2*i], ring[2*i+1]
+		xj, yj := ring[2*j], ring[2*j+1]
+		if (yi > lat) != (yj > lat) && lon < (xj-xi)*(lat-yi)/(yj-yi)+xi {
+			inside = !inside
+		}
+	}
+	return inside
+}
+
+// findWater returns the name of the sea or the ocean at p, which is not in any country.
+func findWater(p Point) string {
+	lat, lon := p.Latitude, p.Longitude
+	for _, s := range seas {
+		if lat >= s.minLat && lat <= s.maxLat && lon >= s.minLon && lon <= s.maxLon {
+			return s.name
+		}
+	}
+	switch {
+	case lat > 66:
+		return "Arctic Ocean"
+	case lat < -60:
+		return "Southern Ocean"
+	case lat <= 30 && lon >= 20 && lon <= 147 && !(lat > -10 && lon > 100):
+		return "Indian Ocean"
+	case lat >= 20 && lon > -100 && lon < 20,
+		lat >= 8 && lat < 20 && lon > -84 && lon < 20,
+		lat < 8 && lon > -70 && lon < 20:
+		return "Atlantic Ocean"
+	}
+	return "Pacific Ocean"
+}
+
+func nearestCity(p Point) (string, float64) {
+	name, best := "", math.Inf(1)
+	for _, c := range cities {
+		d := Distance(p, Point{Latitude: c.latitude, Longitude: c.longitude})
+		if d < best {
+			name, best = c.name, d
+		}
+	}
+	return name, best
+}
+
+// Distance returns the great-circle distance between a and b in kilometres.
+func Distance(a, b Point) float64 {
+	lat1 := a.Latitude * math.Pi / 180
+	lat2 := b.Latitude * math.Pi / 180
+	dLat := lat2 - lat1
+	dLon := (b.Longitude - a.Longitude) * math.Pi / 180
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * EarthRadius * math.Asin(math.Min(1, math.Sqrt(h)))
+}
@@ -1,6 +1,6 @@
 module github.com/andbar-ru/distrowatch
 
-go 1.12
+go 1.13
 
 require (
 	github.com/PuerkitoBio/goquery v1.5.1
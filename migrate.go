@@ -0,0 +1,228 @@
+package distrowatch
+
+import (
+	"database/sql"
+	"fmt"
+	"log"
+	"time"
+)
+
+// migration is a step of the schema evolution.
+// Statements are executed in one transaction.
+type migration struct {
+	version    int
+	name       string
+	statements []string
+}
+
+// migrations must be ordered by version. Never change applied migrations, append new ones instead.
+// Statements of the first migrations use IF NOT EXISTS, because databases created before
+// the migrations subsystem already have these tables.
+var migrations = []migration{
+	{1, "initial tables", []string{
+		"CREATE TABLE IF NOT EXISTS 'distrs' (`name` TEXT NOT NULL UNIQUE, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL UNIQUE, PRIMARY KEY(`name`))",
+		"CREATE TABLE IF NOT EXISTS 'coords' (`date` INTEGER NOT NULL UNIQUE, `longitude_diff` FLOAT, `longitude_trend` INTEGER, `latitude_diff` FLOAT, `latitude_trend` INTEGER, `latitude` FLOAT NOT NULL, `longitude` FLOAT NOT NULL, PRIMARY KEY(`date`))",
+		"CREATE TABLE IF NOT EXISTS 'dropout' (`name` TEXT NOT NULL, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL UNIQUE, `drop_date` INTEGER NOT NULL, PRIMARY KEY(`last_update`))",
+		"CREATE TABLE IF NOT EXISTS 'distrs_daily' (`date` INTEGER NOT NULL UNIQUE, `name` TEXT NOT NULL, `hpd` INTEGER NOT NULL, PRIMARY KEY(`date`))",
+	}},
+	{2, "rankings_daily", []string{
+		"CREATE TABLE IF NOT EXISTS 'rankings_daily' (`date` INTEGER NOT NULL, `rank` INTEGER NOT NULL, `name` TEXT NOT NULL, `url` TEXT NOT NULL, `hpd` INTEGER NOT NULL, `trend` INTEGER NOT NULL, PRIMARY KEY(`date`, `rank`))",
+	}},
+	{3, "drop UNIQUE on distrs.last_update", []string{
+		"CREATE TABLE 'distrs_new' (`name` TEXT NOT NULL, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL, PRIMARY KEY(`name`))",
+		"INSERT INTO distrs_new (name, count, last_update) SELECT name, count, last_update FROM distrs",
+		"DROP TABLE distrs",
+		"ALTER TABLE distrs_new RENAME TO distrs",
+		"CREATE INDEX distrs_last_update ON distrs (last_update)",
+	}},
+	{4, "screenshots", []string{
+		"CREATE TABLE 'screenshots' (`date` INTEGER NOT NULL, `name` TEXT NOT NULL, `url` TEXT NOT NULL, `path` TEXT NOT NULL, `sha256` TEXT NOT NULL, `size` INTEGER NOT NULL, `mime_type` TEXT NOT NULL, `width` INTEGER, `height` INTEGER, `average_color` TEXT, PRIMARY KEY(`date`))",
+	}},
+	{5, "distrs_daily.strategy", []string{
+		"ALTER TABLE distrs_daily ADD COLUMN `strategy` TEXT NOT NULL DEFAULT 'first-equal'",
+	}},
+	{6, "runs", []string{
+		"CREATE TABLE 'runs' (`id` INTEGER NOT NULL, `date` INTEGER NOT NULL, `started_at` INTEGER NOT NULL, `finished_at` INTEGER NOT NULL, `status` TEXT NOT NULL, `message` TEXT NOT NULL, PRIMARY KEY(`id`))",
+		"CREATE INDEX runs_date ON runs (date)",
+	}},
+	// Rows of existing coords are filled by the parser command recompute-coords.
+	{7, "places", []string{
+		"CREATE TABLE 'places' (`date` INTEGER NOT NULL, `country` TEXT NOT NULL, `water` TEXT NOT NULL, `city` TEXT NOT NULL, `distance_km` FLOAT NOT NULL, `description` TEXT NOT NULL, PRIMARY KEY(`date`))",
+	}},
+	// Lists in distr_info are joined with ", ". The view keeps the latest info of every distr.
+	{8, "distr_info", []string{
+		"CREATE TABLE 'distr_info' (`id` INTEGER NOT NULL, `name` TEXT NOT NULL, `date` INTEGER NOT NULL, `fetched_at` INTEGER NOT NULL, `url` TEXT NOT NULL, `os_type` TEXT NOT NULL, `based_on` TEXT NOT NULL, `origin` TEXT NOT NULL, `architecture` TEXT NOT NULL, `desktop` TEXT NOT NULL, `category` TEXT NOT NULL, `status` TEXT NOT NULL, `latest_version` TEXT NOT NULL, PRIMARY KEY(`id`))",
+		"CREATE INDEX distr_info_name ON distr_info (name)",
+		"CREATE VIEW distr_info_latest AS SELECT * FROM distr_info WHERE id IN (SELECT MAX(id) FROM distr_info GROUP BY name)",
+	}},
+	// Aliases map names and slugs to canonical names, merges is the audit log of merged distrs.
+	// Slugs of known distrs are taken from their latest urls in rankings_daily.
+	{9, "aliases and merges", []string{
+		"CREATE TABLE 'aliases' (`kind` TEXT NOT NULL, `alias` TEXT NOT NULL, `canonical` TEXT NOT NULL, PRIMARY KEY(`kind`, `alias`))",
+		"INSERT OR IGNORE INTO aliases (kind, alias, canonical) SELECT 'slug', substr(url, instr(url, 'distribution=') + length('distribution=')), name FROM rankings_daily WHERE instr(url, 'distribution=') > 0 ORDER BY date DESC",
+		"CREATE TABLE 'merges' (`id` INTEGER NOT NULL, `from_name` TEXT NOT NULL, `into_name` TEXT NOT NULL, `merged_at` INTEGER NOT NULL, `summary` TEXT NOT NULL, PRIMARY KEY(`id`))",
+	}},
+	// Slugs of daily winners are taken from rankings_daily of the day, the others from aliases.
+	// Empty slug means unknown.
+	{10, "slugs", []string{
+		"ALTER TABLE distrs ADD COLUMN `slug` TEXT NOT NULL DEFAULT ''",
+		"ALTER TABLE distrs_daily ADD COLUMN `slug` TEXT NOT NULL DEFAULT ''",
+		"ALTER TABLE dropout ADD COLUMN `slug` TEXT NOT NULL DEFAULT ''",
+		"UPDATE distrs_daily SET slug = COALESCE((SELECT substr(r.url, instr(r.url, 'distribution=') + length('distribution=')) FROM rankings_daily r WHERE r.date = distrs_daily.date AND r.name = distrs_daily.name AND instr(r.url, 'distribution=') > 0), (SELECT alias FROM aliases WHERE kind = 'slug' AND canonical = distrs_daily.name ORDER BY alias LIMIT 1), '')",
+		"UPDATE distrs SET slug = COALESCE((SELECT slug FROM distrs_daily WHERE distrs_daily.name = distrs.name AND slug != '' ORDER BY date DESC LIMIT 1), (SELECT alias FROM aliases WHERE kind = 'slug' AND canonical = distrs.name ORDER BY alias LIMIT 1), '')",
+		"UPDATE dropout SET slug = COALESCE((SELECT slug FROM distrs_daily WHERE distrs_daily.name = dropout.name AND slug != '' ORDER BY date DESC LIMIT 1), (SELECT alias FROM aliases WHERE kind = 'slug' AND canonical = dropout.name ORDER BY alias LIMIT 1), '')",
+	}},
+	// A distr may drop out and come back several times. Every period between coming to distrs
+	// and dropping out is a stint numbered from 1. Dropout keeps finished stints linked by previous_id,
+	// distrs keeps the current stint linked to the previous one by reactivated_from.
+	// first_update is NULL where distrs_daily has no record of the stint.
+	{11, "dropout stints", []string{
+		"CREATE TABLE 'dropout_new' (`id` INTEGER NOT NULL, `name` TEXT NOT NULL, `slug` TEXT NOT NULL DEFAULT '', `count` INTEGER NOT NULL, `first_update` INTEGER, `last_update` INTEGER NOT NULL, `drop_date` INTEGER NOT NULL, `stint` INTEGER NOT NULL, `previous_id` INTEGER, PRIMARY KEY(`id`))",
+		"INSERT INTO dropout_new (name, slug, count, last_update, drop_date, stint) SELECT name, slug, count, last_update, drop_date, ROW_NUMBER() OVER (PARTITION BY name ORDER BY last_update) FROM dropout ORDER BY last_update",
+		"DROP TABLE dropout",
+		"ALTER TABLE dropout_new RENAME TO dropout",
+		"CREATE INDEX dropout_name ON dropout (name, stint)",
+		"UPDATE dropout SET previous_id = (SELECT d.id FROM dropout d WHERE d.name = dropout.name AND d.stint = dropout.stint - 1)",
+		"UPDATE dropout SET first_update = (SELECT MIN(date) FROM distrs_daily WHERE distrs_daily.name = dropout.name AND date <= dropout.last_update AND date > COALESCE((SELECT d.drop_date FROM dropout d WHERE d.id = dropout.previous_id), 0))",
+		"ALTER TABLE distrs ADD COLUMN `stint` INTEGER NOT NULL DEFAULT 1",
+		"ALTER TABLE distrs ADD COLUMN `first_update` INTEGER",
+		"ALTER TABLE distrs ADD COLUMN `reactivated_from` INTEGER",
+		"UPDATE distrs SET stint = 1 + (SELECT count(*) FROM dropout WHERE dropout.name = distrs.name), reactivated_from = (SELECT id FROM dropout WHERE dropout.name = distrs.name ORDER BY stint DESC LIMIT 1)",
+		"UPDATE distrs SET first_update = (SELECT MIN(date) FROM distrs_daily WHERE distrs_daily.name = distrs.name AND date > COALESCE((SELECT drop_date FROM dropout WHERE dropout.id = distrs.reactivated_from), 0))",
+	}},
+	// Every ranking source has its own namespace, existing rows belong to the source 'default'.
+	{12, "sources", []string{
+		"CREATE TABLE 'distrs_new' (`source` TEXT NOT NULL, `name` TEXT NOT NULL, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL, `slug` TEXT NOT NULL DEFAULT '', `stint` INTEGER NOT NULL DEFAULT 1, `first_update` INTEGER, `reactivated_from` INTEGER, PRIMARY KEY(`source`, `name`))",
+		"INSERT INTO distrs_new (source, name, count, last_update, slug, stint, first_update, reactivated_from) SELECT 'default', name, count, last_update, slug, stint, first_update, reactivated_from FROM distrs",
+		"DROP TABLE distrs",
+		"ALTER TABLE distrs_new RENAME TO distrs",
+		"CREATE INDEX distrs_last_update ON distrs (source, last_update)",
+
+		"CREATE TABLE 'distrs_daily_new' (`source` TEXT NOT NULL, `date` INTEGER NOT NULL, `name` TEXT NOT NULL, `hpd` INTEGER NOT NULL, `strategy` TEXT NOT NULL DEFAULT 'first-equal', `slug` TEXT NOT NULL DEFAULT '', PRIMARY KEY(`source`, `date`))",
+		"INSERT INTO distrs_daily_new (source, date, name, hpd, strategy, slug) SELECT 'default', date, name, hpd, strategy, slug FROM distrs_daily",
+		"DROP TABLE distrs_daily",
+		"ALTER TABLE distrs_daily_new RENAME TO distrs_daily",
+
+		"CREATE TABLE 'coords_new' (`source` TEXT NOT NULL, `date` INTEGER NOT NULL, `longitude_diff` FLOAT, `longitude_trend` INTEGER, `latitude_diff` FLOAT, `latitude_trend` INTEGER, `latitude` FLOAT NOT NULL, `longitude` FLOAT NOT NULL, PRIMARY KEY(`source`, `date`))",
+		"INSERT INTO coords_new (source, date, longitude_diff, longitude_trend, latitude_diff, latitude_trend, latitude, longitude) SELECT 'default', date, longitude_diff, longitude_trend, latitude_diff, latitude_trend, latitude, longitude FROM coords",
+		"DROP TABLE coords",
+		"ALTER TABLE coords_new RENAME TO coords",
+
+		"CREATE TABLE 'rankings_daily_new' (`source` TEXT NOT NULL, `date` INTEGER NOT NULL, `rank` INTEGER NOT NULL, `name` TEXT NOT NULL, `url` TEXT NOT NULL, `hpd` INTEGER NOT NULL, `trend` INTEGER NOT NULL, PRIMARY KEY(`source`, `date`, `rank`))",
+		"INSERT INTO rankings_daily_new (source, date, rank, name, url, hpd, trend) SELECT 'default', date, rank, name, url, hpd, trend FROM rankings_daily",
+		"DROP TABLE rankings_daily",
+		"ALTER TABLE rankings_daily_new RENAME TO rankings_daily",
+
+		"CREATE TABLE 'places_new' (`source` TEXT NOT NULL, `date` INTEGER NOT NULL, `country` TEXT NOT NULL, `water` TEXT NOT NULL, `city` TEXT NOT NULL, `distance_km` FLOAT NOT NULL, `description` TEXT NOT NULL, PRIMARY KEY(`source`, `date`))",
+		"INSERT INTO places_new (source, date, country, water, city, distance_km, description) SELECT 'default', date, country, water, city, distance_km, description FROM places",
+		"DROP TABLE places",
+		"ALTER TABLE places_new RENAME TO places",
+
+		"CREATE TABLE 'screenshots_new' (`source` TEXT NOT NULL, `date` INTEGER NOT NULL, `name` TEXT NOT NULL, `url` TEXT NOT NULL, `path` TEXT NOT NULL, `sha256` TEXT NOT NULL, `size` INTEGER NOT NULL, `mime_type` TEXT NOT NULL, `width` INTEGER, `height` INTEGER, `average_color` TEXT, PRIMARY KEY(`source`, `date`))",
+		"INSERT INTO screenshots_new (source, date, name, url, path, sha256, size, mime_type, width, height, average_color) SELECT 'default', date, name, url, path, sha256, size, mime_type, width, height, average_color FROM screenshots",
+		"DROP TABLE screenshots",
+		"ALTER TABLE screenshots_new RENAME TO screenshots",
+
+		"ALTER TABLE dropout ADD COLUMN `source` TEXT NOT NULL DEFAULT 'default'",
+		"DROP INDEX dropout_name",
+		"CREATE INDEX dropout_name ON dropout (source, name, stint)",
+		"ALTER TABLE runs ADD COLUMN `source` TEXT NOT NULL DEFAULT 'default'",
+	}},
+	// Finished steps of the daily update, finished_at is NULL for days recorded before steps were tracked.
+	// Those days are complete: the parser did every step of them at once, and they can't be resumed,
+	// because their ranking may be not recorded.
+	{13, "steps", []string{
+		"CREATE TABLE 'steps' (`source` TEXT NOT NULL, `date` INTEGER NOT NULL, `step` TEXT NOT NULL, `finished_at` INTEGER, PRIMARY KEY(`source`, `date`, `step`))",
+		"INSERT INTO steps (source, date, step) SELECT source, date, 'ranking' FROM distrs_daily",
+		"INSERT INTO steps (source, date, step) SELECT source, date, 'coords' FROM distrs_daily",
+		"INSERT INTO steps (source, date, step) SELECT source, date, 'info' FROM distrs_daily",
+		"INSERT INTO steps (source, date, step) SELECT source, date, 'screenshot' FROM distrs_daily",
+	}},
+	// Fingerprint of the day's ranking, empty for days before it was stored, then it is computed from rankings_daily.
+	{14, "fingerprints", []string{
+		"ALTER TABLE distrs_daily ADD COLUMN `fingerprint` TEXT NOT NULL DEFAULT ''",
+	}},
+}
+
+// SchemaVersion returns the version of the latest applied migration, 0 if there is none.
+func SchemaVersion(db *sql.DB) (int, error) {
+	var count int
+	err := db.QueryRow("SELECT count(*) FROM sqlite_master WHERE type='table' AND name='schema_migrations'").Scan(&count)
+	if err != nil || count == 0 {
+		return 0, err
+	}
+	var version int
+	err = db.QueryRow("SELECT COALESCE(MAX(version), 0) FROM schema_migrations").Scan(&version)
+	return version, err
+}
+
+// LatestSchemaVersion returns the version the database is migrated to.
+func LatestSchemaVersion() int {
+	return migrations[len(migrations)-1].version
+}
+
+// Migrate applies pending migrations to the database stored in the file database.
+// If the database already has tables, it is backed up to the file next to it first.
+func Migrate(db *sql.DB, database string) error {
+	_, err := db.Exec("CREATE TABLE IF NOT EXISTS 'schema_migrations' (`version` INTEGER NOT NULL, `name` TEXT NOT NULL, `applied_at` INTEGER NOT NULL, PRIMARY KEY(`version`))")
+	if err != nil {
+		return err
+	}
+	version, err := SchemaVersion(db)
+	if err != nil {
+		return err
+	}
+	if version >= LatestSchemaVersion() {
+		return nil
+	}
+
+	// Back up non-empty database.
+	var tableCount int
+	err = db.QueryRow("SELECT count(*) FROM sqlite_master WHERE type='table' AND name != 'schema_migrations'").Scan(&tableCount)
+	if err != nil {
+		return err
+	}
+	if tableCount > 0 {
+		backup := fmt.Sprintf("%s.v%d-%s.bak", database, version, time.Now().Format("20060102150405"))
+		if _, err := db.Exec("VACUUM INTO ?", backup); err != nil {
+			return fmt.Errorf("could not back up database to %s: %w", backup, err)
+		}
+		log.Printf("Database is backed up to %s before migration from version %d", backup, version)
+	}
+
+	for _, m := range migrations {
+		if m.version <= version {
+			continue
+		}
+		if err := applyMigration(db, m); err != nil {
+			return fmt.Errorf("migration %d (%s): %w", m.version, m.name, err)
+		}
+	}
+	return nil
+}
+
+func applyMigration(db *sql.DB, m migration) error {
+	tx, err := db.Begin()
+	if err != nil {
+		return err
+	}
+	// Another process may have applied the migration meanwhile.
+	var applied int
+	err = tx.QueryRow("SELECT count(*) FROM schema_migrations WHERE version = ?", m.version).Scan(&applied)
+	if err != nil || applied > 0 {
+		tx.Rollback()
+		return err
+	}
+	for _, statement := range m.statements {
+		if _, err := tx.Exec(statement); err != nil {
+			tx.Rollback()
+			return err
+		}
+	}
+	_, err = tx.Exec("INSERT INTO schema_migrations (version, name, applied_at) VALUES (?, ?, ?)", m.version, m.name, time.Now().Unix())
+	if err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
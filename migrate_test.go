@@ -0,0 +1,114 @@
+package distrowatch
+
+import (
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// baselineSchema is the schema the parser created before migrations existed.
+var baselineSchema = []string{
+	"CREATE TABLE 'distrs' (`name` TEXT NOT NULL UNIQUE, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL UNIQUE, PRIMARY KEY(`name`))",
+	"CREATE TABLE 'coords' (`date` INTEGER NOT NULL UNIQUE, `longitude_diff` FLOAT, `longitude_trend` INTEGER, `latitude_diff` FLOAT, `latitude_trend` INTEGER, `latitude` FLOAT NOT NULL, `longitude` FLOAT NOT NULL, PRIMARY KEY(`date`))",
+	"CREATE TABLE 'dropout' (`name` TEXT NOT NULL, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL UNIQUE, `drop_date` INTEGER NOT NULL, PRIMARY KEY(`last_update`))",
+	"CREATE TABLE 'distrs_daily' (`date` INTEGER NOT NULL UNIQUE, `name` TEXT NOT NULL, `hpd` INTEGER NOT NULL, PRIMARY KEY(`date`))",
+}
+
+// baselineRows are days of X and Y, X has dropped out twice and come back.
+var baselineRows = []string{
+	"INSERT INTO distrs_daily (date, name, hpd) VALUES (20200101, 'X', 10), (20210106, 'X', 11), (20220110, 'Y', 12), (20220111, 'X', 13), (20220109, 'Y', 14)",
+	"INSERT INTO distrs (name, count, last_update) VALUES ('X', 1, 20220111), ('Y', 2, 20220110)",
+	"INSERT INTO dropout (name, count, last_update, drop_date) VALUES ('X', 1, 20200101, 20210105), ('X', 1, 20210106, 20220107)",
+	"INSERT INTO coords (date, longitude_diff, longitude_trend, latitude_diff, latitude_trend, latitude, longitude) VALUES (20220110, 0.1, 1, 0.2, -1, 59.8, 30.1), (20220111, 0.3, 0, 0.1, 1, 59.9, 30.1)",
+}
+
+func TestMigrateBaseline(t *testing.T) {
+	dir, err := ioutil.TempDir("", "distrowatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "db.sqlite3")
+	db, err := sql.Open("sqlite3", DSN(path, false))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer db.Close()
+	for _, statement := range append(baselineSchema, baselineRows...) {
+		if _, err := db.Exec(statement); err != nil {
+			t.Fatalf("%s: %v", statement, err)
+		}
+	}
+
+	if err := Migrate(db, path); err != nil {
+		t.Fatal(err)
+	}
+	version, err := SchemaVersion(db)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != LatestSchemaVersion() {
+		t.Errorf("schema version is %d, want %d", version, LatestSchemaVersion())
+	}
+
+	// The backup keeps the baseline database.
+	backups, err := filepath.Glob(path + ".v0-*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Fatalf("backups are %v, want one", backups)
+	}
+	backup, err := sql.Open("sqlite3", DSN(backups[0], true))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer backup.Close()
+	var count int
+	if err := backup.QueryRow("SELECT count(*) FROM distrs_daily").Scan(&count); err != nil {
+		t.Fatal(err)
+	}
+	if count != 5 {
+		t.Errorf("backup has %d days, want 5", count)
+	}
+
+	queries := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT group_concat(source || ':' || name || ':' || count || ':' || last_update || ':' || stint || ':' || COALESCE(first_update, '-') || ':' || COALESCE(reactivated_from, '-'), ' ') FROM (SELECT * FROM distrs ORDER BY name)",
+			"default:X:1:20220111:3:20220111:2 default:Y:2:20220110:1:20220109:-"},
+		{"SELECT group_concat(id || ':' || source || ':' || name || ':' || stint || ':' || COALESCE(first_update, '-') || ':' || last_update || ':' || drop_date || ':' || COALESCE(previous_id, '-'), ' ') FROM (SELECT * FROM dropout ORDER BY id)",
+			"1:default:X:1:20200101:20200101:20210105:- 2:default:X:2:20210106:20210106:20220107:1"},
+		{"SELECT group_concat(source || ':' || date || ':' || name || ':' || hpd || ':' || strategy || ':' || fingerprint, ' ') FROM (SELECT * FROM distrs_daily ORDER BY date)",
+			"default:20200101:X:10:first-equal: default:20210106:X:11:first-equal: default:20220109:Y:14:first-equal: default:20220110:Y:12:first-equal: default:20220111:X:13:first-equal:"},
+		{"SELECT group_concat(source || ':' || date || ':' || latitude || ':' || longitude, ' ') FROM (SELECT * FROM coords ORDER BY date)",
+			"default:20220110:59.8:30.1 default:20220111:59.9:30.1"},
+		// Every step of the days recorded before steps were tracked is finished.
+		{"SELECT count(*) || ':' || count(finished_at) FROM steps", "20:0"},
+		{"SELECT count(DISTINCT date) FROM steps WHERE step IN ('ranking', 'coords', 'info', 'screenshot')", "5"},
+	}
+	for _, q := range queries {
+		var got string
+		if err := db.QueryRow(q.query).Scan(&got); err != nil {
+			t.Fatalf("%s: %v", q.query, err)
+		}
+		if got != q.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", q.query, got, q.want)
+		}
+	}
+
+	// Migrating the latest schema does nothing, not even a backup.
+	if err := Migrate(db, path); err != nil {
+		t.Fatal(err)
+	}
+	backups, err = filepath.Glob(path + ".v*.bak")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(backups) != 1 {
+		t.Errorf("backups after the second migration are %v, want one", backups)
+	}
+}
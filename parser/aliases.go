@@ -0,0 +1,155 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Kinds of aliases.
+const (
+	AliasName = "name"
+	AliasSlug = "slug"
+)
+
+// ErrSameIdentity means that a distribution is merged into itself.
+var ErrSameIdentity = errors.New("cannot merge distribution into itself")
+
+// ErrNothingToMerge means that nothing is recorded for the distribution being merged.
+var ErrNothingToMerge = errors.New("nothing is recorded for distribution")
+
+// Slug returns the DistroWatch slug of the distr page url like "table.php?distribution=mint",
+// empty string if the url has no slug.
+func Slug(distrURL string) string {
+	u, err := url.Parse(distrURL)
+	if err != nil {
+		return ""
+	}
+	return u.Query().Get("distribution")
+}
+
+// resolveName returns the canonical name of the distribution with the name and the slug.
+// An alias of the name takes precedence over an alias of the slug. If there are no aliases,
+// the name is canonical itself.
+func resolveName(ctx context.Context, q queryer, name, slug string) (string, error) {
+	var canonical string
+	err := q.QueryRowContext(ctx, "SELECT canonical FROM aliases WHERE (kind = ? AND alias = ?) OR (kind = ? AND alias = ?) ORDER BY kind = ? DESC LIMIT 1", AliasName, name, AliasSlug, slug, AliasName).Scan(&canonical)
+	if err == sql.ErrNoRows {
+		return name, nil
+	}
+	return canonical, err
+}
+
+// ResolveAliases returns the outcome with the canonical name of the distribution,
+// see table aliases.
+func ResolveAliases(ctx context.Context, db *sql.DB, outcome Outcome) (Outcome, error) {
+	name, err := resolveName(ctx, db, outcome.DistrName, Slug(outcome.DistrURL))
+	if err != nil {
+		return outcome, err
+	}
+	outcome.DistrName = name
+	return outcome, nil
+}
+
+// AddAlias makes the alias of the kind (AliasName or AliasSlug) resolve to the canonical name.
+func AddAlias(ctx context.Context, db *sql.DB, kind, alias, canonical string) error {
+	if kind != AliasName && kind != AliasSlug {
+		return fmt.Errorf("invalid alias kind %q, expected %s or %s", kind, AliasName, AliasSlug)
+	}
+	_, err := db.ExecContext(ctx, "INSERT OR REPLACE INTO aliases (kind, alias, canonical) VALUES (?, ?, ?)", kind, alias, canonical)
+	return err
+}
+
+// Merge moves everything recorded for the distribution from to the distribution into
+// in one transaction in every source: counts in distrs are summed, rows of other tables are renamed, aliases of from
+// are redirected to into and from itself becomes an alias of into. Stints of into are renumbered
+// in order of their last updates. Table rankings_daily is left
+// as is, because it keeps the ranking as it was on the page. The merge is recorded in the table merges.
+// Returns the numbers of affected rows by table or ErrNothingToMerge if nothing is recorded for from.
+func Merge(ctx context.Context, db *sql.DB, from, into string, now time.Time) (map[string]int64, error) {
+	if from == into {
+		return nil, ErrSameIdentity
+	}
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return nil, err
+	}
+	affected, err := merge(ctx, tx, from, into, now)
+	if err != nil {
+		tx.Rollback()
+		return nil, err
+	}
+	return affected, tx.Commit()
+}
+
+func merge(ctx context.Context, tx *sql.Tx, from, into string, now time.Time) (map[string]int64, error) {
+	affected := make(map[string]int64)
+	exec := func(table, query string, args ...interface{}) error {
+		result, err := tx.ExecContext(ctx, query, args...)
+		if err != nil {
+			return fmt.Errorf("%s: %w", table, err)
+		}
+		n, err := result.RowsAffected()
+		affected[table] += n
+		return err
+	}
+
+	// distrs: sum into the existing row of the same source or rename.
+	err := exec("distrs", "UPDATE distrs SET count = count + (SELECT d.count FROM distrs d WHERE d.source = distrs.source AND d.name = ?), last_update = MAX(last_update, (SELECT d.last_update FROM distrs d WHERE d.source = distrs.source AND d.name = ?)), slug = CASE WHEN slug = '' THEN (SELECT d.slug FROM distrs d WHERE d.source = distrs.source AND d.name = ?) ELSE slug END WHERE name = ? AND EXISTS (SELECT 1 FROM distrs d WHERE d.source = distrs.source AND d.name = ?)", from, from, from, into, from)
+	if err != nil {
+		return nil, err
+	}
+	_, err = tx.ExecContext(ctx, "DELETE FROM distrs WHERE name = ? AND EXISTS (SELECT 1 FROM distrs d WHERE d.source = distrs.source AND d.name = ?)", from, into)
+	if err != nil {
+		return nil, err
+	}
+	if err := exec("distrs", "UPDATE distrs SET name = ? WHERE name = ?", into, from); err != nil {
+		return nil, err
+	}
+
+	for _, table := range []string{"distrs_daily", "dropout", "screenshots", "distr_info"} {
+		if err := exec(table, "UPDATE "+table+" SET name = ? WHERE name = ?", into, from); err != nil {
+			return nil, err
+		}
+	}
+
+	// Stints of both distrs are renumbered in order of their last updates.
+	for _, query := range []string{
+		"UPDATE dropout SET stint = (SELECT count(*) FROM dropout d WHERE d.source = dropout.source AND d.name = dropout.name AND (d.last_update < dropout.last_update OR d.last_update = dropout.last_update AND d.id <= dropout.id)) WHERE name = ?",
+		"UPDATE dropout SET previous_id = (SELECT d.id FROM dropout d WHERE d.source = dropout.source AND d.name = dropout.name AND d.stint = dropout.stint - 1) WHERE name = ?",
+		"UPDATE distrs SET stint = 1 + (SELECT count(*) FROM dropout WHERE dropout.source = distrs.source AND dropout.name = distrs.name), reactivated_from = (SELECT id FROM dropout WHERE dropout.source = distrs.source AND dropout.name = distrs.name ORDER BY stint DESC LIMIT 1) WHERE name = ?",
+	} {
+		if _, err := tx.ExecContext(ctx, query, into); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := exec("aliases", "UPDATE aliases SET canonical = ? WHERE canonical = ?", into, from); err != nil {
+		return nil, err
+	}
+	// A misspelled from must not become an alias.
+	var matched int64
+	for _, n := range affected {
+		matched += n
+	}
+	if matched == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrNothingToMerge, from)
+	}
+	if err := exec("aliases", "INSERT OR REPLACE INTO aliases (kind, alias, canonical) VALUES (?, ?, ?)", AliasName, from, into); err != nil {
+		return nil, err
+	}
+
+	var summary []string
+	for _, table := range []string{"distrs", "distrs_daily", "dropout", "screenshots", "distr_info", "aliases"} {
+		summary = append(summary, fmt.Sprintf("%s: %d", table, affected[table]))
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO merges (from_name, into_name, merged_at, summary) VALUES (?, ?, ?, ?)", from, into, now.Unix(), strings.Join(summary, ", "))
+	if err != nil {
+		return nil, err
+	}
+	return affected, nil
+}
@@ -0,0 +1,93 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestMerge(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Old drops out on the 3rd and comes back on the 4th, New drops out on the 4th.
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	applyDays(t, db, start, "Old", "New", "Third", "Old")
+
+	affected, err := Merge(ctx, db, "Old", "New", start.AddDate(0, 0, 4))
+	if err != nil {
+		t.Fatal(err)
+	}
+	wantAffected := map[string]int64{"distrs": 1, "distrs_daily": 2, "dropout": 1, "screenshots": 0, "distr_info": 0, "aliases": 2}
+	for table, want := range wantAffected {
+		if affected[table] != want {
+			t.Errorf("Merge affected %d rows of %s, want %d", affected[table], table, want)
+		}
+	}
+
+	queries := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT group_concat(name || ':' || count || ':' || last_update || ':' || stint, ' ') FROM (SELECT * FROM distrs ORDER BY name)",
+			"New:1:20240304:3 Third:1:20240303:1"},
+		{"SELECT group_concat(name || ':' || stint || ':' || last_update || ':' || drop_date || ':' || COALESCE(previous_id, '-'), ' ') FROM (SELECT * FROM dropout ORDER BY stint)",
+			"New:1:20240301:20240303:- New:2:20240302:20240304:1"},
+		{"SELECT group_concat(date || ':' || name, ' ') FROM (SELECT * FROM distrs_daily ORDER BY date)",
+			"20240301:New 20240302:New 20240303:Third 20240304:New"},
+		// The ranking stays as it was on the page.
+		{"SELECT group_concat(date || ':' || name, ' ') FROM (SELECT * FROM rankings_daily WHERE rank = 1 ORDER BY date)",
+			"20240301:Old 20240302:New 20240303:Third 20240304:Old"},
+		{"SELECT group_concat(kind || ':' || alias || ':' || canonical, ' ') FROM (SELECT * FROM aliases WHERE alias = 'Old')",
+			"name:Old:New"},
+		{"SELECT group_concat(from_name || ':' || into_name || ':' || merged_at, ' ') FROM merges",
+			"Old:New:1709596800"},
+	}
+	for _, q := range queries {
+		var got string
+		if err := db.QueryRow(q.query).Scan(&got); err != nil {
+			t.Fatalf("%s: %v", q.query, err)
+		}
+		if got != q.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", q.query, got, q.want)
+		}
+	}
+
+	// Counts of distrs in both are summed.
+	if _, err := Merge(ctx, db, "Third", "New", start.AddDate(0, 0, 4)); err != nil {
+		t.Fatal(err)
+	}
+	var distrs string
+	if err := db.QueryRow("SELECT group_concat(name || ':' || count || ':' || last_update || ':' || stint, ' ') FROM distrs").Scan(&distrs); err != nil {
+		t.Fatal(err)
+	}
+	if want := "New:2:20240304:3"; distrs != want {
+		t.Errorf("distrs after the second merge are %s, want %s", distrs, want)
+	}
+}
+
+func TestMergeNothing(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	outcome := testOutcome(time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC), "New", "Other")
+	if err := Apply(ctx, db, outcome, outcome.Date); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Merge(ctx, db, "Nwe", "New", outcome.Date); !errors.Is(err, ErrNothingToMerge) {
+		t.Errorf("Merge of unknown distr returned %v, want %v", err, ErrNothingToMerge)
+	}
+	if _, err := Merge(ctx, db, "New", "New", outcome.Date); err != ErrSameIdentity {
+		t.Errorf("Merge into itself returned %v, want %v", err, ErrSameIdentity)
+	}
+	var aliases, merges int
+	if err := db.QueryRow("SELECT (SELECT count(*) FROM aliases WHERE kind = ?), (SELECT count(*) FROM merges)", AliasName).Scan(&aliases, &merges); err != nil {
+		t.Fatal(err)
+	}
+	if aliases != 0 || merges != 0 {
+		t.Errorf("failed merges left %d name aliases and %d merges, want none", aliases, merges)
+	}
+}
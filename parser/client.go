@@ -0,0 +1,172 @@
+package parser
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"math/rand"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// Defaults for Options.
+const (
+	DefaultRequestTimeout = 30 * time.Second
+	DefaultTimeout        = 5 * time.Minute
+	DefaultRetries        = 4
+	DefaultBackoff        = 2 * time.Second
+	DefaultMaxBackoff     = time.Minute
+)
+
+func (o Options) client() *http.Client {
+	if o.FixturesDir != "" {
+		return &http.Client{Transport: &FixtureTransport{Dir: o.FixturesDir}}
+	}
+	if o.Client != nil {
+		return o.Client
+	}
+	timeout := o.RequestTimeout
+	if timeout == 0 {
+		timeout = DefaultRequestTimeout
+	}
+	return &http.Client{Timeout: timeout}
+}
+
+// withTimeout limits ctx with the overall timeout.
+func (o Options) withTimeout(ctx context.Context) (context.Context, context.CancelFunc) {
+	timeout := o.Timeout
+	if timeout == 0 {
+		timeout = DefaultTimeout
+	}
+	return context.WithTimeout(ctx, timeout)
+}
+
+func (o Options) retries() int {
+	if o.Retries == 0 {
+		return DefaultRetries
+	} else if o.Retries < 0 {
+		return 0
+	}
+	return o.Retries
+}
+
+// backoff returns the delay before retry number attempt (starting with 0):
+// exponential with jitter, so that the delay is random in [d/2, d).
+func (o Options) backoff(attempt int) time.Duration {
+	d, maxD := o.Backoff, o.MaxBackoff
+	if d == 0 {
+		d = DefaultBackoff
+	}
+	if maxD == 0 {
+		maxD = DefaultMaxBackoff
+	}
+	for i := 0; i < attempt && d < maxD; i++ {
+		d *= 2
+	}
+	if d > maxD {
+		d = maxD
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
+
+// retryAfter parses header Retry-After which is either seconds or HTTP date.
+func retryAfter(response *http.Response) (time.Duration, bool) {
+	value := response.Header.Get("Retry-After")
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		d := time.Until(date)
+		if d < 0 {
+			d = 0
+		}
+		return d, true
+	}
+	return 0, false
+}
+
+func retryableStatus(statusCode int) bool {
+	return statusCode >= 500 || statusCode == http.StatusTooManyRequests
+}
+
+func checkResponse(response *http.Response) error {
+	if response.StatusCode != http.StatusOK && response.StatusCode != http.StatusNotModified {
+		return &StatusError{URL: response.Request.URL.String(), StatusCode: response.StatusCode, Status: response.Status}
+	}
+	return nil
+}
+
+// getResponse performs GET request with header, retrying after network errors and 5xx responses.
+// It returns response with status 200 or, for a conditional request, 304.
+// Consumers have to close the response body.
+func getResponse(ctx context.Context, opts Options, url string, header http.Header) (*http.Response, error) {
+	client := opts.client()
+	retries := opts.retries()
+	for attempt := 0; ; attempt++ {
+		request, err := http.NewRequestWithContext(ctx, "GET", url, nil)
+		if err != nil {
+			return nil, err
+		}
+		for key, values := range header {
+			request.Header[key] = values
+		}
+		request.Header.Set("User-Agent", userAgent)
+
+		var delay time.Duration
+		response, err := client.Do(request)
+		if err != nil {
+			if ctx.Err() != nil || attempt >= retries {
+				return nil, err
+			}
+		} else if err = checkResponse(response); err != nil {
+			response.Body.Close()
+			if !retryableStatus(response.StatusCode) || attempt >= retries {
+				return nil, err
+			}
+			delay, _ = retryAfter(response)
+		} else {
+			return response, nil
+		}
+
+		if delay == 0 {
+			delay = opts.backoff(attempt)
+		}
+		opts.logf("WARNING: %v; retry %d/%d in %s", err, attempt+1, retries, delay.Round(time.Millisecond))
+		if deadline, ok := ctx.Deadline(); ok && time.Now().Add(delay).After(deadline) {
+			return nil, fmt.Errorf("no time left to retry: %w", err)
+		}
+		timer := time.NewTimer(delay)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// getPage fetches url and returns the body.
+func getPage(ctx context.Context, opts Options, url string) ([]byte, error) {
+	response, err := getResponse(ctx, opts, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	return ioutil.ReadAll(response.Body)
+}
+
+// getDocument fetches url and parses it as HTML.
+func getDocument(ctx context.Context, opts Options, url string) (*goquery.Document, error) {
+	response, err := getResponse(ctx, opts, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	defer response.Body.Close()
+	return goquery.NewDocumentFromReader(response.Body)
+}
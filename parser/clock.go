@@ -0,0 +1,49 @@
+package parser
+
+import "time"
+
+// Clock tells the current time.
+type Clock interface {
+	Now() time.Time
+}
+
+// SystemClock is the Clock which returns time.Now.
+type SystemClock struct{}
+
+// Now implements Clock.
+func (SystemClock) Now() time.Time {
+	return time.Now()
+}
+
+// FixedClock is the Clock which always returns the same time.
+type FixedClock time.Time
+
+// Now implements Clock.
+func (c FixedClock) Now() time.Time {
+	return time.Time(c)
+}
+
+func (o Options) now() time.Time {
+	if o.Clock == nil {
+		return time.Now()
+	}
+	return o.Clock.Now()
+}
+
+// Today returns the DistroWatch day the outcome is counted for: opts.Date if set,
+// otherwise the current date in opts.Location. The day is represented as midnight UTC.
+func (o Options) Today() time.Time {
+	if !o.Date.IsZero() {
+		return day(o.Date)
+	}
+	location := o.Location
+	if location == nil {
+		location = time.Local
+	}
+	return day(o.now().In(location))
+}
+
+// day truncates t to the date represented as midnight UTC.
+func day(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+}
@@ -0,0 +1,85 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math"
+
+	"github.com/andbar-ru/distrowatch/geo"
+)
+
+// stepCoords moves prev by the day's step and rounds the result to 4 decimals as it is stored.
+func stepCoords(prev geo.Point, longitudeDiff float64, longitudeTrend int, latitudeDiff float64, latitudeTrend int) geo.Point {
+	next := geo.Step(prev, longitudeDiff*float64(longitudeTrend), latitudeDiff*float64(latitudeTrend))
+	next.Latitude = math.Round(next.Latitude*divider) / divider
+	next.Longitude = math.Round(next.Longitude*divider) / divider
+	return geo.Normalize(next)
+}
+
+// recordPlace stores the reverse geocoded place of the day's coordinates of the source.
+func recordPlace(ctx context.Context, tx *sql.Tx, source string, date interface{}, place geo.Place) error {
+	_, err := tx.ExecContext(ctx, "INSERT OR REPLACE INTO places (source, date, country, water, city, distance_km, description) VALUES (?, ?, ?, ?, ?, ?, ?)", source, date, place.Country, place.Water, place.City, fmt.Sprintf("%.1f", place.DistanceKm), place.String())
+	return err
+}
+
+// RecomputeCoords recomputes latitude and longitude of every row of the source in the table coords
+// from the stored diffs and trends, starting from geo.Initial, and their places.
+// Returns the number of rows and the biggest change of a coordinate in degrees.
+func RecomputeCoords(ctx context.Context, db *sql.DB, source Source) (int, float64, error) {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, 0, err
+	}
+	count, maxChange, err := recomputeCoords(ctx, tx, source.String())
+	if err != nil {
+		tx.Rollback()
+		return 0, 0, err
+	}
+	return count, maxChange, tx.Commit()
+}
+
+func recomputeCoords(ctx context.Context, tx *sql.Tx, source string) (int, float64, error) {
+	type coordsRow struct {
+		date                          int
+		longitudeDiff, latitudeDiff   float64
+		longitudeTrend, latitudeTrend int
+		latitude, longitude           float64
+	}
+	rows, err := tx.QueryContext(ctx, "SELECT date, longitude_diff, longitude_trend, latitude_diff, latitude_trend, latitude, longitude FROM coords WHERE source = ? ORDER BY date", source)
+	if err != nil {
+		return 0, 0, err
+	}
+	var coords []coordsRow
+	for rows.Next() {
+		var c coordsRow
+		err := rows.Scan(&c.date, &c.longitudeDiff, &c.longitudeTrend, &c.latitudeDiff, &c.latitudeTrend, &c.latitude, &c.longitude)
+		if err != nil {
+			rows.Close()
+			return 0, 0, err
+		}
+		coords = append(coords, c)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, 0, err
+	}
+
+	var maxChange float64
+	p := geo.Initial
+	for _, c := range coords {
+		p = stepCoords(p, c.longitudeDiff, c.longitudeTrend, c.latitudeDiff, c.latitudeTrend)
+		// The change across the antimeridian is the short one.
+		change := geo.Delta(geo.Point{Latitude: c.latitude, Longitude: c.longitude}, p)
+		maxChange = math.Max(maxChange, math.Abs(change.Latitude))
+		maxChange = math.Max(maxChange, math.Abs(change.Longitude))
+		_, err := tx.ExecContext(ctx, "UPDATE coords SET latitude = ?, longitude = ? WHERE source = ? AND date = ?", fmt.Sprintf("%.4f", p.Latitude), fmt.Sprintf("%.4f", p.Longitude), source, c.date)
+		if err != nil {
+			return 0, 0, err
+		}
+		if err := recordPlace(ctx, tx, source, c.date, geo.ReverseGeocode(p)); err != nil {
+			return 0, 0, err
+		}
+	}
+	return len(coords), maxChange, nil
+}
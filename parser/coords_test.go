@@ -0,0 +1,122 @@
+package parser
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestRecomputeCoordsReproducesApply(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// Big hits per day make long steps, so the walk goes over the north pole.
+	days := []struct {
+		next1HPD, next1Trend, next2HPD, next2Trend int
+	}{
+		{3000, 1, 200000, 1},
+		{1500, -1, 150000, 1},
+		{0, 0, 2500, -1},
+		{1234, 1, 0, 0},
+		{9999, -1, 8888, 1},
+	}
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	for i, day := range days {
+		ranking := []Row{
+			{Rank: 1, Name: "Alpha", URL: "https://distrowatch.com/table.php?distribution=alpha", HPD: 300000, Trend: 0},
+			{Rank: 2, Name: "Beta", URL: "https://distrowatch.com/table.php?distribution=beta", HPD: day.next1HPD, Trend: day.next1Trend},
+			{Rank: 3, Name: "Gamma", URL: "https://distrowatch.com/table.php?distribution=gamma", HPD: day.next2HPD, Trend: day.next2Trend},
+		}
+		outcome := Outcome{
+			Date:       start.AddDate(0, 0, i),
+			DistrName:  ranking[0].Name,
+			DistrURL:   ranking[0].URL,
+			HPD:        ranking[0].HPD,
+			Next1HPD:   day.next1HPD,
+			Next1Trend: day.next1Trend,
+			Next2HPD:   day.next2HPD,
+			Next2Trend: day.next2Trend,
+			Strategy:   DefaultStrategy.Name(),
+			Source:     DefaultSource,
+			Ranking:    ranking,
+		}
+		if err := Apply(ctx, db, outcome, outcome.Date); err != nil {
+			t.Fatalf("day %d: %v", i, err)
+		}
+	}
+
+	applied := make(map[int][2]float64)
+	rows, err := db.Query("SELECT date, latitude, longitude FROM coords WHERE source = ?", DefaultSource.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	for rows.Next() {
+		var date int
+		var latitude, longitude float64
+		if err := rows.Scan(&date, &latitude, &longitude); err != nil {
+			t.Fatal(err)
+		}
+		applied[date] = [2]float64{latitude, longitude}
+	}
+	rows.Close()
+	var maxLatitude float64
+	for _, coords := range applied {
+		if coords[0] > maxLatitude {
+			maxLatitude = coords[0]
+		}
+	}
+	if maxLatitude < 80 {
+		t.Fatalf("the walk doesn't get close to the pole, max latitude %v", maxLatitude)
+	}
+
+	count, maxChange, err := RecomputeCoords(ctx, db, DefaultSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != len(days) {
+		t.Errorf("RecomputeCoords recomputed %d rows, want %d", count, len(days))
+	}
+	if maxChange != 0 {
+		t.Errorf("RecomputeCoords changed coordinates by %v, want 0", maxChange)
+	}
+	for date, want := range applied {
+		var latitude, longitude float64
+		err := db.QueryRow("SELECT latitude, longitude FROM coords WHERE source = ? AND date = ?", DefaultSource.String(), date).Scan(&latitude, &longitude)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if latitude != want[0] || longitude != want[1] {
+			t.Errorf("%d: recomputed %v, %v, applied %v, %v", date, latitude, longitude, want[0], want[1])
+		}
+	}
+}
+
+func TestRecomputeCoordsAcrossAntimeridian(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	// The step goes over the north pole to -179.9999, the stored longitude is on the other side of the antimeridian.
+	_, err := db.Exec("INSERT INTO coords (source, date, longitude_diff, longitude_trend, latitude_diff, latitude_trend, latitude, longitude) VALUES (?, 20240301, 6.3104, 1, 40, 1, 78.3233, 179.9999)", DefaultSource.String())
+	if err != nil {
+		t.Fatal(err)
+	}
+	count, maxChange, err := RecomputeCoords(ctx, db, DefaultSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 1 {
+		t.Errorf("RecomputeCoords recomputed %d rows, want 1", count)
+	}
+	if maxChange > 0.001 {
+		t.Errorf("RecomputeCoords changed coordinates by %v, want 0.0002", maxChange)
+	}
+	var longitude float64
+	if err := db.QueryRow("SELECT longitude FROM coords").Scan(&longitude); err != nil {
+		t.Fatal(err)
+	}
+	if longitude != -179.9999 {
+		t.Errorf("recomputed longitude is %v, want -179.9999", longitude)
+	}
+}
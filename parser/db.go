@@ -0,0 +1,131 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+)
+
+const (
+	timeLayout = "20060102"
+	divider    = 10000
+)
+
+// Apply updates or inserts count of distribution name in the namespace of the outcome's source,
+// moves outdated distrs to dropout and computes new coordinates in one transaction,
+// which finishes StepRanking and StepCoords at now. The name is resolved through the table aliases.
+func Apply(ctx context.Context, db *sql.DB, outcome Outcome, now time.Time) error {
+	return inTx(ctx, db, func(tx *sql.Tx) error {
+		return apply(ctx, tx, outcome, now)
+	})
+}
+
+// ApplyCoords computes coordinates of the outcome which has been already applied without them
+// and finishes StepCoords at now.
+func ApplyCoords(ctx context.Context, db *sql.DB, outcome Outcome, now time.Time) error {
+	return inTx(ctx, db, func(tx *sql.Tx) error {
+		plan, err := makePlan(ctx, tx, outcome)
+		if err != nil {
+			return err
+		}
+		return applyCoords(ctx, tx, plan, now)
+	})
+}
+
+func apply(ctx context.Context, tx *sql.Tx, outcome Outcome, now time.Time) error {
+	plan, err := makePlan(ctx, tx, outcome)
+	if err != nil {
+		return err
+	}
+	if err := applyRanking(ctx, tx, outcome, plan, now); err != nil {
+		return err
+	}
+	return applyCoords(ctx, tx, plan, now)
+}
+
+// applyRanking records the ranking and the winner and moves outdated distrs to dropout.
+func applyRanking(ctx context.Context, tx *sql.Tx, outcome Outcome, plan *Plan, now time.Time) error {
+	var reactivatedFrom interface{}
+	if plan.ReactivatedFrom != nil {
+		reactivatedFrom = plan.ReactivatedFrom.ID
+	}
+	_, err := tx.ExecContext(ctx, "INSERT OR IGNORE INTO distrs (source, name, count, last_update, slug, stint, first_update, reactivated_from) VALUES (?, ?, 0, ?, ?, ?, ?, ?)", plan.Source, plan.DistrName, plan.Date, plan.Slug, plan.Stint, plan.Date, reactivatedFrom)
+	if err != nil {
+		return err
+	}
+	// Keep the known slug if the page has none.
+	_, err = tx.ExecContext(ctx, "UPDATE distrs SET count = count + 1, last_update = MAX(last_update, CAST(? AS INTEGER)), slug = CASE WHEN ? = '' THEN slug ELSE ? END WHERE source = ? AND name = ?", plan.Date, plan.Slug, plan.Slug, plan.Source, plan.DistrName)
+	if err != nil {
+		return err
+	}
+	_, err = tx.ExecContext(ctx, "INSERT INTO distrs_daily (source, date, name, hpd, strategy, slug, fingerprint) VALUES (?, ?, ?, ?, ?, ?, ?)", plan.Source, plan.Date, plan.DistrName, outcome.HPD, outcome.Strategy, plan.Slug, plan.Fingerprint)
+	if err != nil {
+		return err
+	}
+	// Remember the slug, so that the distr is recognized after renaming.
+	if plan.Slug != "" {
+		_, err = tx.ExecContext(ctx, "INSERT OR IGNORE INTO aliases (kind, alias, canonical) VALUES (?, ?, ?)", AliasSlug, plan.Slug, plan.DistrName)
+		if err != nil {
+			return err
+		}
+	}
+	for _, row := range outcome.Ranking {
+		_, err = tx.ExecContext(ctx, "INSERT INTO rankings_daily (source, date, rank, name, url, hpd, trend) VALUES (?, ?, ?, ?, ?, ?, ?)", plan.Source, plan.Date, row.Rank, row.Name, row.URL, row.HPD, row.Trend)
+		if err != nil {
+			return err
+		}
+	}
+
+	// Move distrs that have not been updated within the retention period to the table `dropout`.
+	for _, distr := range plan.Dropout {
+		_, err = tx.ExecContext(ctx, "INSERT INTO dropout (source, name, slug, count, first_update, last_update, drop_date, stint, previous_id) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?)", plan.Source, distr.Name, distr.Slug, distr.Count, nullIfZero(int64(distr.FirstUpdate)), distr.LastUpdate, plan.Date, distr.Stint, nullIfZero(distr.PreviousID))
+		if err != nil {
+			return err
+		}
+		_, err = tx.ExecContext(ctx, "DELETE FROM distrs WHERE source = ? AND name = ?", plan.Source, distr.Name)
+		if err != nil {
+			return err
+		}
+	}
+	return recordStep(ctx, tx, plan.Source, plan.Date, StepRanking, now)
+}
+
+// applyCoords records the coordinates of the plan and their place.
+func applyCoords(ctx context.Context, tx *sql.Tx, plan *Plan, now time.Time) error {
+	_, err := tx.ExecContext(ctx, "INSERT INTO coords (source, date, longitude_diff, longitude_trend, latitude_diff, latitude_trend, latitude, longitude) VALUES (?, ?, ?, ?, ?, ?, ?, ?)", plan.Source, plan.Date, fmt.Sprintf("%.4f", plan.LongitudeDiff), plan.LongitudeTrend, fmt.Sprintf("%.4f", plan.LatitudeDiff), plan.LatitudeTrend, fmt.Sprintf("%.4f", plan.Latitude), fmt.Sprintf("%.4f", plan.Longitude))
+	if err != nil {
+		return err
+	}
+	if err := recordPlace(ctx, tx, plan.Source, plan.Date, plan.Place); err != nil {
+		return err
+	}
+	return recordStep(ctx, tx, plan.Source, plan.Date, StepCoords, now)
+}
+
+// nullIfZero returns nil for 0 to store NULL instead.
+func nullIfZero(n int64) interface{} {
+	if n == 0 {
+		return nil
+	}
+	return n
+}
+
+// RecordScreenshot stores metadata of the screenshot of the outcome's distribution and finishes StepScreenshot at now.
+func RecordScreenshot(ctx context.Context, db *sql.DB, outcome Outcome, screenshot Screenshot, now time.Time) error {
+	var width, height, averageColor interface{}
+	if screenshot.AverageColor != nil {
+		width = screenshot.Width
+		height = screenshot.Height
+		averageColor = distrowatch.FormatColor(*screenshot.AverageColor)
+	}
+	return inTx(ctx, db, func(tx *sql.Tx) error {
+		_, err := tx.ExecContext(ctx, "INSERT OR REPLACE INTO screenshots (source, date, name, url, path, sha256, size, mime_type, width, height, average_color) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)", outcome.Source.String(), outcome.Date.Format(timeLayout), outcome.DistrName, screenshot.URL, screenshot.Path, screenshot.SHA256, screenshot.Size, screenshot.MIMEType, width, height, averageColor)
+		if err != nil {
+			return err
+		}
+		return recordStep(ctx, tx, outcome.Source.String(), outcome.Date.Format(timeLayout), StepScreenshot, now)
+	})
+}
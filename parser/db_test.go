@@ -0,0 +1,98 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+)
+
+// openTestDB returns a migrated database in a temporary directory, which is removed by the returned function.
+func openTestDB(t *testing.T) (*sql.DB, func()) {
+	dir, err := ioutil.TempDir("", "distrowatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "db.sqlite3")
+	db, err := sql.Open("sqlite3", distrowatch.DSN(path, false))
+	if err != nil {
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	if err := distrowatch.Migrate(db, path); err != nil {
+		db.Close()
+		os.RemoveAll(dir)
+		t.Fatal(err)
+	}
+	return db, func() {
+		db.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+// testOutcome returns the outcome of DefaultSource for the date with the ranking of names in order,
+// the first one is the winner.
+func testOutcome(date time.Time, names ...string) Outcome {
+	ranking := make([]Row, len(names))
+	for i, name := range names {
+		ranking[i] = Row{Rank: i + 1, Name: name, URL: "https://distrowatch.com/table.php?distribution=" + strings.ToLower(name), HPD: 1000 - 10*i, Trend: i%3 - 1}
+	}
+	outcome := Outcome{
+		Date:      date,
+		DistrName: ranking[0].Name,
+		DistrURL:  ranking[0].URL,
+		HPD:       ranking[0].HPD,
+		Strategy:  DefaultStrategy.Name(),
+		Source:    DefaultSource,
+		Ranking:   ranking,
+	}
+	if len(ranking) > 1 {
+		outcome.Next1HPD, outcome.Next1Trend = ranking[1].HPD, ranking[1].Trend
+	}
+	if len(ranking) > 2 {
+		outcome.Next2HPD, outcome.Next2Trend = ranking[2].HPD, ranking[2].Trend
+	}
+	return outcome
+}
+
+// applyDays applies the days of DefaultSource starting on start with the winners in order,
+// the rest of the winners follow in the rankings. Distrs drop out after a day without updates.
+func applyDays(t *testing.T, db *sql.DB, start time.Time, winners ...string) {
+	ctx := context.Background()
+	var distrs []string
+	seen := make(map[string]bool)
+	for _, winner := range winners {
+		if !seen[winner] {
+			seen[winner] = true
+			distrs = append(distrs, winner)
+		}
+	}
+	for i, winner := range winners {
+		names := []string{winner}
+		for _, name := range distrs {
+			if name != winner {
+				names = append(names, name)
+			}
+		}
+		outcome := testOutcome(start.AddDate(0, 0, i), names...)
+		outcome.Retention = Retention{Days: 1}
+		if err := Apply(ctx, db, outcome, outcome.Date); err != nil {
+			t.Fatalf("day %d: %v", i, err)
+		}
+	}
+}
+
+// queryString returns the string the query selects.
+func queryString(t *testing.T, db *sql.DB, query string) string {
+	var s string
+	if err := db.QueryRow(query).Scan(&s); err != nil {
+		t.Fatalf("%s: %v", query, err)
+	}
+	return s
+}
@@ -0,0 +1,125 @@
+package parser
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// maxFound limits LayoutError.Found.
+const maxFound = 300
+
+// LayoutError describes where parsing of the ranking table failed and what was found instead.
+// It matches its sentinel error (ErrNoHPDCells, ErrBadTrend etc.) with errors.Is.
+type LayoutError struct {
+	Err error
+	// Selector is the expected element, like "td.phr3 > img".
+	Selector string
+	// Row is the index of the row of the ranking table, -1 if the failure is not in a row.
+	Row int
+	// Found is the HTML or the value found instead, truncated to a few hundred bytes.
+	Found   string
+	Message string
+}
+
+func (e *LayoutError) Error() string {
+	return fmt.Sprintf("%v: %s", e.Err, e.Message)
+}
+
+// Unwrap returns the sentinel error.
+func (e *LayoutError) Unwrap() error {
+	return e.Err
+}
+
+// outerHTML returns HTML of the first element of the selection for LayoutError.Found.
+func outerHTML(s *goquery.Selection) string {
+	if s.Length() == 0 {
+		return "nothing"
+	}
+	html, err := goquery.OuterHtml(s.First())
+	if err != nil {
+		return err.Error()
+	}
+	return truncate(strings.TrimSpace(html), maxFound)
+}
+
+// truncate cuts s to n bytes at most, marking the cut with "...".
+func truncate(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	return s[:n] + "..."
+}
+
+// Report is the structured report of extraction of the ranking from a page.
+type Report struct {
+	Time time.Time `json:"time"`
+	URL  string    `json:"url,omitempty"`
+	// HTMLPath is the saved page.
+	HTMLPath string `json:"htmlPath,omitempty"`
+	// Cells is the number of hits per day cells (td.phr3) on the page.
+	Cells int `json:"cells"`
+	// Error is empty if the extraction succeeded, then Rows and Winner are set.
+	Error    string `json:"error,omitempty"`
+	Selector string `json:"selector,omitempty"`
+	Row      int    `json:"row"`
+	Found    string `json:"found,omitempty"`
+	Rows     int    `json:"rows"`
+	Winner   string `json:"winner,omitempty"`
+}
+
+// Diagnose runs the extraction of the outcome against the page and reports the result.
+func Diagnose(page []byte, opts Options) (*Report, error) {
+	root, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+	if err != nil {
+		return nil, err
+	}
+	outcome, err := parseOutcome(root, opts)
+	return newReport(root, opts, outcome, err), nil
+}
+
+func newReport(root *goquery.Document, opts Options, outcome Outcome, err error) *Report {
+	report := &Report{Time: opts.now(), Cells: root.Find("td.phr3").Length(), Row: -1}
+	if err == nil {
+		report.Rows = len(outcome.Ranking)
+		report.Winner = outcome.DistrName
+		return report
+	}
+	report.Error = err.Error()
+	var layoutErr *LayoutError
+	if errors.As(err, &layoutErr) {
+		report.Selector = layoutErr.Selector
+		report.Row = layoutErr.Row
+		report.Found = layoutErr.Found
+		// Rows before the failed one are parsed.
+		if layoutErr.Row > 0 {
+			report.Rows = layoutErr.Row
+		}
+	}
+	return report
+}
+
+// saveDiagnostics saves the page and the report of its failed extraction into opts.DiagnosticsDir
+// as <time>-<source>.html and .json and returns the path of the page.
+func saveDiagnostics(opts Options, report *Report, page []byte) (string, error) {
+	name := filepath.Join(opts.DiagnosticsDir, report.Time.Format("20060102-150405")+"-"+opts.source().String())
+	report.HTMLPath = name + ".html"
+	if err := writeFileAtomic(report.HTMLPath, page); err != nil {
+		return "", err
+	}
+	// Found is HTML, so it is kept readable.
+	var data bytes.Buffer
+	encoder := json.NewEncoder(&data)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(report); err != nil {
+		return "", err
+	}
+	return report.HTMLPath, writeFileAtomic(name+".json", data.Bytes())
+}
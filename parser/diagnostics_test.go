@@ -0,0 +1,111 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDiagnose(t *testing.T) {
+	now := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	opts := Options{Clock: FixedClock(now), Location: time.UTC, Logger: log.New(ioutil.Discard, "", 0)}
+	tests := []struct {
+		page     string
+		err      error
+		selector string
+		row      int
+		// found is a part of Found.
+		found  string
+		cells  int
+		rows   int
+		winner string
+	}{
+		{"index.html", nil, "", -1, "", 100, 100, "MX Linux"},
+		{"no-phr3.html", ErrNoHPDCells, "td.phr3", -1, `"Just a moment..."`, 0, 0, ""},
+		{"missing-img.html", ErrBadTrend, "td.phr3 > img", 3, `<td class="phr3" title="Yesterday: 2890">2895</td>`, 100, 3, ""},
+		{"wrong-sibling.html", ErrBadLayout, "td.phr2", 1, `<td class="Sponsor">`, 100, 1, ""},
+	}
+	for _, test := range tests {
+		page, err := ioutil.ReadFile(filepath.Join("testdata", test.page))
+		if err != nil {
+			t.Fatal(err)
+		}
+		report, err := Diagnose(page, opts)
+		if err != nil {
+			t.Fatalf("%s: %v", test.page, err)
+		}
+		if !report.Time.Equal(now) {
+			t.Errorf("%s: report time is %s, want %s", test.page, report.Time, now)
+		}
+		if test.err == nil && report.Error != "" {
+			t.Errorf("%s: error %q, want none", test.page, report.Error)
+		} else if test.err != nil && !strings.HasPrefix(report.Error, test.err.Error()+": ") {
+			t.Errorf("%s: error %q, want %q", test.page, report.Error, test.err)
+		}
+		if report.Selector != test.selector || report.Row != test.row {
+			t.Errorf("%s: selector %q in row %d, want %q in row %d", test.page, report.Selector, report.Row, test.selector, test.row)
+		}
+		if !strings.Contains(report.Found, test.found) {
+			t.Errorf("%s: found %q, want it to contain %q", test.page, report.Found, test.found)
+		}
+		if report.Cells != test.cells || report.Rows != test.rows || report.Winner != test.winner {
+			t.Errorf("%s: %d cells, %d rows, winner %q, want %d cells, %d rows, winner %q",
+				test.page, report.Cells, report.Rows, report.Winner, test.cells, test.rows, test.winner)
+		}
+	}
+}
+
+func TestFetchOutcomeDiagnostics(t *testing.T) {
+	fixtures, err := ioutil.TempDir("", "distrowatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(fixtures)
+	page, err := ioutil.ReadFile(filepath.Join("testdata", "missing-img.html"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(fixtures, "index.html"), page, 0644); err != nil {
+		t.Fatal(err)
+	}
+	diagnostics := filepath.Join(fixtures, "diagnostics")
+	now := time.Date(2024, 3, 1, 8, 0, 0, 0, time.UTC)
+	opts := Options{FixturesDir: fixtures, Retries: -1, Clock: FixedClock(now), Location: time.UTC, Logger: log.New(ioutil.Discard, "", 0)}
+
+	// Nothing is saved without DiagnosticsDir, as in dry runs.
+	if _, err := FetchOutcome(context.Background(), opts); !errors.Is(err, ErrBadTrend) || strings.Contains(err.Error(), "saved") {
+		t.Errorf("FetchOutcome without DiagnosticsDir returned %v, want %v", err, ErrBadTrend)
+	}
+	if files, _ := ioutil.ReadDir(fixtures); len(files) != 1 {
+		t.Errorf("FetchOutcome without DiagnosticsDir wrote %d files, want none", len(files)-1)
+	}
+
+	opts.DiagnosticsDir = diagnostics
+	_, err = FetchOutcome(context.Background(), opts)
+	if !errors.Is(err, ErrBadTrend) {
+		t.Errorf("FetchOutcome returned %v, want %v", err, ErrBadTrend)
+	}
+	name := filepath.Join(diagnostics, "20240301-080000-default")
+	if err == nil || !strings.HasSuffix(err.Error(), "(the page is saved to "+name+".html)") {
+		t.Errorf("FetchOutcome returned %v, want the path of the saved page", err)
+	}
+	saved, err := ioutil.ReadFile(name + ".html")
+	if err != nil || string(saved) != string(page) {
+		t.Errorf("the saved page differs from the fetched one: %v", err)
+	}
+	report, err := ioutil.ReadFile(name + ".json")
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{`"selector": "td.phr3 > img"`, `"row": 3`, `"url": "https://distrowatch.com/"`} {
+		if !strings.Contains(string(report), want) {
+			t.Errorf("the report has no %s:\n%s", want, report)
+		}
+	}
+}
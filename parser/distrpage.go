@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"strings"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// infoListSeparator joins multiple values of a field in the table distr_info.
+const infoListSeparator = ", "
+
+// DistrInfo describes a distribution as it is given on its page.
+// Fields missing on the page are empty.
+type DistrInfo struct {
+	OSType        string   `json:"osType"`
+	BasedOn       []string `json:"basedOn"`
+	Origin        string   `json:"origin"`
+	Architectures []string `json:"architectures"`
+	Desktops      []string `json:"desktops"`
+	Categories    []string `json:"categories"`
+	Status        string   `json:"status"`
+	LatestVersion string   `json:"latestVersion"`
+}
+
+// DistrPage is the parsed page of a distribution.
+type DistrPage struct {
+	URL           string
+	FetchedAt     time.Time
+	ScreenshotURL string
+	Info          DistrInfo
+}
+
+// FetchDistrPage fetches the distr page and parses the screenshot url and details of the distribution.
+func FetchDistrPage(ctx context.Context, opts Options, distrURL string) (DistrPage, error) {
+	ctx, cancel := opts.withTimeout(ctx)
+	defer cancel()
+	return fetchDistrPage(ctx, opts, distrURL)
+}
+
+func fetchDistrPage(ctx context.Context, opts Options, distrURL string) (DistrPage, error) {
+	root, err := getDocument(ctx, opts, distrURL)
+	if err != nil {
+		return DistrPage{}, err
+	}
+	page := DistrPage{URL: distrURL, FetchedAt: opts.now(), Info: parseDistrInfo(root)}
+	page.ScreenshotURL, err = parseScreenshotURL(root, opts, distrURL)
+	return page, err
+}
+
+// parseDistrInfo parses the list under the screenshot like
+//
+//	<li><b>Based on:</b> <a href="...">Debian</a>, <a href="...">Ubuntu</a></li>
+//
+// and the latest version in the header of the table of releases.
+func parseDistrInfo(root *goquery.Document) DistrInfo {
+	var info DistrInfo
+	root.Find("td.TablesTitle li").Each(func(i int, li *goquery.Selection) {
+		b := li.Find("b").First()
+		label := strings.TrimSuffix(strings.TrimSpace(b.Text()), ":")
+		var values []string
+		li.Find("a").Each(func(i int, a *goquery.Selection) {
+			if value := strings.TrimSpace(a.Text()); value != "" {
+				values = append(values, value)
+			}
+		})
+		if len(values) == 0 {
+			value := strings.TrimSpace(strings.TrimPrefix(li.Text(), b.Text()))
+			if value != "" {
+				values = append(values, value)
+			}
+		}
+		if len(values) == 0 {
+			return
+		}
+		switch label {
+		case "OS Type":
+			info.OSType = values[0]
+		case "Based on":
+			info.BasedOn = values
+		case "Origin":
+			info.Origin = values[0]
+		case "Architecture":
+			info.Architectures = values
+		case "Desktop":
+			info.Desktops = values
+		case "Category":
+			info.Categories = values
+		case "Status":
+			info.Status = values[0]
+		}
+	})
+	root.Find("th.TablesInvert").EachWithBreak(func(i int, th *goquery.Selection) bool {
+		version := strings.TrimSpace(th.Text())
+		if version == "" || version == "Feature" {
+			return true
+		}
+		info.LatestVersion = version
+		return false
+	})
+	return info
+}
+
+// RecordDistrInfo appends details of the outcome's distribution to the history in the table distr_info
+// and finishes StepInfo at now.
+func RecordDistrInfo(ctx context.Context, db *sql.DB, outcome Outcome, page DistrPage, now time.Time) error {
+	return inTx(ctx, db, func(tx *sql.Tx) error {
+		if err := recordDistrInfo(ctx, tx, outcome, page); err != nil {
+			return err
+		}
+		return recordStep(ctx, tx, outcome.Source.String(), outcome.Date.Format(timeLayout), StepInfo, now)
+	})
+}
+
+func recordDistrInfo(ctx context.Context, e execer, outcome Outcome, page DistrPage) error {
+	info := page.Info
+	_, err := e.ExecContext(ctx, "INSERT INTO distr_info (name, date, fetched_at, url, os_type, based_on, origin, architecture, desktop, category, status, latest_version) VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)",
+		outcome.DistrName, outcome.Date.Format(timeLayout), page.FetchedAt.Unix(), page.URL,
+		info.OSType, strings.Join(info.BasedOn, infoListSeparator), info.Origin, strings.Join(info.Architectures, infoListSeparator),
+		strings.Join(info.Desktops, infoListSeparator), strings.Join(info.Categories, infoListSeparator), info.Status, info.LatestVersion)
+	return err
+}
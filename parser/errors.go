@@ -0,0 +1,53 @@
+package parser
+
+import (
+	"errors"
+	"fmt"
+)
+
+// Sentinel errors returned by the parser. They are usually wrapped with
+// details about the place where the error occurred, so use errors.Is to check them.
+var (
+	// ErrNoHPDCells means that the main page has no tds with class phr3.
+	ErrNoHPDCells = errors.New("there is no tds with class phr3")
+	// ErrNoEqualDistr means that no distribution has img.alt == '='.
+	ErrNoEqualDistr = errors.New("could not find distribution with img.alt == '='")
+	// ErrNoWinner means that a strategy could not select a distribution.
+	ErrNoWinner = errors.New("could not select distribution")
+	// ErrMalformedHref means that an expected link or its href is missing.
+	ErrMalformedHref = errors.New("malformed href")
+	// ErrBadTrend means that a trend img is missing or has an unexpected alt.
+	ErrBadTrend = errors.New("bad trend img")
+	// ErrBadHPD means that a hits per day cell does not contain a number.
+	ErrBadHPD = errors.New("bad hits per day")
+	// ErrBadLayout means that the page layout differs from the expected one.
+	ErrBadLayout = errors.New("unexpected page layout")
+	// ErrAlreadyUpdated means that the database has been already updated for the day.
+	ErrAlreadyUpdated = errors.New("database is already updated for the day")
+	// ErrNothingToUndo means that the database has not been updated for the day.
+	ErrNothingToUndo = errors.New("database is not updated for the day")
+	// ErrNotLatestDay means that the day to undo is followed by other days, whose coordinates depend on it.
+	ErrNotLatestDay = errors.New("only the latest day can be undone")
+	// ErrStaleRanking means that the ranking is identical to the one of the previous day,
+	// i.e. DistroWatch has not refreshed it yet.
+	ErrStaleRanking = errors.New("ranking is identical to the previous day's one")
+	// ErrStatus means that a response has status code other than 200.
+	ErrStatus = errors.New("status code error")
+)
+
+// StatusError describes a response with status code other than 200.
+// It matches ErrStatus with errors.Is.
+type StatusError struct {
+	URL        string
+	StatusCode int
+	Status     string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("status code error: %s: %s", e.URL, e.Status)
+}
+
+// Is reports whether target is ErrStatus.
+func (e *StatusError) Is(target error) bool {
+	return target == ErrStatus
+}
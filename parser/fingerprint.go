@@ -0,0 +1,67 @@
+package parser
+
+import (
+	"context"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"fmt"
+)
+
+// Fingerprint returns SHA-256 of names, hits per day and trends of the ranking in order.
+// Equal fingerprints of two days mean that the ranking has not been refreshed.
+func Fingerprint(ranking []Row) string {
+	hash := sha256.New()
+	for _, row := range ranking {
+		fmt.Fprintf(hash, "%s\t%d\t%d\n", row.Name, row.HPD, row.Trend)
+	}
+	return hex.EncodeToString(hash.Sum(nil))
+}
+
+// previousFingerprint returns the date and the fingerprint of the ranking of the last day of the source before date,
+// empty strings if there is no such day. Fingerprints of days recorded before they were stored are computed
+// from rankings_daily, they are empty if the ranking is not recorded either.
+func previousFingerprint(ctx context.Context, q queryer, source, date string) (string, string, error) {
+	var prevDate, fingerprint string
+	err := q.QueryRowContext(ctx, "SELECT date, fingerprint FROM distrs_daily WHERE source = ? AND date < ? ORDER BY date DESC LIMIT 1", source, date).Scan(&prevDate, &fingerprint)
+	if err == sql.ErrNoRows {
+		return "", "", nil
+	}
+	if err != nil || fingerprint != "" {
+		return prevDate, fingerprint, err
+	}
+
+	rows, err := q.QueryContext(ctx, "SELECT name, hpd, trend FROM rankings_daily WHERE source = ? AND date = ? ORDER BY rank", source, prevDate)
+	if err != nil {
+		return "", "", err
+	}
+	defer rows.Close()
+	var ranking []Row
+	for rows.Next() {
+		var row Row
+		if err := rows.Scan(&row.Name, &row.HPD, &row.Trend); err != nil {
+			return "", "", err
+		}
+		ranking = append(ranking, row)
+	}
+	if err := rows.Err(); err != nil || len(ranking) == 0 {
+		return prevDate, "", err
+	}
+	return prevDate, Fingerprint(ranking), nil
+}
+
+// CheckStale returns ErrStaleRanking if the ranking of the outcome is identical to the ranking
+// of the previous day of its source, unless the source is final on the date (see Source.Final).
+func CheckStale(ctx context.Context, db *sql.DB, outcome Outcome) error {
+	if outcome.Source.Final(outcome.Date) {
+		return nil
+	}
+	prevDate, fingerprint, err := previousFingerprint(ctx, db, outcome.Source.String(), outcome.Date.Format(timeLayout))
+	if err != nil {
+		return err
+	}
+	if fingerprint != "" && fingerprint == Fingerprint(outcome.Ranking) {
+		return fmt.Errorf("%w: source %s, %s and %s have fingerprint %.12s", ErrStaleRanking, outcome.Source, prevDate, outcome.Date.Format(timeLayout), fingerprint)
+	}
+	return nil
+}
@@ -0,0 +1,68 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestCheckStale(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	year := Source{Name: "2023", DataSpan: "2023"}
+	date := time.Date(2023, 12, 30, 0, 0, 0, 0, time.UTC)
+	for _, source := range []Source{DefaultSource, year} {
+		outcome := testOutcome(date, "Alpha", "Beta", "Gamma")
+		outcome.Source = source
+		if err := Apply(ctx, db, outcome, date); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	changed := func(f func(ranking []Row)) []Row {
+		ranking := testOutcome(date, "Alpha", "Beta", "Gamma").Ranking
+		f(ranking)
+		return ranking
+	}
+	tests := []struct {
+		name    string
+		source  Source
+		date    time.Time
+		ranking []Row
+		stale   bool
+	}{
+		{"identical", DefaultSource, date.AddDate(0, 0, 1), changed(func([]Row) {}), true},
+		{"changed HPD", DefaultSource, date.AddDate(0, 0, 1), changed(func(r []Row) { r[2].HPD++ }), false},
+		{"changed trend", DefaultSource, date.AddDate(0, 0, 1), changed(func(r []Row) { r[1].Trend = 1 }), false},
+		{"changed order", DefaultSource, date.AddDate(0, 0, 1), changed(func(r []Row) { r[1].Name, r[2].Name = r[2].Name, r[1].Name }), false},
+		{"fewer rows", DefaultSource, date.AddDate(0, 0, 1), changed(func([]Row) {})[:2], false},
+		{"first day", Source{Name: "1m", DataSpan: "4"}, date.AddDate(0, 0, 1), changed(func([]Row) {}), false},
+		// The table of 2023 may change until the year is over.
+		{"identical year in the year", year, date.AddDate(0, 0, 1), changed(func([]Row) {}), true},
+		{"identical past year", year, date.AddDate(0, 0, 2), changed(func([]Row) {}), false},
+		{"identical past year later", year, date.AddDate(1, 0, 0), changed(func([]Row) {}), false},
+	}
+	for _, test := range tests {
+		outcome := testOutcome(test.date, "Alpha")
+		outcome.Source = test.source
+		outcome.Ranking = test.ranking
+		err := CheckStale(ctx, db, outcome)
+		if test.stale && !errors.Is(err, ErrStaleRanking) {
+			t.Errorf("%s: CheckStale returned %v, want %v", test.name, err, ErrStaleRanking)
+		} else if !test.stale && err != nil {
+			t.Errorf("%s: CheckStale returned %v, want nil", test.name, err)
+		}
+	}
+
+	// The fingerprint of a day recorded before fingerprints were stored is computed from rankings_daily.
+	if _, err := db.Exec("UPDATE distrs_daily SET fingerprint = ''"); err != nil {
+		t.Fatal(err)
+	}
+	outcome := testOutcome(date.AddDate(0, 0, 1), "Alpha", "Beta", "Gamma")
+	if err := CheckStale(ctx, db, outcome); !errors.Is(err, ErrStaleRanking) {
+		t.Errorf("CheckStale without the stored fingerprint returned %v, want %v", err, ErrStaleRanking)
+	}
+}
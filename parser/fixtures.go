@@ -0,0 +1,60 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+)
+
+// FixtureTransport serves requests from files in Dir instead of network,
+// so that saved pages and images can be replayed offline.
+//
+// The file for a request is the URL path relative to Dir, "index.html" for paths ending with "/".
+// Non-empty query is appended after "?", so "/table.php?distribution=mint" is read from
+// the file "table.php?distribution=mint" as wget saves it. The host is ignored.
+type FixtureTransport struct {
+	Dir string
+}
+
+// RoundTrip implements http.RoundTripper.
+func (t *FixtureTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	name := request.URL.Path
+	if name == "" || strings.HasSuffix(name, "/") {
+		name += "index.html"
+	}
+	if request.URL.RawQuery != "" {
+		name += "?" + request.URL.RawQuery
+	}
+	name = filepath.Join(t.Dir, filepath.FromSlash(path.Clean("/"+name)))
+
+	response := &http.Response{
+		Proto:      "HTTP/1.1",
+		ProtoMajor: 1,
+		ProtoMinor: 1,
+		Header:     make(http.Header),
+		Request:    request,
+	}
+	data, err := ioutil.ReadFile(name)
+	if os.IsNotExist(err) {
+		response.StatusCode = http.StatusNotFound
+		response.Status = fmt.Sprintf("%d %s", http.StatusNotFound, http.StatusText(http.StatusNotFound))
+		response.Body = ioutil.NopCloser(strings.NewReader(""))
+		return response, nil
+	} else if err != nil {
+		return nil, err
+	}
+	response.StatusCode = http.StatusOK
+	response.Status = fmt.Sprintf("%d %s", http.StatusOK, http.StatusText(http.StatusOK))
+	response.ContentLength = int64(len(data))
+	if contentType := mime.TypeByExtension(path.Ext(request.URL.Path)); contentType != "" {
+		response.Header.Set("Content-Type", contentType)
+	}
+	response.Body = ioutil.NopCloser(bytes.NewReader(data))
+	return response, nil
+}
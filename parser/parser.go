@@ -1,13 +1,13 @@
-package main
+// Package parser scrapes the DistroWatch main page, picks the distribution of the day
+// and records the outcome in the database.
+package parser
 
 import (
-	"database/sql"
+	"bytes"
+	"context"
 	"fmt"
-	"io"
 	"log"
 	"net/http"
-	"os"
-	"path"
 	"strconv"
 	"strings"
 	"time"
@@ -17,309 +17,268 @@ import (
 )
 
 const (
-	baseURL          = "https://distrowatch.com/"
-	timeLayout       = "20060102"
-	userAgent        = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/106.0.0.0 Safari/537.36" // Brave 1.44.112
-	initialLatitude  = 60.0
-	initialLongitude = 30.0
-	divider          = 10000
+	// DefaultBaseURL is the address of the DistroWatch main page.
+	DefaultBaseURL = distrowatch.SiteURL
+	userAgent      = "Mozilla/5.0 (X11; Linux x86_64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/106.0.0.0 Safari/537.36" // Brave 1.44.112
+	// DistrCount is the expected number of distributions in the ranking table.
+	DistrCount = 100
 )
 
-var (
-	now         = time.Now()
-	today       = time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
-	todayYYMMDD = today.Format(timeLayout)
-	client      = &http.Client{}
-	distrCount  = 100
-)
-
-// Outcome stores outcome from baseURL.
-type Outcome struct {
-	distrName  string
-	distrURL   string
-	hpd        int
-	next1HPD   int
-	next1Trend int
-	next2HPD   int
-	next2Trend int
+// Options configures fetching and downloading.
+type Options struct {
+	// Client is used for HTTP requests. If nil, a client with RequestTimeout is used.
+	Client *http.Client
+	// RequestTimeout limits every single request attempt. DefaultRequestTimeout is used if 0.
+	RequestTimeout time.Duration
+	// Timeout limits FetchOutcome, FetchDistrPage and FetchScreenshot as a whole, including retries.
+	// DefaultTimeout is used if 0.
+	Timeout time.Duration
+	// Retries is the number of retries after a network error or 5xx response.
+	// Negative value disables retries. DefaultRetries is used if 0.
+	Retries int
+	// Backoff is the delay before the first retry, it doubles with every next retry
+	// up to MaxBackoff. DefaultBackoff and DefaultMaxBackoff are used if 0.
+	Backoff    time.Duration
+	MaxBackoff time.Duration
+	// Logger receives warnings. The standard logger is used if nil.
+	Logger *log.Logger
+	// Dir is directory where screenshots are stored. distrowatch.DistrsDir is used if empty.
+	Dir string
+	// BaseURL is the address of the main page. DefaultBaseURL is used if empty.
+	BaseURL string
+	// FixturesDir, if set, makes all requests served from saved files in the directory
+	// instead of network (see FixtureTransport). Client is ignored then.
+	FixturesDir string
+	// Strategy selects the distribution of the day. DefaultStrategy is used if nil.
+	Strategy Strategy
+	// Clock tells the current time. SystemClock is used if nil.
+	Clock Clock
+	// Location is the timezone of the DistroWatch day. time.Local is used if nil.
+	Location *time.Location
+	// Date, if set, overrides the day the outcome is counted for.
+	Date time.Time
+	// Retention is how long distrs stay in distrs without updates, DefaultRetention if zero.
+	Retention Retention
+	// Source is the ranking table to read, DefaultSource if zero.
+	Source Source
+	// AllowStale makes Update count the ranking even if it is identical to the previous day's one.
+	AllowStale bool
+	// DiagnosticsDir is directory where the main page and the report are saved if parsing fails.
+	// Nothing is saved if it is empty.
+	DiagnosticsDir string
 }
 
-func check(err error) {
-	if err != nil {
-		log.Fatal(err)
+func (o Options) strategy() Strategy {
+	if o.Strategy == nil {
+		return DefaultStrategy
 	}
+	return o.Strategy
 }
 
-func closeCheck(c io.Closer) {
-	err := c.Close()
-	check(err)
-}
-
-func checkResponse(response *http.Response) {
-	if response.StatusCode != 200 {
-		log.Fatalf("Status code error: %d %s", response.StatusCode, response.Status)
+func (o Options) logf(format string, args ...interface{}) {
+	if o.Logger == nil {
+		log.Printf(format, args...)
+	} else {
+		o.Logger.Printf(format, args...)
 	}
 }
 
-func getResponse(url string) *http.Response {
-	request, err := http.NewRequest("GET", url, nil)
-	check(err)
-	request.Header.Add("User-Agent", userAgent)
-	response, err := client.Do(request)
-	check(err)
-	return response
+// Outcome stores outcome from the main page.
+type Outcome struct {
+	// Date is the day the outcome is counted for.
+	Date       time.Time
+	DistrName  string
+	DistrURL   string
+	HPD        int
+	Next1HPD   int
+	Next1Trend int
+	Next2HPD   int
+	Next2Trend int
+	// Strategy is the name of the strategy which selected the distribution.
+	Strategy string
+	// Retention decides which distrs drop out on the date.
+	Retention Retention
+	// Source is the ranking table the outcome is read from.
+	Source Source
+	// Ranking is the whole ranking table.
+	Ranking []Row
 }
 
-func getOutcome() Outcome {
-	// Get main page
-	response := getResponse(baseURL)
-	defer closeCheck(response.Body)
-	checkResponse(response)
-
-	// Parse the page and fetch first distribution, hits per day of which didn't change since yesterday.
-	root, err := goquery.NewDocumentFromReader(response.Body)
-	check(err)
+// Row is a row of the ranking table.
+type Row struct {
+	Rank  int
+	Name  string
+	URL   string
+	HPD   int
+	Trend int // -1, 0 or 1
+}
 
-	hpdTds := root.Find("td.phr3") // HPD: Hits Per Day (Column header)
-	if hpdTds.Length() == 0 {
-		log.Fatal("There is no tds with class phr3.")
-	} else if hpdTds.Length() != distrCount {
-		log.Printf("WARNING: number of tds with HPD is not %d, just %d", distrCount, hpdTds.Length())
-		distrCount = hpdTds.Length()
+func (o Options) baseURL() string {
+	if o.BaseURL == "" {
+		return DefaultBaseURL
 	}
-
-	// Find first td which has img with alt="=" and fill outcome.
-	outcome := Outcome{}
-	var equalFound, next1Filled bool
-	hpdTds.EachWithBreak(func(index int, hpdTd *goquery.Selection) bool {
-		img := hpdTd.ChildrenFiltered("img").First()
-		// Every hpdTd must contain just one img.
-		if img.Length() == 0 {
-			log.Fatalf("td.phr3 with index %d has not an img.", index)
-		}
-		// Image must have the attribute 'alt'.
-		alt, ok := img.Attr("alt")
-		if !ok {
-			log.Fatalf("img in td.phr3 with index %d has not attribute 'alt'", index)
-		}
-
-		if equalFound {
-			hpd, err := strconv.Atoi(hpdTd.Text())
-			check(err)
-			var trend int
-			switch alt {
-			case "<":
-				trend = -1
-			case ">":
-				trend = 1
-			case "=":
-				trend = 0
-			default:
-				log.Fatalf("unexpected alt %s: td.phr3 with index %d", alt, index)
-			}
-			if !next1Filled {
-				outcome.next1HPD = hpd
-				outcome.next1Trend = trend
-				next1Filled = true
-			} else {
-				outcome.next2HPD = hpd
-				outcome.next2Trend = trend
-				return false
-			}
-			return true
-		}
-
-		if alt == "=" {
-			equalFound = true
-			hpd, err := strconv.Atoi(hpdTd.Text())
-			check(err)
-			outcome.hpd = hpd
-
-			distributionTd := hpdTd.Prev()
-			if !distributionTd.HasClass("phr2") {
-				log.Fatalf("td.phr3 with index %d has previous sibling (distributionTd) with class name != 'phr2'.", index)
-			}
-			a := distributionTd.ChildrenFiltered("a").First()
-			if a.Length() == 0 {
-				log.Fatalf("td.phr3 with index %d has not an 'a' in previous sibling.", index)
-			}
-			outcome.distrName = a.Text()
-			url, ok := a.Attr("href")
-			if !ok {
-				log.Fatalf("a in td.phr2 with index %d has not attribute 'href'", index)
-			}
-			if !strings.HasPrefix(url, "http") {
-				url = baseURL + url
-			}
-			outcome.distrURL = url
-		}
-		return true
-	})
-
-	if outcome.distrName == "" {
-		log.Fatal("Could not find distribution with img.alt == '='.")
+	if !strings.HasSuffix(o.BaseURL, "/") {
+		return o.BaseURL + "/"
 	}
-
-	return outcome
+	return o.BaseURL
 }
 
-// updateDb updates or inserts count of distribution name in database.
-func updateDb(db *sql.DB, outcome Outcome) {
-	tx, err := db.Begin()
-	check(err)
-	_, err = tx.Exec("INSERT OR IGNORE INTO distrs (name, count, last_update) VALUES (?, 0, ?)", outcome.distrName, todayYYMMDD)
-	check(err)
-	_, err = tx.Exec("UPDATE distrs SET count = count + 1, last_update = ? WHERE name = ?", todayYYMMDD, outcome.distrName)
-	check(err)
-	_, err = tx.Exec("INSERT INTO distrs_daily (date, name, hpd) VALUES (?, ?, ?)", todayYYMMDD, outcome.distrName, outcome.hpd)
-	check(err)
-
-	// Move distrs that have been updated over year ago to the table `dropout`.
-	todayYYMMDDint, err := strconv.Atoi(todayYYMMDD)
-	check(err)
-	yearAgoYYMMDDint := todayYYMMDDint - 10000
-	_, err = tx.Exec("INSERT INTO dropout (name, count, last_update, drop_date) SELECT name, count, last_update, CAST(strftime('%Y%m%d', 'now') AS int) FROM distrs WHERE last_update < ?", yearAgoYYMMDDint)
-	check(err)
-	_, err = tx.Exec("DELETE FROM distrs WHERE last_update < ?", yearAgoYYMMDDint)
-	check(err)
-
-	var latitude, longitude float64
-	err = db.QueryRow("SELECT latitude, longitude FROM coords ORDER BY date DESC LIMIT 1").Scan(&latitude, &longitude)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			latitude = initialLatitude
-			longitude = initialLongitude
-		} else {
-			log.Fatal(err)
-		}
+// absURL makes url absolute relative to the base URL.
+func (o Options) absURL(url string) string {
+	if !strings.HasPrefix(url, "http") {
+		url = o.baseURL() + strings.TrimPrefix(url, "/")
 	}
+	return url
+}
 
-	longitudeDiff := float64(outcome.next1HPD) / float64(divider)
-	longitudeTrend := outcome.next1Trend
-	latitudeDiff := float64(outcome.next2HPD) / float64(divider)
-	latitudeTrend := outcome.next2Trend
-	longitude += longitudeDiff * float64(longitudeTrend)
-	latitude += latitudeDiff * float64(latitudeTrend)
-
-	if latitude > 90 {
-		latitude = latitude - 180
+// parseTrend converts alt of the trend img into -1, 0 or 1.
+func parseTrend(alt string) (int, bool) {
+	switch alt {
+	case "<":
+		return -1, true
+	case ">":
+		return 1, true
+	case "=":
+		return 0, true
 	}
-	if longitude > 180.0 {
-		longitude = longitude - 360.0
-	}
-	_, err = tx.Exec("INSERT INTO coords (date, longitude_diff, longitude_trend, latitude_diff, latitude_trend, latitude, longitude) VALUES (?, ?, ?, ?, ?, ?, ?)", todayYYMMDD, fmt.Sprintf("%.4f", longitudeDiff), longitudeTrend, fmt.Sprintf("%.4f", latitudeDiff), latitudeTrend, fmt.Sprintf("%.4f", latitude), fmt.Sprintf("%.4f", longitude))
-	check(err)
-
-	err = tx.Commit()
-	check(err)
+	return 0, false
 }
 
-func downloadScreenshot(distrURL string) string {
-	// Get distr page
-	response := getResponse(distrURL)
-	defer closeCheck(response.Body)
-	checkResponse(response)
-
-	// Parse the page and fetch full url of screenshot.
-	root, err := goquery.NewDocumentFromReader(response.Body)
-	check(err)
-
-	a := root.Find("td.TablesTitle > a").First()
-	if a.Length() == 0 {
-		log.Fatalf("Could not find screenshot on page %s", distrURL)
+// FetchOutcome fetches the ranking table opts.Source and returns the distribution selected by opts.Strategy,
+// by default the first one, hits per day of which didn't change since yesterday,
+// along with two next distributions.
+// If parsing fails, the page and the Report are saved to opts.DiagnosticsDir unless it is empty.
+func FetchOutcome(ctx context.Context, opts Options) (Outcome, error) {
+	ctx, cancel := opts.withTimeout(ctx)
+	defer cancel()
+	url := opts.source().url(opts.baseURL())
+	page, err := getPage(ctx, opts, url)
+	if err != nil {
+		return Outcome{}, err
 	}
-	url, ok := a.Attr("href")
-	if !ok {
-		log.Fatalf("Screenshot a has not attribute 'href' on page %s", distrURL)
+	root, err := goquery.NewDocumentFromReader(bytes.NewReader(page))
+	if err != nil {
+		return Outcome{}, err
 	}
-	if !strings.HasPrefix(url, "http") {
-		url = baseURL + url
+	outcome, err := parseOutcome(root, opts)
+	if err != nil && opts.DiagnosticsDir != "" {
+		report := newReport(root, opts, outcome, err)
+		report.URL = url
+		path, saveErr := saveDiagnostics(opts, report, page)
+		if saveErr != nil {
+			opts.logf("WARNING: could not save diagnostics: %v", saveErr)
+			return Outcome{}, err
+		}
+		return Outcome{}, fmt.Errorf("%w (the page is saved to %s)", err, path)
 	}
-	base := path.Base(url)
-	screenshotPath := path.Join(distrowatch.DistrsDir, base)
+	return outcome, err
+}
 
-	// Download screenshot
-	output, err := os.Create(screenshotPath)
+func parseOutcome(root *goquery.Document, opts Options) (Outcome, error) {
+	ranking, err := parseRanking(root, opts)
 	if err != nil {
-		log.Fatalf("Could not create file %s, err: %s", screenshotPath, err)
+		return Outcome{}, err
 	}
-	defer closeCheck(output)
-
-	response = getResponse(url)
-	defer closeCheck(response.Body)
-	checkResponse(response)
 
-	_, err = io.Copy(output, response.Body)
+	// Select the distribution and fill outcome with it and two next rows.
+	strategy := opts.strategy()
+	date := opts.Today()
+	i, err := strategy.Select(ranking, date)
 	if err != nil {
-		log.Fatalf("Could not write image %s to file %s, err: %s", url, screenshotPath, err)
+		return Outcome{}, err
+	}
+	row := ranking[i]
+	outcome := Outcome{
+		Date:      date,
+		DistrName: row.Name,
+		DistrURL:  row.URL,
+		HPD:       row.HPD,
+		Strategy:  strategy.Name(),
+		Retention: opts.Retention,
+		Source:    opts.source(),
+		Ranking:   ranking,
+	}
+	if i+1 < len(ranking) {
+		outcome.Next1HPD = ranking[i+1].HPD
+		outcome.Next1Trend = ranking[i+1].Trend
+	}
+	if i+2 < len(ranking) {
+		outcome.Next2HPD = ranking[i+2].HPD
+		outcome.Next2Trend = ranking[i+2].Trend
 	}
 
-	return screenshotPath
+	return outcome, nil
 }
 
-func main() {
-	// Create directory if it doesn't exist.
-	_, err := os.Stat(distrowatch.DistrsDir)
-	if os.IsNotExist(err) {
-		err = os.MkdirAll(distrowatch.DistrsDir, 0755)
-		check(err)
+// parseRanking parses every row of the ranking table.
+func parseRanking(root *goquery.Document, opts Options) ([]Row, error) {
+	hpdTds := root.Find("td.phr3") // HPD: Hits Per Day (Column header)
+	if hpdTds.Length() == 0 {
+		return nil, &LayoutError{Err: ErrNoHPDCells, Selector: "td.phr3", Row: -1, Found: fmt.Sprintf("page with title %q", strings.TrimSpace(root.Find("title").Text())), Message: "the ranking table is not found"}
+	} else if hpdTds.Length() != DistrCount {
+		opts.logf("WARNING: number of tds with HPD is not %d, just %d", DistrCount, hpdTds.Length())
 	}
 
-	// Open database and create tables if they don't exist.
-	db, err := distrowatch.GetDB()
-	check(err)
-	defer closeCheck(db)
-	var answer string
-	err = db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name='distrs'").Scan(&answer)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			_, err = db.Exec("CREATE TABLE 'distrs' (`name` TEXT NOT NULL UNIQUE, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL UNIQUE, PRIMARY KEY(`name`))")
-			check(err)
-		} else {
-			log.Fatal(err)
+	ranking := make([]Row, 0, hpdTds.Length())
+	var parseErr error
+	hpdTds.EachWithBreak(func(index int, hpdTd *goquery.Selection) bool {
+		img := hpdTd.ChildrenFiltered("img").First()
+		// Every hpdTd must contain just one img.
+		if img.Length() == 0 {
+			parseErr = &LayoutError{Err: ErrBadTrend, Selector: "td.phr3 > img", Row: index, Found: outerHTML(hpdTd),
+				Message: fmt.Sprintf("td.phr3 with index %d has not an img", index)}
+			return false
 		}
-	}
-	err = db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name='coords'").Scan(&answer)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			_, err = db.Exec("CREATE TABLE 'coords' (`date` INTEGER NOT NULL UNIQUE, `longitude_diff` FLOAT, `longitude_trend` INTEGER, `latitude_diff` FLOAT, `latitude_trend` INTEGER, `latitude` FLOAT NOT NULL, `longitude` FLOAT NOT NULL, PRIMARY KEY(`date`))")
-			check(err)
-		} else {
-			log.Fatal(err)
+		// Image must have the attribute 'alt'.
+		alt, ok := img.Attr("alt")
+		if !ok {
+			parseErr = &LayoutError{Err: ErrBadTrend, Selector: "td.phr3 > img[alt]", Row: index, Found: outerHTML(img),
+				Message: fmt.Sprintf("img in td.phr3 with index %d has not attribute 'alt'", index)}
+			return false
 		}
-	}
-	err = db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name='dropout'").Scan(&answer)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			_, err = db.Exec("CREATE TABLE 'dropout' (`name` TEXT NOT NULL, `count` INTEGER NOT NULL, `last_update` INTEGER NOT NULL UNIQUE, `drop_date` INTEGER NOT NULL, PRIMARY KEY(`last_update`))")
-			check(err)
-		} else {
-			log.Fatal(err)
+		trend, ok := parseTrend(alt)
+		if !ok {
+			parseErr = &LayoutError{Err: ErrBadTrend, Selector: "td.phr3 > img[alt]", Row: index, Found: alt,
+				Message: fmt.Sprintf("unexpected alt %s: td.phr3 with index %d", alt, index)}
+			return false
 		}
-	}
-	err = db.QueryRow("SELECT name FROM sqlite_master WHERE type='table' AND name='distrs_daily'").Scan(&answer)
-	if err != nil {
-		if err == sql.ErrNoRows {
-			_, err = db.Exec("CREATE TABLE 'distrs_daily' (`date` INTEGER NOT NULL UNIQUE, `name` TEXT NOT NULL, `hpd` INTEGER NOT NULL, PRIMARY KEY(`date`))")
-			check(err)
-		} else {
-			log.Fatal(err)
+		hpd, err := strconv.Atoi(strings.TrimSpace(hpdTd.Text()))
+		if err != nil {
+			parseErr = &LayoutError{Err: ErrBadHPD, Selector: "td.phr3", Row: index, Found: outerHTML(hpdTd),
+				Message: fmt.Sprintf("td.phr3 with index %d: %v", index, err)}
+			return false
 		}
-	}
-	_ = answer
 
-	// If date of last_update is today, exit.
-	var lastUpdate string
-	err = db.QueryRow("SELECT last_update FROM distrs ORDER BY last_update DESC LIMIT 1").Scan(&lastUpdate)
-	if err != nil && err != sql.ErrNoRows {
-		log.Fatal(err)
-	}
-	if lastUpdate == todayYYMMDD {
-		fmt.Println("Database is already updated today.")
-		os.Exit(0)
-	}
+		distributionTd := hpdTd.Prev()
+		if !distributionTd.HasClass("phr2") {
+			parseErr = &LayoutError{Err: ErrBadLayout, Selector: "td.phr2", Row: index, Found: outerHTML(distributionTd),
+				Message: fmt.Sprintf("td.phr3 with index %d has previous sibling (distributionTd) with class name != 'phr2'", index)}
+			return false
+		}
+		a := distributionTd.ChildrenFiltered("a").First()
+		if a.Length() == 0 {
+			parseErr = &LayoutError{Err: ErrMalformedHref, Selector: "td.phr2 > a", Row: index, Found: outerHTML(distributionTd),
+				Message: fmt.Sprintf("td.phr3 with index %d has not an 'a' in previous sibling", index)}
+			return false
+		}
+		url, ok := a.Attr("href")
+		if !ok {
+			parseErr = &LayoutError{Err: ErrMalformedHref, Selector: "td.phr2 > a[href]", Row: index, Found: outerHTML(a),
+				Message: fmt.Sprintf("a in td.phr2 with index %d has not attribute 'href'", index)}
+			return false
+		}
 
-	outcome := getOutcome()
-	updateDb(db, outcome)
-	_ = downloadScreenshot(outcome.distrURL)
+		ranking = append(ranking, Row{
+			Rank:  index + 1,
+			Name:  a.Text(),
+			URL:   opts.absURL(url),
+			HPD:   hpd,
+			Trend: trend,
+		})
+		return true
+	})
+	if parseErr != nil {
+		return nil, parseErr
+	}
+	return ranking, nil
 }
@@ -0,0 +1,197 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"strconv"
+	"time"
+
+	"github.com/andbar-ru/distrowatch/geo"
+)
+
+// queryer is implemented by *sql.DB and *sql.Tx.
+type queryer interface {
+	QueryContext(ctx context.Context, query string, args ...interface{}) (*sql.Rows, error)
+	QueryRowContext(ctx context.Context, query string, args ...interface{}) *sql.Row
+}
+
+// DroppedDistr is a stint of a distribution which is moved to dropout or has been moved there before.
+type DroppedDistr struct {
+	// ID is the id in the table dropout, 0 if the stint is not moved there yet.
+	ID    int64  `json:"id,omitempty"`
+	Name  string `json:"name"`
+	Slug  string `json:"slug"`
+	Count int    `json:"count"`
+	// FirstUpdate is 0 if unknown.
+	FirstUpdate int `json:"firstUpdate,omitempty"`
+	LastUpdate  int `json:"lastUpdate"`
+	// DropDate is 0 if the stint is not moved to dropout yet.
+	DropDate int `json:"dropDate,omitempty"`
+	Stint    int `json:"stint"`
+	// PreviousID is the id of the previous stint in the table dropout, 0 if there is none.
+	PreviousID int64 `json:"previousID,omitempty"`
+}
+
+// Plan describes what Apply does with the outcome.
+type Plan struct {
+	Source     string `json:"source"`
+	Date       string `json:"date"`
+	DistrName  string `json:"distrName"`
+	DistrURL   string `json:"distrURL"`
+	Slug       string `json:"slug"`
+	HPD        int    `json:"hpd"`
+	Next1HPD   int    `json:"next1HPD"`
+	Next1Trend int    `json:"next1Trend"`
+	Next2HPD   int    `json:"next2HPD"`
+	Next2Trend int    `json:"next2Trend"`
+	Strategy   string `json:"strategy"`
+	Retention  string `json:"retention"`
+
+	// Fingerprint identifies the ranking, see Fingerprint.
+	Fingerprint string `json:"fingerprint"`
+	// StaleSince is the previous day if its ranking is identical, i.e. the ranking is not refreshed.
+	// It is empty for final sources, see Source.Final.
+	StaleSince string `json:"staleSince,omitempty"`
+
+	// Stint is the number of the winner's stint in distrs, see DroppedDistr.
+	Stint int `json:"stint"`
+	// ReactivatedFrom is the previous stint if the winner comes back from dropout.
+	ReactivatedFrom *DroppedDistr `json:"reactivatedFrom,omitempty"`
+
+	PrevLatitude   float64 `json:"prevLatitude"`
+	PrevLongitude  float64 `json:"prevLongitude"`
+	LongitudeDiff  float64 `json:"longitudeDiff"`
+	LongitudeTrend int     `json:"longitudeTrend"`
+	LatitudeDiff   float64 `json:"latitudeDiff"`
+	LatitudeTrend  int     `json:"latitudeTrend"`
+	Latitude       float64 `json:"latitude"`
+	Longitude      float64 `json:"longitude"`
+	// Place is where the new coordinates are.
+	Place geo.Place `json:"place"`
+
+	// Dropout lists distrs which are moved to dropout.
+	Dropout []DroppedDistr `json:"dropout"`
+	// ScreenshotURL and Info are filled by the caller, see FetchDistrPage.
+	ScreenshotURL string     `json:"screenshotURL,omitempty"`
+	Info          *DistrInfo `json:"info,omitempty"`
+}
+
+// MakePlan returns what Apply would do with the outcome without changing the database.
+func MakePlan(ctx context.Context, db *sql.DB, outcome Outcome) (*Plan, error) {
+	return makePlan(ctx, db, outcome)
+}
+
+func makePlan(ctx context.Context, q queryer, outcome Outcome) (*Plan, error) {
+	plan := &Plan{
+		Source:     outcome.Source.String(),
+		Date:       outcome.Date.Format(timeLayout),
+		DistrName:  outcome.DistrName,
+		DistrURL:   outcome.DistrURL,
+		HPD:        outcome.HPD,
+		Next1HPD:   outcome.Next1HPD,
+		Next1Trend: outcome.Next1Trend,
+		Next2HPD:   outcome.Next2HPD,
+		Next2Trend: outcome.Next2Trend,
+		Strategy:   outcome.Strategy,
+		Retention:  outcome.Retention.String(),
+		Dropout:    make([]DroppedDistr, 0),
+	}
+	plan.Slug = Slug(outcome.DistrURL)
+	plan.Fingerprint = Fingerprint(outcome.Ranking)
+	prevDate, prevFingerprint, err := previousFingerprint(ctx, q, plan.Source, plan.Date)
+	if err != nil {
+		return nil, err
+	}
+	if prevFingerprint == plan.Fingerprint && !outcome.Source.Final(outcome.Date) {
+		plan.StaleSince = prevDate
+	}
+	name, err := resolveName(ctx, q, outcome.DistrName, plan.Slug)
+	if err != nil {
+		return nil, err
+	}
+	plan.DistrName = name
+
+	err = q.QueryRowContext(ctx, "SELECT stint FROM distrs WHERE source = ? AND name = ?", plan.Source, plan.DistrName).Scan(&plan.Stint)
+	if err == sql.ErrNoRows {
+		// The winner comes to distrs, maybe back from dropout.
+		plan.ReactivatedFrom, err = lastStint(ctx, q, plan.Source, plan.DistrName)
+		if err != nil {
+			return nil, err
+		}
+		plan.Stint = 1
+		if plan.ReactivatedFrom != nil {
+			plan.Stint = plan.ReactivatedFrom.Stint + 1
+		}
+	} else if err != nil {
+		return nil, err
+	}
+
+	// Distrs that have not been updated within the retention period, except the winner which is updated today.
+	if cutoff, ok := outcome.Retention.Cutoff(outcome.Date); ok {
+		plan.Dropout, err = dropoutBefore(ctx, q, plan.Source, cutoff, plan.DistrName)
+		if err != nil {
+			return nil, err
+		}
+		for i := range plan.Dropout {
+			plan.Dropout[i].DropDate, _ = strconv.Atoi(plan.Date)
+		}
+	}
+
+	prev := geo.Initial
+	err = q.QueryRowContext(ctx, "SELECT latitude, longitude FROM coords WHERE source = ? AND date < ? ORDER BY date DESC LIMIT 1", plan.Source, plan.Date).Scan(&prev.Latitude, &prev.Longitude)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	plan.PrevLatitude = prev.Latitude
+	plan.PrevLongitude = prev.Longitude
+
+	plan.LongitudeDiff = float64(outcome.Next1HPD) / float64(divider)
+	plan.LongitudeTrend = outcome.Next1Trend
+	plan.LatitudeDiff = float64(outcome.Next2HPD) / float64(divider)
+	plan.LatitudeTrend = outcome.Next2Trend
+	next := stepCoords(prev, plan.LongitudeDiff, plan.LongitudeTrend, plan.LatitudeDiff, plan.LatitudeTrend)
+	plan.Latitude = next.Latitude
+	plan.Longitude = next.Longitude
+	plan.Place = geo.ReverseGeocode(next)
+
+	return plan, nil
+}
+
+// lastStint returns the latest stint of the distr in dropout of the source, nil if the distr has never dropped out.
+func lastStint(ctx context.Context, q queryer, source, name string) (*DroppedDistr, error) {
+	distr := new(DroppedDistr)
+	err := q.QueryRowContext(ctx, "SELECT id, name, slug, count, COALESCE(first_update, 0), last_update, drop_date, stint, COALESCE(previous_id, 0) FROM dropout WHERE source = ? AND name = ? ORDER BY stint DESC LIMIT 1", source, name).
+		Scan(&distr.ID, &distr.Name, &distr.Slug, &distr.Count, &distr.FirstUpdate, &distr.LastUpdate, &distr.DropDate, &distr.Stint, &distr.PreviousID)
+	if err == sql.ErrNoRows {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	return distr, nil
+}
+
+// dropoutBefore returns distrs of the source last updated before cutoff except the distr named except.
+func dropoutBefore(ctx context.Context, q queryer, source string, cutoff time.Time, except string) ([]DroppedDistr, error) {
+	return queryStints(ctx, q, source, "AND last_update < CAST(? AS INTEGER) AND name != ? ORDER BY last_update", cutoff.Format(timeLayout), except)
+}
+
+// queryStints returns current stints of distrs of the source selected with the condition
+// which continues "WHERE source = ?".
+func queryStints(ctx context.Context, q queryer, source, condition string, args ...interface{}) ([]DroppedDistr, error) {
+	args = append([]interface{}{source}, args...)
+	rows, err := q.QueryContext(ctx, "SELECT name, slug, count, COALESCE(first_update, 0), last_update, stint, COALESCE(reactivated_from, 0) FROM distrs WHERE source = ? "+condition, args...)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	distrs := make([]DroppedDistr, 0)
+	for rows.Next() {
+		var distr DroppedDistr
+		if err := rows.Scan(&distr.Name, &distr.Slug, &distr.Count, &distr.FirstUpdate, &distr.LastUpdate, &distr.Stint, &distr.PreviousID); err != nil {
+			return nil, err
+		}
+		distrs = append(distrs, distr)
+	}
+	return distrs, rows.Err()
+}
@@ -0,0 +1,131 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Retention is how long a distr stays in distrs after its last update before it drops out.
+// The zero Retention is DefaultRetention.
+type Retention struct {
+	Days   int
+	Months int
+	// Never means that distrs never drop out.
+	Never bool
+}
+
+// DefaultRetention keeps distrs for a year.
+var DefaultRetention = Retention{Months: 12}
+
+func (r Retention) orDefault() Retention {
+	if r == (Retention{}) {
+		return DefaultRetention
+	}
+	return r
+}
+
+// ParseRetention parses retention like "400d" (days), "6m" (months), "1y" (12 months) or "never".
+func ParseRetention(s string) (Retention, error) {
+	if s == "never" {
+		return Retention{Never: true}, nil
+	}
+	if len(s) < 2 {
+		return Retention{}, fmt.Errorf("invalid retention %q, expected Nd, Nm, Ny or never", s)
+	}
+	n, err := strconv.Atoi(s[:len(s)-1])
+	if err != nil || n <= 0 {
+		return Retention{}, fmt.Errorf("invalid retention %q, expected positive number of days, months or years", s)
+	}
+	switch s[len(s)-1] {
+	case 'd':
+		return Retention{Days: n}, nil
+	case 'm':
+		return Retention{Months: n}, nil
+	case 'y':
+		return Retention{Months: 12 * n}, nil
+	}
+	return Retention{}, fmt.Errorf("invalid retention %q, expected Nd, Nm, Ny or never", s)
+}
+
+// String returns retention in the format of ParseRetention.
+func (r Retention) String() string {
+	r = r.orDefault()
+	var parts []string
+	switch {
+	case r.Never:
+		return "never"
+	case r.Months > 0 && r.Months%12 == 0:
+		parts = append(parts, fmt.Sprintf("%dy", r.Months/12))
+	case r.Months > 0:
+		parts = append(parts, fmt.Sprintf("%dm", r.Months))
+	}
+	if r.Days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", r.Days))
+	}
+	return strings.Join(parts, "")
+}
+
+// Cutoff returns the earliest last update of distrs which stay in distrs on the date,
+// distrs updated before drop out. Returns false if distrs never drop out.
+// Months are calendar months, the day is clamped to the end of the shorter month,
+// so the year before 29 February 2024 is 28 February 2023.
+func (r Retention) Cutoff(date time.Time) (time.Time, bool) {
+	r = r.orDefault()
+	if r.Never {
+		return time.Time{}, false
+	}
+	return addMonths(date, -r.Months).AddDate(0, 0, -r.Days), true
+}
+
+// DropDate returns the date on which a distr last updated on lastUpdate drops out
+// if it is not updated again. Returns false if distrs never drop out.
+func (r Retention) DropDate(lastUpdate time.Time) (time.Time, bool) {
+	r = r.orDefault()
+	if r.Never {
+		return time.Time{}, false
+	}
+	// Clamping may map several dates to the same cutoff, so step forward to the first one which is after lastUpdate.
+	date := addMonths(lastUpdate, r.Months).AddDate(0, 0, r.Days)
+	for {
+		cutoff, _ := r.Cutoff(date)
+		if cutoff.After(lastUpdate) {
+			return date, true
+		}
+		date = date.AddDate(0, 0, 1)
+	}
+}
+
+// addMonths adds months to t clamping the day to the last day of the resulting month.
+func addMonths(t time.Time, months int) time.Time {
+	year, month, day := t.Date()
+	first := time.Date(year, month+time.Month(months), 1, 0, 0, 0, 0, t.Location())
+	if last := first.AddDate(0, 1, -1).Day(); day > last {
+		day = last
+	}
+	return time.Date(first.Year(), first.Month(), day, t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), t.Location())
+}
+
+// PreviewDropout returns distrs of the source with DropDate set to the date they drop out on under the retention
+// if they are not updated again, ordered by the date (drop dates grow with last updates). It is empty if distrs never drop out.
+func PreviewDropout(ctx context.Context, db *sql.DB, source Source, retention Retention) ([]DroppedDistr, error) {
+	if retention.orDefault().Never {
+		return make([]DroppedDistr, 0), nil
+	}
+	distrs, err := queryStints(ctx, db, source.String(), "ORDER BY last_update, name")
+	if err != nil {
+		return nil, err
+	}
+	for i, distr := range distrs {
+		lastUpdate, err := time.Parse(timeLayout, strconv.Itoa(distr.LastUpdate))
+		if err != nil {
+			return nil, err
+		}
+		dropDate, _ := retention.DropDate(lastUpdate)
+		distrs[i].DropDate, _ = strconv.Atoi(dropDate.Format(timeLayout))
+	}
+	return distrs, nil
+}
@@ -0,0 +1,80 @@
+package parser
+
+import (
+	"testing"
+	"time"
+)
+
+func TestRetentionCutoff(t *testing.T) {
+	tests := []struct {
+		retention Retention
+		date      string
+		want      string // empty if distrs never drop out
+	}{
+		{Retention{}, "20240615", "20230615"},
+		{Retention{Days: 30}, "20240315", "20240214"},
+		{Retention{Months: 1}, "20240315", "20240215"},
+		// 30 days and a month differ in February.
+		{Retention{Days: 30}, "20230315", "20230213"},
+		{Retention{Months: 1}, "20230315", "20230215"},
+		// The leap day.
+		{Retention{Months: 12}, "20240229", "20230228"},
+		{Retention{Months: 12}, "20250301", "20240301"},
+		{Retention{Days: 365}, "20240301", "20230302"},
+		{Retention{Months: 48}, "20240229", "20200229"},
+		// Month ends are clamped.
+		{Retention{Months: 1}, "20240331", "20240229"},
+		{Retention{Months: 1}, "20230331", "20230228"},
+		{Retention{Months: 6}, "20240831", "20240229"},
+		{Retention{Months: 1}, "20240430", "20240330"},
+		{Retention{Months: 1, Days: 1}, "20240331", "20240228"},
+		{Retention{Never: true}, "20240315", ""},
+	}
+	for _, test := range tests {
+		date, _ := time.Parse(timeLayout, test.date)
+		cutoff, ok := test.retention.Cutoff(date)
+		var got string
+		if ok {
+			got = cutoff.Format(timeLayout)
+		}
+		if got != test.want {
+			t.Errorf("%s.Cutoff(%s) = %q, want %q", test.retention, test.date, got, test.want)
+		}
+	}
+}
+
+func TestRetentionDropDate(t *testing.T) {
+	tests := []struct {
+		retention  Retention
+		lastUpdate string
+		want       string // empty if distrs never drop out
+	}{
+		{Retention{}, "20230615", "20240616"},
+		{Retention{Days: 30}, "20240214", "20240316"},
+		{Retention{Months: 1}, "20240215", "20240316"},
+		// The leap day: the cutoff of 1 March 2025 is 1 March 2024.
+		{Retention{Months: 12}, "20240229", "20250301"},
+		{Retention{Months: 12}, "20230228", "20240301"},
+		{Retention{Days: 365}, "20230301", "20240301"},
+		// The cutoffs of 29, 30 and 31 March 2024 are all 29 February.
+		{Retention{Months: 1}, "20240228", "20240329"},
+		{Retention{Months: 1}, "20240229", "20240401"},
+		{Retention{Months: 1}, "20240131", "20240301"},
+		{Retention{Never: true}, "20240315", ""},
+	}
+	for _, test := range tests {
+		lastUpdate, _ := time.Parse(timeLayout, test.lastUpdate)
+		date, ok := test.retention.DropDate(lastUpdate)
+		var got string
+		if ok {
+			got = date.Format(timeLayout)
+			// The distr stays on the day before the drop date and drops out on it.
+			if cutoff, _ := test.retention.Cutoff(date.AddDate(0, 0, -1)); cutoff.After(lastUpdate) {
+				t.Errorf("%s: a distr last updated on %s drops out before %s", test.retention, test.lastUpdate, got)
+			}
+		}
+		if got != test.want {
+			t.Errorf("%s.DropDate(%s) = %q, want %q", test.retention, test.lastUpdate, got, test.want)
+		}
+	}
+}
@@ -0,0 +1,69 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"time"
+)
+
+// Statuses of runs.
+const (
+	RunSuccess = "success"
+	RunFailure = "failure"
+	RunSkipped = "skipped"
+	RunMissed  = "missed"
+	RunUndone  = "undone"
+	RunStale   = "stale"
+)
+
+// Run is an attempt to update the database from the source for the date.
+type Run struct {
+	Source   Source
+	Date     time.Time
+	Started  time.Time
+	Finished time.Time
+	Status   string
+	Message  string
+}
+
+// RecordRun stores the run in the table runs.
+func RecordRun(ctx context.Context, db *sql.DB, run Run) error {
+	_, err := db.ExecContext(ctx, "INSERT INTO runs (source, date, started_at, finished_at, status, message) VALUES (?, ?, ?, ?, ?, ?)", run.Source.String(), run.Date.Format(timeLayout), run.Started.Unix(), run.Finished.Unix(), run.Status, run.Message)
+	return err
+}
+
+// RecordMissedRuns finds days before opts.Today() without the daily record of opts.Source since the last recorded day
+// and records them as missed runs, unless they are recorded already. Returns the missed days.
+func RecordMissedRuns(ctx context.Context, db *sql.DB, opts Options) ([]time.Time, error) {
+	date := opts.Today()
+	source := opts.source()
+	var lastDate sql.NullString
+	err := db.QueryRowContext(ctx, "SELECT MAX(date) FROM distrs_daily WHERE source = ? AND date < ?", source.String(), date.Format(timeLayout)).Scan(&lastDate)
+	if err != nil || !lastDate.Valid {
+		// Nothing to compare with in an empty database.
+		return nil, err
+	}
+	last, err := time.Parse(timeLayout, lastDate.String)
+	if err != nil {
+		return nil, err
+	}
+
+	missed := make([]time.Time, 0)
+	now := opts.now()
+	for day := last.AddDate(0, 0, 1); day.Before(date); day = day.AddDate(0, 0, 1) {
+		var count int
+		err := db.QueryRowContext(ctx, "SELECT count(*) FROM runs WHERE source = ? AND date = ? AND status = ?", source.String(), day.Format(timeLayout), RunMissed).Scan(&count)
+		if err != nil {
+			return missed, err
+		}
+		if count > 0 {
+			continue
+		}
+		run := Run{Source: source, Date: day, Started: now, Finished: now, Status: RunMissed, Message: "no daily record"}
+		if err := RecordRun(ctx, db, run); err != nil {
+			return missed, err
+		}
+		missed = append(missed, day)
+	}
+	return missed, nil
+}
@@ -0,0 +1,124 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/PuerkitoBio/goquery"
+	"github.com/andbar-ru/average_color"
+	"github.com/andbar-ru/distrowatch"
+)
+
+// Screenshot describes downloaded screenshot.
+type Screenshot struct {
+	URL      string
+	Path     string
+	SHA256   string
+	Size     int64
+	MIMEType string
+	// Width, Height and AverageColor are zero if the image could not be decoded.
+	Width        int
+	Height       int
+	AverageColor *color.NRGBA
+}
+
+// describeScreenshot fills screenshot with metadata of the file screenshot.Path.
+func describeScreenshot(screenshot *Screenshot, opts Options) error {
+	data, err := ioutil.ReadFile(screenshot.Path)
+	if err != nil {
+		return err
+	}
+	sum := sha256.Sum256(data)
+	screenshot.SHA256 = hex.EncodeToString(sum[:])
+	screenshot.Size = int64(len(data))
+	screenshot.MIMEType = http.DetectContentType(data)
+
+	img, _, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		opts.logf("WARNING: could not decode image %s: %v", screenshot.Path, err)
+		return nil
+	}
+	bounds := img.Bounds()
+	screenshot.Width = bounds.Dx()
+	screenshot.Height = bounds.Dy()
+	averageColor := average_color.AverageColor(img)
+	screenshot.AverageColor = &averageColor
+	return nil
+}
+
+func parseScreenshotURL(root *goquery.Document, opts Options, distrURL string) (string, error) {
+	a := root.Find("td.TablesTitle > a").First()
+	if a.Length() == 0 {
+		return "", fmt.Errorf("%w: could not find screenshot on page %s", ErrMalformedHref, distrURL)
+	}
+	url, ok := a.Attr("href")
+	if !ok {
+		return "", fmt.Errorf("%w: screenshot a has not attribute 'href' on page %s", ErrMalformedHref, distrURL)
+	}
+	return opts.absURL(url), nil
+}
+
+// FetchScreenshot downloads the screenshot from url into the content-addressed storage in opts.Dir
+// and returns it along with metadata.
+// If the screenshot has been already downloaded, it is requested conditionally and reused if not modified.
+func FetchScreenshot(ctx context.Context, opts Options, url string) (Screenshot, error) {
+	ctx, cancel := opts.withTimeout(ctx)
+	defer cancel()
+	return fetchScreenshot(ctx, opts, url)
+}
+
+func fetchScreenshot(ctx context.Context, opts Options, url string) (Screenshot, error) {
+	screenshot := Screenshot{URL: url}
+
+	// Download screenshot, conditionally if it has been already downloaded.
+	header := make(http.Header)
+	entry := readURLEntry(opts.dir(), url)
+	// Validators come from the server, our clock may differ from its one.
+	if entry != nil {
+		if entry.LastModified != "" {
+			header.Set("If-Modified-Since", entry.LastModified)
+		}
+		if entry.ETag != "" {
+			header.Set("If-None-Match", entry.ETag)
+		}
+	}
+	response, err := getResponse(ctx, opts, url, header)
+	if err != nil {
+		return Screenshot{}, err
+	}
+	defer response.Body.Close()
+	if response.StatusCode == http.StatusNotModified {
+		if entry == nil {
+			return Screenshot{}, &StatusError{URL: url, StatusCode: response.StatusCode, Status: response.Status}
+		}
+		screenshot.Path = entry.Path
+		return screenshot, describeScreenshot(&screenshot, opts)
+	}
+
+	screenshot.Path, err = storeObject(opts.dir(), urlExt(url), response.Body)
+	if err != nil {
+		return Screenshot{}, fmt.Errorf("could not store image %s: %w", url, err)
+	}
+	// Remember the object and validators for the next conditional request.
+	entry = &urlEntry{URL: url, Path: screenshot.Path, ETag: response.Header.Get("ETag"), LastModified: response.Header.Get("Last-Modified"), Downloaded: time.Now()}
+	if err := writeURLEntry(opts.dir(), entry); err != nil {
+		return Screenshot{}, err
+	}
+
+	return screenshot, describeScreenshot(&screenshot, opts)
+}
+
+func (o Options) dir() string {
+	if o.Dir == "" {
+		return distrowatch.DistrsDir
+	}
+	return o.Dir
+}
@@ -0,0 +1,76 @@
+package parser
+
+import (
+	"bytes"
+	"context"
+	"image"
+	"image/png"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestFetchScreenshotConditional(t *testing.T) {
+	var data bytes.Buffer
+	if err := png.Encode(&data, image.NewNRGBA(image.Rect(0, 0, 4, 4))); err != nil {
+		t.Fatal(err)
+	}
+	const lastModified = "Wed, 21 Oct 2015 07:28:00 GMT"
+
+	tests := []struct {
+		name         string
+		lastModified string
+		// wantSince is If-Modified-Since of the second request.
+		wantSince string
+	}{
+		{"server's Last-Modified", lastModified, lastModified},
+		// Our download time must not be sent instead.
+		{"no Last-Modified", "", ""},
+	}
+	for _, test := range tests {
+		dir, err := ioutil.TempDir("", "distrowatch")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dir)
+
+		var requests []*http.Request
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			requests = append(requests, r)
+			if since := r.Header.Get("If-Modified-Since"); since != "" && since == test.lastModified {
+				w.WriteHeader(http.StatusNotModified)
+				return
+			}
+			if test.lastModified != "" {
+				w.Header().Set("Last-Modified", test.lastModified)
+			}
+			w.Write(data.Bytes())
+		}))
+		defer server.Close()
+
+		opts := Options{Dir: dir, Retries: -1}
+		url := server.URL + "/images/shot.png"
+		first, err := FetchScreenshot(context.Background(), opts, url)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		second, err := FetchScreenshot(context.Background(), opts, url)
+		if err != nil {
+			t.Fatalf("%s: %v", test.name, err)
+		}
+		if len(requests) != 2 {
+			t.Fatalf("%s: %d requests, want 2", test.name, len(requests))
+		}
+		if since := requests[0].Header.Get("If-Modified-Since"); since != "" {
+			t.Errorf("%s: the first request has If-Modified-Since %q", test.name, since)
+		}
+		if since := requests[1].Header.Get("If-Modified-Since"); since != test.wantSince {
+			t.Errorf("%s: If-Modified-Since is %q, want %q", test.name, since, test.wantSince)
+		}
+		if first.Path != second.Path || first.SHA256 != second.SHA256 {
+			t.Errorf("%s: the second download is %s, want the same object %s", test.name, second.Path, first.Path)
+		}
+	}
+}
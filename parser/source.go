@@ -0,0 +1,78 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/andbar-ru/distrowatch"
+)
+
+// Source is a ranking table of the main page. Every source is tracked in its own namespace:
+// rows of distrs, distrs_daily, coords etc. are marked with its name.
+type Source struct {
+	// Name identifies the source in the database.
+	Name string
+	// DataSpan is the value of the parameter dataspan of the main page, empty for the default table.
+	DataSpan string
+}
+
+// DefaultSource is the table shown on the main page by default.
+var DefaultSource = Source{Name: distrowatch.DefaultSource}
+
+// ParseSource parses the name of a source, see distrowatch.ParseSource.
+func ParseSource(s string) (Source, error) {
+	name, dataSpan, err := distrowatch.ParseSource(s)
+	if err != nil {
+		return Source{}, err
+	}
+	return Source{Name: name, DataSpan: dataSpan}, nil
+}
+
+// ParseSources parses a comma-separated list of sources, repeated ones are skipped.
+func ParseSources(s string) ([]Source, error) {
+	var sources []Source
+	seen := make(map[string]bool)
+	for _, name := range strings.Split(s, ",") {
+		source, err := ParseSource(strings.TrimSpace(name))
+		if err != nil {
+			return nil, err
+		}
+		if !seen[source.Name] {
+			seen[source.Name] = true
+			sources = append(sources, source)
+		}
+	}
+	return sources, nil
+}
+
+func (s Source) orDefault() Source {
+	if s.Name == "" {
+		return DefaultSource
+	}
+	return s
+}
+
+// String returns the name of the source.
+func (s Source) String() string {
+	return s.orDefault().Name
+}
+
+// url returns the address of the ranking table relative to the main page baseURL.
+func (s Source) url(baseURL string) string {
+	if s.DataSpan == "" {
+		return baseURL
+	}
+	return baseURL + "index.php?dataspan=" + s.DataSpan
+}
+
+// Final reports whether the table can't change anymore on the date: it is the table of a year which is over.
+// Final tables are identical every day, so they are never stale.
+func (s Source) Final(date time.Time) bool {
+	year, err := strconv.Atoi(s.DataSpan)
+	return err == nil && len(s.DataSpan) == 4 && year < date.Year()
+}
+
+func (o Options) source() Source {
+	return o.Source.orDefault()
+}
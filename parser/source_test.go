@@ -0,0 +1,103 @@
+package parser
+
+import (
+	"os"
+	"testing"
+
+	"github.com/PuerkitoBio/goquery"
+)
+
+// openFixture parses the page in testdata.
+func openFixture(t *testing.T, name string) *goquery.Document {
+	f, err := os.Open("testdata/" + name)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	root, err := goquery.NewDocumentFromReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return root
+}
+
+func TestParseSourceDataSpans(t *testing.T) {
+	root := openFixture(t, "index.html")
+	options := make(map[string]string)
+	root.Find("select[name=dataspan] > option").Each(func(_ int, option *goquery.Selection) {
+		options[option.Text()] = option.AttrOr("value", "")
+	})
+	defaultOption := root.Find("select[name=dataspan] > option[selected]").Text()
+
+	tests := []struct {
+		source string
+		// option is the text of the option of the main page selecting the table, the selected one is the default table.
+		option   string
+		wantName string
+	}{
+		{"default", "Last 6 months", "default"},
+		{"6m", "Last 6 months", "default"},
+		{"1m", "Last 1 month", "1m"},
+		{"3m", "Last 3 months", "3m"},
+		{"12m", "Last 12 months", "12m"},
+		{"2023", "2023", "2023"},
+	}
+	for _, test := range tests {
+		source, err := ParseSource(test.source)
+		if err != nil {
+			t.Errorf("ParseSource(%q): %v", test.source, err)
+			continue
+		}
+		if source.Name != test.wantName {
+			t.Errorf("ParseSource(%q) is named %q, want %q", test.source, source.Name, test.wantName)
+		}
+		value, ok := options[test.option]
+		if !ok {
+			t.Fatalf("the page has no option %q", test.option)
+		}
+		if test.option == defaultOption {
+			if source.DataSpan != "" {
+				t.Errorf("ParseSource(%q) has dataspan %q, want the default table", test.source, source.DataSpan)
+			}
+		} else if source.DataSpan != value {
+			t.Errorf("ParseSource(%q) has dataspan %q, want %q of option %q", test.source, source.DataSpan, value, test.option)
+		}
+	}
+
+	for _, s := range []string{"", "6", "2m", "24m", "1999", "02023"} {
+		if _, err := ParseSource(s); err == nil {
+			t.Errorf("ParseSource(%q) succeeded", s)
+		}
+	}
+	sources, err := ParseSources("default, 6m,1m")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(sources) != 2 || sources[0] != DefaultSource || sources[1].Name != "1m" {
+		t.Errorf("ParseSources returned %v, want default and 1m", sources)
+	}
+}
+
+func TestParseRankingFixture(t *testing.T) {
+	ranking, err := parseRanking(openFixture(t, "index.html"), Options{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ranking) != DistrCount {
+		t.Fatalf("ranking has %d rows, want %d", len(ranking), DistrCount)
+	}
+	want := []Row{
+		{Rank: 1, Name: "Mint", URL: "https://distrowatch.com/table.php?distribution=mint", HPD: 2950, Trend: -1},
+		{Rank: 2, Name: "MX Linux", URL: "https://distrowatch.com/table.php?distribution=mxlinux", HPD: ranking[1].HPD, Trend: 0},
+	}
+	for i, row := range want {
+		if ranking[i] != row {
+			t.Errorf("row %d is %+v, want %+v", i, ranking[i], row)
+		}
+	}
+	for i := 1; i < len(ranking); i++ {
+		if ranking[i].Rank != i+1 || ranking[i].HPD > ranking[i-1].HPD {
+			t.Errorf("row %d is %+v after %+v", i, ranking[i], ranking[i-1])
+		}
+	}
+}
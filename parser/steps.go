@@ -0,0 +1,140 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"time"
+)
+
+// Steps of the daily update of a source, in order. Every finished step is recorded in the table steps,
+// so that a rerun of the day resumes only the missing ones.
+const (
+	// StepRanking records the ranking, counts the winner and moves outdated distrs to dropout.
+	StepRanking = "ranking"
+	// StepCoords computes the day's coordinates and their place.
+	// It is done in the transaction of StepRanking, alone only when resuming.
+	StepCoords = "coords"
+	// StepInfo records details of the winner from its page.
+	StepInfo = "info"
+	// StepScreenshot downloads the screenshot of the winner and records it.
+	StepScreenshot = "screenshot"
+)
+
+// Steps are all steps of the daily update in order.
+var Steps = []string{StepRanking, StepCoords, StepInfo, StepScreenshot}
+
+// execer is implemented by *sql.DB and *sql.Tx.
+type execer interface {
+	ExecContext(ctx context.Context, query string, args ...interface{}) (sql.Result, error)
+}
+
+// inTx runs f in a transaction which is committed if f succeeds and rolled back otherwise.
+func inTx(ctx context.Context, db *sql.DB, f func(tx *sql.Tx) error) error {
+	tx, err := db.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	if err := f(tx); err != nil {
+		tx.Rollback()
+		return err
+	}
+	return tx.Commit()
+}
+
+// recordStep marks the step of the day of the source finished at now.
+func recordStep(ctx context.Context, e execer, source, date, step string, now time.Time) error {
+	_, err := e.ExecContext(ctx, "INSERT OR REPLACE INTO steps (source, date, step, finished_at) VALUES (?, ?, ?, ?)", source, date, step, now.Unix())
+	return err
+}
+
+// PendingSteps returns steps of the daily update of the source for the date which are not finished yet, in order.
+func PendingSteps(ctx context.Context, db *sql.DB, source Source, date time.Time) ([]string, error) {
+	rows, err := db.QueryContext(ctx, "SELECT step FROM steps WHERE source = ? AND date = ?", source.String(), date.Format(timeLayout))
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	finished := make(map[string]bool)
+	for rows.Next() {
+		var step string
+		if err := rows.Scan(&step); err != nil {
+			return nil, err
+		}
+		finished[step] = true
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	pending := make([]string, 0, len(Steps))
+	for _, step := range Steps {
+		if !finished[step] {
+			pending = append(pending, step)
+		}
+	}
+	return pending, nil
+}
+
+// hasStep reports whether steps contain the step.
+func hasStep(steps []string, step string) bool {
+	for _, s := range steps {
+		if s == step {
+			return true
+		}
+	}
+	return false
+}
+
+// RecordedOutcome returns the outcome of opts.Source for the date as StepRanking recorded it:
+// the winner from distrs_daily with its canonical name and the ranking from rankings_daily.
+func RecordedOutcome(ctx context.Context, db *sql.DB, opts Options, date time.Time) (Outcome, error) {
+	source := opts.source()
+	outcome := Outcome{Date: date, Retention: opts.Retention, Source: source}
+	var slug string
+	err := db.QueryRowContext(ctx, "SELECT name, hpd, strategy, slug FROM distrs_daily WHERE source = ? AND date = ?", source.String(), date.Format(timeLayout)).
+		Scan(&outcome.DistrName, &outcome.HPD, &outcome.Strategy, &slug)
+	if err == sql.ErrNoRows {
+		return outcome, fmt.Errorf("there is no recorded winner of source %s for %s", source, date.Format(timeLayout))
+	}
+	if err != nil {
+		return outcome, err
+	}
+
+	rows, err := db.QueryContext(ctx, "SELECT rank, name, url, hpd, trend FROM rankings_daily WHERE source = ? AND date = ? ORDER BY rank", source.String(), date.Format(timeLayout))
+	if err != nil {
+		return outcome, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var row Row
+		if err := rows.Scan(&row.Rank, &row.Name, &row.URL, &row.HPD, &row.Trend); err != nil {
+			return outcome, err
+		}
+		outcome.Ranking = append(outcome.Ranking, row)
+	}
+	if err := rows.Err(); err != nil {
+		return outcome, err
+	}
+
+	// The recorded name may be canonical, so the winner is recognized by its slug first.
+	winner := -1
+	for i, row := range outcome.Ranking {
+		if slug != "" && Slug(row.URL) == slug || row.Name == outcome.DistrName {
+			winner = i
+			break
+		}
+	}
+	if winner == -1 {
+		return outcome, fmt.Errorf("could not find %s in the recorded ranking of source %s for %s", outcome.DistrName, source, date.Format(timeLayout))
+	}
+	outcome.DistrURL = outcome.Ranking[winner].URL
+	if winner+1 < len(outcome.Ranking) {
+		outcome.Next1HPD = outcome.Ranking[winner+1].HPD
+		outcome.Next1Trend = outcome.Ranking[winner+1].Trend
+	}
+	if winner+2 < len(outcome.Ranking) {
+		outcome.Next2HPD = outcome.Ranking[winner+2].HPD
+		outcome.Next2Trend = outcome.Ranking[winner+2].Trend
+	}
+	return outcome, nil
+}
@@ -0,0 +1,42 @@
+package parser
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestStepsFinishedAtNow(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+
+	date := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	now := time.Date(2024, 3, 1, 6, 30, 0, 0, time.UTC)
+	outcome := testOutcome(date, "Alpha", "Beta", "Gamma")
+	if err := Apply(ctx, db, outcome, now); err != nil {
+		t.Fatal(err)
+	}
+	pending, err := PendingSteps(ctx, db, DefaultSource, date)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pending) != 2 || pending[0] != StepInfo || pending[1] != StepScreenshot {
+		t.Errorf("pending steps are %v, want [%s %s]", pending, StepInfo, StepScreenshot)
+	}
+	rows, err := db.Query("SELECT step, finished_at FROM steps")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var step string
+		var finishedAt int64
+		if err := rows.Scan(&step, &finishedAt); err != nil {
+			t.Fatal(err)
+		}
+		if finishedAt != now.Unix() {
+			t.Errorf("step %s is finished at %d, want %d", step, finishedAt, now.Unix())
+		}
+	}
+}
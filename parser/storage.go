@@ -0,0 +1,138 @@
+package parser
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// Screenshots are stored content-addressed: Dir/objects/ab/cdef...ext, where abcdef... is SHA-256
+// of the content. So identical images are stored once and different images never overwrite each other.
+// Dir/urls/<SHA-256 of URL>.json remembers which object and validators were downloaded from URL.
+
+// urlEntry is the last download from URL. ETag and LastModified are the validators sent by the server.
+type urlEntry struct {
+	URL          string    `json:"url"`
+	Path         string    `json:"path"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	Downloaded   time.Time `json:"downloaded"`
+}
+
+func urlEntryPath(dir, url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(dir, "urls", hex.EncodeToString(sum[:])+".json")
+}
+
+// readURLEntry returns the last download from url, nil if there is none or its object is missing.
+func readURLEntry(dir, url string) *urlEntry {
+	data, err := ioutil.ReadFile(urlEntryPath(dir, url))
+	if err != nil {
+		return nil
+	}
+	var entry urlEntry
+	if err := json.Unmarshal(data, &entry); err != nil || entry.URL != url {
+		return nil
+	}
+	if _, err := os.Stat(entry.Path); err != nil {
+		return nil
+	}
+	return &entry
+}
+
+func writeURLEntry(dir string, entry *urlEntry) error {
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(urlEntryPath(dir, entry.URL), data)
+}
+
+// writeFileAtomic writes data to the temporary file and renames it to name,
+// so that name is never partially written.
+func writeFileAtomic(name string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(name), 0755); err != nil {
+		return err
+	}
+	tmp, err := ioutil.TempFile(filepath.Dir(name), ".tmp-")
+	if err != nil {
+		return err
+	}
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		os.Remove(tmp.Name())
+		return err
+	}
+	return os.Rename(tmp.Name(), name)
+}
+
+// urlExt returns the extension of the file in the path of rawURL, the query and the fragment are not a part of it.
+func urlExt(rawURL string) string {
+	u, err := url.Parse(rawURL)
+	if err != nil {
+		return ""
+	}
+	return path.Ext(u.Path)
+}
+
+// storeObject copies r into the content-addressed storage in dir and returns the path of the object.
+// Nothing is left on disk if copying fails. If the same content is already stored, it is reused.
+func storeObject(dir, ext string, r io.Reader) (string, error) {
+	objectsDir := filepath.Join(dir, "objects")
+	if err := os.MkdirAll(objectsDir, 0755); err != nil {
+		return "", err
+	}
+	tmp, err := ioutil.TempFile(objectsDir, ".download-")
+	if err != nil {
+		return "", err
+	}
+	hash := sha256.New()
+	_, err = io.Copy(io.MultiWriter(tmp, hash), r)
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+
+	sum := hex.EncodeToString(hash.Sum(nil))
+	objectPath := filepath.Join(objectsDir, sum[:2], sum[2:]+strings.ToLower(ext))
+	if _, err := os.Stat(objectPath); err == nil {
+		// Deduplicate.
+		os.Remove(tmp.Name())
+		return objectPath, nil
+	}
+	if err := os.MkdirAll(filepath.Dir(objectPath), 0755); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := os.Chmod(tmp.Name(), 0644); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	if err := os.Rename(tmp.Name(), objectPath); err != nil {
+		os.Remove(tmp.Name())
+		return "", err
+	}
+	return objectPath, nil
+}
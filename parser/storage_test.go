@@ -0,0 +1,21 @@
+package parser
+
+import "testing"
+
+func TestURLExt(t *testing.T) {
+	tests := []struct {
+		url  string
+		want string
+	}{
+		{"https://distrowatch.com/images/ktyxqzobhgijab/mint.png", ".png"},
+		{"https://distrowatch.com/images/ktyxqzobhgijab/mint.png?v=2", ".png"},
+		{"https://distrowatch.com/images/ktyxqzobhgijab/mint.JPG#full", ".JPG"},
+		{"https://distrowatch.com/images/shot?file=mint.png", ""},
+		{"images/ktyxqzobhgijab/mint.gif?v=2#top", ".gif"},
+	}
+	for _, test := range tests {
+		if got := urlExt(test.url); got != test.want {
+			t.Errorf("urlExt(%q) = %q, want %q", test.url, got, test.want)
+		}
+	}
+}
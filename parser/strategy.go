@@ -0,0 +1,121 @@
+package parser
+
+import (
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// DefaultStrategy is the strategy used if Options.Strategy is nil.
+var DefaultStrategy Strategy = FirstTrend(0)
+
+// Strategy selects the distribution of the day from the ranking table.
+// Two rows after the selected one drive longitude and latitude.
+type Strategy interface {
+	// Name identifies the strategy, ParseStrategy(Name()) returns the same strategy.
+	Name() string
+	// Select returns index of the selected row in ranking.
+	Select(ranking []Row, date time.Time) (int, error)
+}
+
+// FirstTrend selects the first row with the given trend: 0 ("="), 1 (">") or -1 ("<").
+type FirstTrend int
+
+// Name implements Strategy.
+func (s FirstTrend) Name() string {
+	switch s {
+	case 1:
+		return "first-rising"
+	case -1:
+		return "first-falling"
+	}
+	return "first-equal"
+}
+
+// Select implements Strategy.
+func (s FirstTrend) Select(ranking []Row, date time.Time) (int, error) {
+	for i, row := range ranking {
+		if row.Trend == int(s) {
+			return i, nil
+		}
+	}
+	if s == 0 {
+		return 0, ErrNoEqualDistr
+	}
+	return 0, fmt.Errorf("%w: %s", ErrNoWinner, s.Name())
+}
+
+// NthEqual selects the Nth (starting with 1) row with trend "=".
+type NthEqual int
+
+// Name implements Strategy.
+func (s NthEqual) Name() string {
+	return fmt.Sprintf("nth-equal:%d", int(s))
+}
+
+// Select implements Strategy.
+func (s NthEqual) Select(ranking []Row, date time.Time) (int, error) {
+	n := 0
+	for i, row := range ranking {
+		if row.Trend == 0 {
+			n++
+			if n == int(s) {
+				return i, nil
+			}
+		}
+	}
+	return 0, fmt.Errorf("%w: %s: there are only %d rows with trend '='", ErrNoWinner, s.Name(), n)
+}
+
+// SeededRandom selects a random row. The choice depends only on the seed and the date.
+type SeededRandom int64
+
+// Name implements Strategy.
+func (s SeededRandom) Name() string {
+	return fmt.Sprintf("random:%d", int64(s))
+}
+
+// Select implements Strategy.
+func (s SeededRandom) Select(ranking []Row, date time.Time) (int, error) {
+	if len(ranking) == 0 {
+		return 0, fmt.Errorf("%w: %s: empty ranking", ErrNoWinner, s.Name())
+	}
+	h := fnv.New64a()
+	fmt.Fprintf(h, "%d/%s", int64(s), date.Format(timeLayout))
+	rnd := rand.New(rand.NewSource(int64(h.Sum64())))
+	return rnd.Intn(len(ranking)), nil
+}
+
+// ParseStrategy returns strategy by name: "first-equal", "first-rising", "first-falling",
+// "nth-equal:N" or "random:SEED".
+func ParseStrategy(name string) (Strategy, error) {
+	switch name {
+	case "first-equal":
+		return FirstTrend(0), nil
+	case "first-rising":
+		return FirstTrend(1), nil
+	case "first-falling":
+		return FirstTrend(-1), nil
+	}
+	parts := strings.SplitN(name, ":", 2)
+	if len(parts) == 2 {
+		switch parts[0] {
+		case "nth-equal":
+			n, err := strconv.Atoi(parts[1])
+			if err != nil || n < 1 {
+				return nil, fmt.Errorf("invalid strategy %q: N must be a positive number", name)
+			}
+			return NthEqual(n), nil
+		case "random":
+			seed, err := strconv.ParseInt(parts[1], 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid strategy %q: SEED must be a number", name)
+			}
+			return SeededRandom(seed), nil
+		}
+	}
+	return nil, fmt.Errorf("unknown strategy %q", name)
+}
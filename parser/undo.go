@@ -0,0 +1,150 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"os"
+	"time"
+)
+
+// Undone describes what Undo has reverted.
+type Undone struct {
+	Source    string
+	Date      string
+	DistrName string
+	// Removed is true if the day started the winner's stint, so it is removed from distrs,
+	// otherwise its count is decremented.
+	Removed bool
+	// Restored lists distrs moved back from dropout.
+	Restored []DroppedDistr
+	// ScreenshotPath is the file of the day's screenshot, empty if there was none.
+	ScreenshotPath string
+	// ScreenshotRemoved is true if the file is removed, it is kept if other screenshots refer to it.
+	ScreenshotRemoved bool
+}
+
+// Undo reverts the update of the source for the date in one transaction: decrements the winner's count,
+// deletes the daily, ranking, coords, place and distr info rows, restores distrs moved to dropout on the date
+// and removes the screenshot.
+// Only the latest day of the source can be undone, because coordinates of the next days depend on it.
+// Returns ErrNothingToUndo if the database is not updated for the date. The undo is recorded in the table runs.
+func Undo(ctx context.Context, db *sql.DB, source Source, date time.Time, now time.Time) (*Undone, error) {
+	var undone *Undone
+	err := inTx(ctx, db, func(tx *sql.Tx) error {
+		var err error
+		undone, err = undo(ctx, tx, source.String(), date.Format(timeLayout))
+		if err != nil {
+			return err
+		}
+		return recordUndone(ctx, tx, undone, now)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return undone, removeUndoneScreenshot(ctx, db, undone)
+}
+
+// recordUndone records the undo in the table runs.
+func recordUndone(ctx context.Context, e execer, undone *Undone, now time.Time) error {
+	_, err := e.ExecContext(ctx, "INSERT INTO runs (source, date, started_at, finished_at, status, message) VALUES (?, ?, ?, ?, ?, ?)", undone.Source, undone.Date, now.Unix(), now.Unix(), RunUndone, undone.DistrName)
+	return err
+}
+
+// removeUndoneScreenshot removes the file of the undone screenshot unless other days share it,
+// objects are content-addressed.
+func removeUndoneScreenshot(ctx context.Context, db *sql.DB, undone *Undone) error {
+	if undone.ScreenshotPath == "" {
+		return nil
+	}
+	var count int
+	err := db.QueryRowContext(ctx, "SELECT count(*) FROM screenshots WHERE path = ?", undone.ScreenshotPath).Scan(&count)
+	if err != nil || count > 0 {
+		return err
+	}
+	if err := os.Remove(undone.ScreenshotPath); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	undone.ScreenshotRemoved = true
+	return nil
+}
+
+func undo(ctx context.Context, tx *sql.Tx, source, date string) (*Undone, error) {
+	undone := &Undone{Source: source, Date: date, Restored: make([]DroppedDistr, 0)}
+	err := tx.QueryRowContext(ctx, "SELECT name FROM distrs_daily WHERE source = ? AND date = ?", source, date).Scan(&undone.DistrName)
+	if err == sql.ErrNoRows {
+		return nil, fmt.Errorf("%w: source %s, date %s", ErrNothingToUndo, source, date)
+	}
+	if err != nil {
+		return nil, err
+	}
+	var later int
+	err = tx.QueryRowContext(ctx, "SELECT count(*) FROM distrs_daily WHERE source = ? AND date > ?", source, date).Scan(&later)
+	if err != nil {
+		return nil, err
+	}
+	if later > 0 {
+		return nil, fmt.Errorf("%w: source %s has %d days after %s", ErrNotLatestDay, source, later, date)
+	}
+
+	// The winner: the stint which started on the date is removed, otherwise the count is decremented
+	// and the last update goes back to the previous win.
+	result, err := tx.ExecContext(ctx, "DELETE FROM distrs WHERE source = ? AND name = ? AND count <= 1", source, undone.DistrName)
+	if err != nil {
+		return nil, err
+	}
+	n, err := result.RowsAffected()
+	if err != nil {
+		return nil, err
+	}
+	undone.Removed = n > 0
+	if !undone.Removed {
+		_, err = tx.ExecContext(ctx, "UPDATE distrs SET count = count - 1, last_update = COALESCE((SELECT MAX(date) FROM distrs_daily d WHERE d.source = distrs.source AND d.name = distrs.name AND d.date < CAST(? AS INTEGER)), first_update, last_update) WHERE source = ? AND name = ?", date, source, undone.DistrName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	// Distrs moved to dropout on the date come back with their stints.
+	rows, err := tx.QueryContext(ctx, "SELECT id, name, slug, count, COALESCE(first_update, 0), last_update, drop_date, stint, COALESCE(previous_id, 0) FROM dropout WHERE source = ? AND drop_date = ? ORDER BY id", source, date)
+	if err != nil {
+		return nil, err
+	}
+	for rows.Next() {
+		var distr DroppedDistr
+		if err := rows.Scan(&distr.ID, &distr.Name, &distr.Slug, &distr.Count, &distr.FirstUpdate, &distr.LastUpdate, &distr.DropDate, &distr.Stint, &distr.PreviousID); err != nil {
+			rows.Close()
+			return nil, err
+		}
+		undone.Restored = append(undone.Restored, distr)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	for _, distr := range undone.Restored {
+		_, err = tx.ExecContext(ctx, "INSERT INTO distrs (source, name, count, last_update, slug, stint, first_update, reactivated_from) VALUES (?, ?, ?, ?, ?, ?, ?, ?)", source, distr.Name, distr.Count, distr.LastUpdate, distr.Slug, distr.Stint, nullIfZero(int64(distr.FirstUpdate)), nullIfZero(distr.PreviousID))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tx.ExecContext(ctx, "DELETE FROM dropout WHERE id = ?", distr.ID); err != nil {
+			return nil, err
+		}
+	}
+
+	err = tx.QueryRowContext(ctx, "SELECT path FROM screenshots WHERE source = ? AND date = ?", source, date).Scan(&undone.ScreenshotPath)
+	if err != nil && err != sql.ErrNoRows {
+		return nil, err
+	}
+	// Details from the distr page describe the winner of the date, unless another source has the same winner.
+	_, err = tx.ExecContext(ctx, "DELETE FROM distr_info WHERE name = ? AND date = ? AND NOT EXISTS (SELECT 1 FROM distrs_daily WHERE name = ? AND date = ? AND source != ?)", undone.DistrName, date, undone.DistrName, date, source)
+	if err != nil {
+		return nil, err
+	}
+	for _, table := range []string{"distrs_daily", "rankings_daily", "coords", "places", "screenshots", "steps"} {
+		if _, err := tx.ExecContext(ctx, "DELETE FROM "+table+" WHERE source = ? AND date = ?", source, date); err != nil {
+			return nil, fmt.Errorf("%s: %w", table, err)
+		}
+	}
+	return undone, nil
+}
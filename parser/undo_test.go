@@ -0,0 +1,133 @@
+package parser
+
+import (
+	"context"
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+const (
+	distrsQuery  = "SELECT COALESCE(group_concat(name || ':' || count || ':' || last_update || ':' || stint, ' '), '') FROM (SELECT * FROM distrs ORDER BY name)"
+	dropoutQuery = "SELECT COALESCE(group_concat(name || ':' || stint || ':' || drop_date, ' '), '') FROM (SELECT * FROM dropout ORDER BY id)"
+)
+
+func TestUndo(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	ctx := context.Background()
+	dir, err := ioutil.TempDir("", "distrowatch")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	// A drops out on the 3rd and comes back on the 5th, C drops out on the 5th.
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	applyDays(t, db, start, "A", "B", "C", "B", "A")
+	if got, want := queryString(t, db, distrsQuery), "A:1:20240305:2 B:2:20240304:1"; got != want {
+		t.Fatalf("distrs are %s, want %s", got, want)
+	}
+	last := start.AddDate(0, 0, 4)
+	screenshot := Screenshot{URL: "https://distrowatch.com/images/a.png", Path: filepath.Join(dir, "a.png"), MIMEType: "image/png"}
+	if err := ioutil.WriteFile(screenshot.Path, []byte("png"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if err := RecordScreenshot(ctx, db, testOutcome(last, "A", "B", "C"), screenshot, last); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := Undo(ctx, db, DefaultSource, start.AddDate(0, 0, 3), last); !errors.Is(err, ErrNotLatestDay) {
+		t.Errorf("Undo of the day before the latest returned %v, want %v", err, ErrNotLatestDay)
+	}
+
+	// The reactivation stint of A is removed, C is restored from dropout.
+	undone, err := Undo(ctx, db, DefaultSource, last, last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if undone.DistrName != "A" || !undone.Removed {
+		t.Errorf("Undo reverted %s, removed %v, want the stint of A removed", undone.DistrName, undone.Removed)
+	}
+	if len(undone.Restored) != 1 || undone.Restored[0].Name != "C" {
+		t.Errorf("Undo restored %+v, want C", undone.Restored)
+	}
+	if undone.ScreenshotPath != screenshot.Path || !undone.ScreenshotRemoved {
+		t.Errorf("Undo reverted screenshot %q, removed %v, want %q removed", undone.ScreenshotPath, undone.ScreenshotRemoved, screenshot.Path)
+	}
+	if _, err := os.Stat(screenshot.Path); !os.IsNotExist(err) {
+		t.Errorf("the screenshot file is not removed: %v", err)
+	}
+	queries := []struct {
+		query string
+		want  string
+	}{
+		{distrsQuery, "B:2:20240304:1 C:1:20240303:1"},
+		{dropoutQuery, "A:1:20240303"},
+		{"SELECT count(*) FROM screenshots", "0"},
+		{"SELECT count(*) FROM distrs_daily WHERE date = 20240305", "0"},
+		{"SELECT count(*) FROM rankings_daily WHERE date = 20240305", "0"},
+		{"SELECT count(*) FROM coords WHERE date = 20240305", "0"},
+		{"SELECT count(*) FROM steps WHERE date = 20240305", "0"},
+		{"SELECT group_concat(status || ':' || message) FROM runs", RunUndone + ":A"},
+	}
+	for _, q := range queries {
+		if got := queryString(t, db, q.query); got != q.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", q.query, got, q.want)
+		}
+	}
+
+	// The count of B is decremented and its last update goes back.
+	undone, err = Undo(ctx, db, DefaultSource, start.AddDate(0, 0, 3), last)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if undone.DistrName != "B" || undone.Removed || len(undone.Restored) != 0 || undone.ScreenshotPath != "" {
+		t.Errorf("Undo reverted %+v, want the count of B decremented", undone)
+	}
+	if got, want := queryString(t, db, distrsQuery), "B:1:20240302:1 C:1:20240303:1"; got != want {
+		t.Errorf("distrs are %s, want %s", got, want)
+	}
+
+	if _, err := Undo(ctx, db, DefaultSource, start.AddDate(0, 0, 3), last); !errors.Is(err, ErrNothingToUndo) {
+		t.Errorf("the second Undo of the day returned %v, want %v", err, ErrNothingToUndo)
+	}
+}
+
+func TestRedoFailedFetch(t *testing.T) {
+	db, cleanup := openTestDB(t)
+	defer cleanup()
+	start := time.Date(2024, 3, 1, 0, 0, 0, 0, time.UTC)
+	applyDays(t, db, start, "A", "B", "C")
+	before := queryString(t, db, distrsQuery) + " / " + queryString(t, db, dropoutQuery)
+
+	// Nothing listens on the port.
+	opts := Options{BaseURL: "http://127.0.0.1:1/", Retries: -1, Clock: FixedClock(start.AddDate(0, 0, 2).Add(12 * time.Hour)), Location: time.UTC}
+	undone, _, err := Redo(context.Background(), db, opts)
+	if err == nil {
+		t.Fatal("Redo succeeded without the page")
+	}
+	if undone != nil {
+		t.Errorf("Redo undid %+v", undone)
+	}
+	if after := queryString(t, db, distrsQuery) + " / " + queryString(t, db, dropoutQuery); after != before {
+		t.Errorf("Redo changed distrs and dropout to %s, want %s", after, before)
+	}
+	queries := []struct {
+		query string
+		want  string
+	}{
+		{"SELECT name FROM distrs_daily WHERE date = 20240303", "C"},
+		{"SELECT count(*) FROM rankings_daily WHERE date = 20240303", "3"},
+		{"SELECT count(*) FROM coords WHERE date = 20240303", "1"},
+		{"SELECT group_concat(date || ':' || status) FROM runs", "20240303:" + RunFailure},
+	}
+	for _, q := range queries {
+		if got := queryString(t, db, q.query); got != q.want {
+			t.Errorf("%s:\ngot  %s\nwant %s", q.query, got, q.want)
+		}
+	}
+}
@@ -0,0 +1,168 @@
+package parser
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+)
+
+// Update performs the whole daily update: fetches the outcome, applies it to the database,
+// records details of the distribution from its page, downloads the screenshot and records it. The attempt is recorded in the table runs.
+// The day is updated step by step (see Steps), a rerun after a failure resumes the steps which are not finished yet.
+// Returns ErrAlreadyUpdated if every step of the update from opts.Source for opts.Today() is finished
+// and ErrStaleRanking if the ranking is not refreshed since the previous day, unless opts.AllowStale is set
+// or the source is final (see Source.Final).
+func Update(ctx context.Context, db *sql.DB, opts Options) (Outcome, error) {
+	return recordedRun(db, opts, func() (Outcome, error) {
+		return update(ctx, db, opts)
+	})
+}
+
+// Redo is Update which updates the day again even if it is updated already. The outcome is fetched first,
+// then the day is undone (see Undo) and the new ranking is applied in one transaction,
+// so the day stays untouched if fetching or applying fails.
+// Returns what has been undone, nil if the day has not been updated yet.
+func Redo(ctx context.Context, db *sql.DB, opts Options) (*Undone, Outcome, error) {
+	var undone *Undone
+	outcome, err := recordedRun(db, opts, func() (Outcome, error) {
+		var outcome Outcome
+		var err error
+		undone, outcome, err = redo(ctx, db, opts)
+		return outcome, err
+	})
+	return undone, outcome, err
+}
+
+// recordedRun records the attempt f of the update in the table runs.
+func recordedRun(db *sql.DB, opts Options, f func() (Outcome, error)) (Outcome, error) {
+	run := Run{Source: opts.source(), Date: opts.Today(), Started: opts.now(), Status: RunSuccess}
+	outcome, err := f()
+	run.Finished = opts.now()
+	if err == ErrAlreadyUpdated {
+		run.Status = RunSkipped
+		run.Message = err.Error()
+	} else if errors.Is(err, ErrStaleRanking) {
+		run.Status = RunStale
+		run.Message = err.Error()
+	} else if err != nil {
+		run.Status = RunFailure
+		run.Message = err.Error()
+	} else {
+		run.Message = outcome.DistrName
+	}
+	// Record the run even if ctx is canceled.
+	if recordErr := RecordRun(context.Background(), db, run); recordErr != nil && err == nil {
+		err = recordErr
+	}
+	return outcome, err
+}
+
+func update(ctx context.Context, db *sql.DB, opts Options) (Outcome, error) {
+	pending, err := PendingSteps(ctx, db, opts.source(), opts.Today())
+	if err != nil {
+		return Outcome{}, err
+	}
+	if len(pending) == 0 {
+		return Outcome{}, ErrAlreadyUpdated
+	}
+
+	var outcome Outcome
+	if hasStep(pending, StepRanking) {
+		outcome, err = fetchRanking(ctx, db, opts)
+		if err != nil {
+			return outcome, err
+		}
+		if err := Apply(ctx, db, outcome, opts.now()); err != nil {
+			return outcome, err
+		}
+	} else {
+		// The ranking of the day is counted already, the rest is done with the recorded outcome.
+		opts.logf("Resuming the update of source %s for %s: %s", opts.source(), opts.Today().Format(timeLayout), strings.Join(pending, ", "))
+		outcome, err = RecordedOutcome(ctx, db, opts, opts.Today())
+		if err != nil {
+			return outcome, err
+		}
+		if hasStep(pending, StepCoords) {
+			if err := ApplyCoords(ctx, db, outcome, opts.now()); err != nil {
+				return outcome, err
+			}
+		}
+	}
+
+	return outcome, finishSteps(ctx, db, opts, outcome, pending)
+}
+
+func redo(ctx context.Context, db *sql.DB, opts Options) (*Undone, Outcome, error) {
+	outcome, err := fetchRanking(ctx, db, opts)
+	if err != nil {
+		return nil, outcome, err
+	}
+	var undone *Undone
+	err = inTx(ctx, db, func(tx *sql.Tx) error {
+		var err error
+		undone, err = undo(ctx, tx, opts.source().String(), opts.Today().Format(timeLayout))
+		if errors.Is(err, ErrNothingToUndo) {
+			undone = nil
+		} else if err != nil {
+			return err
+		} else if err := recordUndone(ctx, tx, undone, opts.now()); err != nil {
+			return err
+		}
+		return apply(ctx, tx, outcome, opts.now())
+	})
+	if err != nil {
+		return nil, outcome, err
+	}
+	err = finishSteps(ctx, db, opts, outcome, Steps)
+	// The undone screenshot is removed after the new one is recorded, which may be the same file.
+	if undone != nil {
+		if removeErr := removeUndoneScreenshot(ctx, db, undone); removeErr != nil && err == nil {
+			err = removeErr
+		}
+	}
+	return undone, outcome, err
+}
+
+// fetchRanking fetches the outcome for StepRanking with canonical names and checks that the ranking is refreshed,
+// unless opts.AllowStale is set.
+func fetchRanking(ctx context.Context, db *sql.DB, opts Options) (Outcome, error) {
+	outcome, err := FetchOutcome(ctx, opts)
+	if err != nil {
+		return Outcome{}, err
+	}
+	outcome, err = ResolveAliases(ctx, db, outcome)
+	if err != nil {
+		return outcome, err
+	}
+	if !opts.AllowStale {
+		if err := CheckStale(ctx, db, outcome); err != nil {
+			return outcome, err
+		}
+	}
+	return outcome, nil
+}
+
+// finishSteps does the pending steps which follow StepRanking and StepCoords: StepInfo and StepScreenshot.
+func finishSteps(ctx context.Context, db *sql.DB, opts Options, outcome Outcome, pending []string) error {
+	if !hasStep(pending, StepInfo) && !hasStep(pending, StepScreenshot) {
+		return nil
+	}
+	page, err := FetchDistrPage(ctx, opts, outcome.DistrURL)
+	if err != nil {
+		return err
+	}
+	if hasStep(pending, StepInfo) {
+		if err := RecordDistrInfo(ctx, db, outcome, page, opts.now()); err != nil {
+			return err
+		}
+	}
+	if !hasStep(pending, StepScreenshot) {
+		return nil
+	}
+	screenshot, err := FetchScreenshot(ctx, opts, page.ScreenshotURL)
+	if err != nil {
+		return err
+	}
+	return RecordScreenshot(ctx, db, outcome, screenshot, opts.now())
+}
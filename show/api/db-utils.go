@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 
+	"github.com/andbar-ru/distrowatch"
 	"github.com/jmoiron/sqlx"
 	// Register sqlite3.
 	_ "github.com/mattn/go-sqlite3"
@@ -14,15 +15,24 @@ import (
 
 var (
 	allDBQueryParams = map[string]bool{
+		"source":  true,
 		"columns": true,
 		"orderBy": true,
 		"limit":   true,
 	}
 	columnRgx = regexp.MustCompile(`^\w+$`)
 	limitRgx  = regexp.MustCompile(`^\d+$`)
+	dateRgx   = regexp.MustCompile(`^\d{8}$`)
+	// infoColumns maps parameters to columns of table "distr_info" with lists joined with ", ".
+	infoColumns = map[string]string{
+		"base":    "based_on",
+		"desktop": "desktop",
+		"origin":  "origin",
+	}
 )
 
-// getDB opens and returns sqlite database specified in config.
+// getDB opens and returns sqlite database specified in config in read-only mode,
+// so that the API never blocks the parser.
 // Consumers have to close the database.
 func getDB() (*sqlx.DB, error) {
 	databasePath := getPath(config.DatabasePath)
@@ -32,13 +42,49 @@ func getDB() (*sqlx.DB, error) {
 		return nil, err
 	}
 	// Open database.
-	db, err := sqlx.Connect("sqlite3", databasePath)
+	db, err := sqlx.Connect("sqlite3", distrowatch.DSN(databasePath, true))
 	if err != nil {
 		return nil, err
 	}
 	return db, nil
 }
 
+// getSource returns the name of the ranking table selected with query parameter "source",
+// distrowatch.DefaultSource by default, or an error if there is no such table.
+func getSource(query url.Values) (string, error) {
+	source := query.Get("source")
+	if source == "" {
+		return distrowatch.DefaultSource, nil
+	}
+	name, _, err := distrowatch.ParseSource(source)
+	return name, err
+}
+
+// getPlaces returns descriptions of places of the source by date.
+// Databases which are not migrated yet have no table places, then the map is empty.
+func getPlaces(source string) (map[int64]string, error) {
+	places := make(map[int64]string)
+	var count int
+	err := db.Get(&count, "SELECT count(*) FROM sqlite_master WHERE type='table' AND name='places'")
+	if err != nil || count == 0 {
+		return places, err
+	}
+	rows, err := db.Query("SELECT date, description FROM places WHERE source = ?", source)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var date int64
+		var description string
+		if err := rows.Scan(&date, &description); err != nil {
+			return nil, err
+		}
+		places[date] = description
+	}
+	return places, rows.Err()
+}
+
 // getOrderByStr converts request.URL.Query()["orderBy"] to string like "ORDER BY column1 ASC, column2 DESC".
 func getOrderByStr(params []string) (string, error) {
 	orderByStr := " ORDER BY"
@@ -85,14 +131,14 @@ func getColumnsStr(columns string) (string, error) {
 	return columnsStr, nil
 }
 
-// buildSQLQuery composes SQL query using request query parameters.
-func buildSQLQuery(table string, query url.Values, allowedParams map[string]bool) (string, error) {
+// buildSQLQuery composes SQL query using request query parameters and returns it along with its arguments.
+func buildSQLQuery(table string, query url.Values, allowedParams map[string]bool) (string, []interface{}, error) {
 	sqlQuery := "SELECT "
 
 	if columns := query.Get("columns"); allowedParams["columns"] && columns != "" {
 		columnsStr, err := getColumnsStr(columns)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		sqlQuery += columnsStr
 	} else {
@@ -100,20 +146,30 @@ func buildSQLQuery(table string, query url.Values, allowedParams map[string]bool
 	}
 	sqlQuery += " FROM " + table
 
+	var args []interface{}
+	if allowedParams["source"] {
+		source, err := getSource(query)
+		if err != nil {
+			return "", nil, err
+		}
+		sqlQuery += " WHERE source = ?"
+		args = append(args, source)
+	}
+
 	if orderBy := query["orderBy"]; allowedParams["orderBy"] && len(orderBy) > 0 {
 		orderByStr, err := getOrderByStr(orderBy)
 		if err != nil {
-			return "", err
+			return "", nil, err
 		}
 		sqlQuery += orderByStr
 	}
 
 	if limit := query.Get("limit"); allowedParams["limit"] && limit != "" {
 		if !limitRgx.MatchString(limit) {
-			return "", fmt.Errorf("limit must be number, got '%s'", limit)
+			return "", nil, fmt.Errorf("limit must be number, got '%s'", limit)
 		}
 		sqlQuery += " LIMIT " + limit
 	}
 
-	return sqlQuery, nil
+	return sqlQuery, args, nil
 }
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"database/sql"
 	"encoding/json"
 	"fmt"
 	"image"
@@ -8,11 +9,13 @@ import (
 	"net/http"
 	"os"
 	"path"
+	"sort"
 	"strings"
 	"time"
 
 	"github.com/andbar-ru/average_color"
 	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/geo"
 )
 
 // respondJSON makes response with payload in json format.
@@ -53,13 +56,34 @@ func handleStatus(w http.ResponseWriter, r *http.Request) {
 // handleDistrs handles route /distrs.
 func handleDistrs(w http.ResponseWriter, r *http.Request) {
 	var query string
+	// Parameter "source" selects the ranking table.
+	source, err := getSource(r.URL.Query())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var args []interface{}
 	// Parameters "dropout" and "last365" are mutually exclusive.
 	if r.URL.Query().Get("last365") == "true" {
-		query = "SELECT name, count(name) as count, MAX(date) as last_update FROM (SELECT * FROM distrs_daily ORDER BY date DESC LIMIT 365) GROUP BY name"
+		query = "SELECT name, count(name) as count, MAX(date) as last_update, MAX(slug) AS slug FROM (SELECT * FROM distrs_daily WHERE source = ? ORDER BY date DESC LIMIT 365) GROUP BY name"
+		args = append(args, source)
 	} else if r.URL.Query().Get("dropout") == "true" {
-		query = "SELECT name, SUM(count) AS count, MAX(last_update) AS last_update FROM (SELECT name, count, last_update FROM distrs UNION ALL SELECT name, count, last_update FROM dropout) GROUP BY name"
+		query = "SELECT name, SUM(count) AS count, MAX(last_update) AS last_update, MAX(slug) AS slug FROM (SELECT name, count, last_update, slug FROM distrs WHERE source = ? UNION ALL SELECT name, count, last_update, slug FROM dropout WHERE source = ?) GROUP BY name"
+		args = append(args, source, source)
 	} else {
-		query = "SELECT name, count, last_update FROM distrs"
+		query = "SELECT name, count, last_update, slug FROM distrs WHERE source = ?"
+		args = append(args, source)
+	}
+	// Parameters "base", "desktop" and "origin" filter distrs by the latest info from their pages.
+	var conditions []string
+	for _, param := range []string{"base", "desktop", "origin"} {
+		if value := r.URL.Query().Get(param); value != "" {
+			conditions = append(conditions, "(', ' || i."+infoColumns[param]+" || ', ') LIKE ('%, ' || ? || ', %')")
+			args = append(args, value)
+		}
+	}
+	if len(conditions) > 0 {
+		query = "SELECT name, count, last_update, slug FROM (" + query + ") WHERE name IN (SELECT name FROM distr_info_latest i WHERE " + strings.Join(conditions, " AND ") + ")"
 	}
 	if orderBy := r.URL.Query()["orderBy"]; len(orderBy) > 0 {
 		orderByStr, err := getOrderByStr(orderBy)
@@ -73,7 +97,7 @@ func handleDistrs(w http.ResponseWriter, r *http.Request) {
 	logger.Debug(query)
 
 	var distrs []Distr
-	err := db.Select(&distrs, query)
+	err = db.Select(&distrs, query, args...)
 	if err != nil {
 		if strings.Contains(err.Error(), "no such column") {
 			respondError(w, http.StatusBadRequest, err.Error())
@@ -82,21 +106,106 @@ func handleDistrs(w http.ResponseWriter, r *http.Request) {
 		}
 		return
 	}
+	for i := range distrs {
+		if distrs[i].Slug != "" {
+			distrs[i].URL = distrowatch.DistrPageURL(distrs[i].Slug)
+		}
+	}
+	// Counts are lifetime totals then, stints give their boundaries.
+	if r.URL.Query().Get("dropout") == "true" && r.URL.Query().Get("last365") != "true" {
+		var stints []Stint
+		err := db.Select(&stints, "SELECT name, stint, count, first_update, last_update, drop_date FROM dropout WHERE source = ? UNION ALL SELECT name, stint, count, first_update, last_update, NULL FROM distrs WHERE source = ? ORDER BY name, stint", source, source)
+		if err != nil {
+			respondError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		stintsByName := make(map[string][]Stint)
+		for _, stint := range stints {
+			stintsByName[stint.Name] = append(stintsByName[stint.Name], stint)
+		}
+		for i := range distrs {
+			distrs[i].Stints = stintsByName[distrs[i].Name]
+		}
+	}
 
 	respondJSON(w, http.StatusOK, distrs)
 }
 
+// handleDistrGroups handles route /distrs/groups.
+// Groups distrs by the latest info from their pages: parameter "by" is "base", "desktop" or "origin".
+// A distr with several values belongs to several groups, distrs without info belong to the group with empty value.
+// Parameter "source" selects the ranking table.
+func handleDistrGroups(w http.ResponseWriter, r *http.Request) {
+	by := r.URL.Query().Get("by")
+	column, ok := infoColumns[by]
+	if !ok {
+		respondError(w, http.StatusBadRequest, fmt.Sprintf("by must be 'base', 'desktop' or 'origin', got '%s'", by))
+		return
+	}
+	source, err := getSource(r.URL.Query())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	query := "SELECT d.name, d.count, COALESCE(i." + column + ", '') FROM distrs d LEFT JOIN distr_info_latest i ON i.name = d.name WHERE d.source = ? ORDER BY d.count DESC, d.name"
+	logger.Debug(query)
+	rows, err := db.Query(query, source)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	defer rows.Close()
+
+	groups := make([]*DistrGroup, 0)
+	groupsByValue := make(map[string]*DistrGroup)
+	for rows.Next() {
+		var name, values string
+		var count int
+		if err := rows.Scan(&name, &count, &values); err != nil {
+			respondError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		for _, value := range strings.Split(values, ", ") {
+			group, ok := groupsByValue[value]
+			if !ok {
+				group = &DistrGroup{Value: value, Distrs: make([]string, 0)}
+				groupsByValue[value] = group
+				groups = append(groups, group)
+			}
+			group.Count += count
+			group.Distrs = append(group.Distrs, name)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+	sort.SliceStable(groups, func(i, j int) bool { return groups[i].Count > groups[j].Count })
+
+	respondJSON(w, http.StatusOK, groups)
+}
+
 // handleCoords handles route /coords.
 func handleCoords(w http.ResponseWriter, r *http.Request) {
-	query, err := buildSQLQuery("coords", r.URL.Query(), allDBQueryParams)
+	query, args, err := buildSQLQuery("coords", r.URL.Query(), allDBQueryParams)
 	if err != nil {
 		message := fmt.Sprintf("Invalid query '%s': %s", r.URL.RawQuery, err.Error())
 		respondError(w, http.StatusBadRequest, message)
 		return
 	}
+	source, err := getSource(r.URL.Query())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	places, err := getPlaces(source)
+	if err != nil {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
 	var coords []map[string]interface{}
 	logger.Debug(query)
-	rows, err := db.Queryx(query)
+	rows, err := db.Queryx(query, args...)
 	if err != nil {
 		if strings.Contains(err.Error(), "no such column") {
 			respondError(w, http.StatusBadRequest, err.Error())
@@ -112,14 +221,102 @@ func handleCoords(w http.ResponseWriter, r *http.Request) {
 			respondError(w, http.StatusInternalServerError, err.Error())
 			return
 		}
+		// Field "place" is added if the row has date or both coordinates.
+		if date, ok := coord["date"].(int64); ok && places[date] != "" {
+			coord["place"] = places[date]
+		} else {
+			latitude, okLat := coord["latitude"].(float64)
+			longitude, okLon := coord["longitude"].(float64)
+			if okLat && okLon {
+				coord["place"] = geo.ReverseGeocode(geo.Point{Latitude: latitude, Longitude: longitude}).String()
+			}
+		}
 		coords = append(coords, coord)
 	}
 	respondJSON(w, http.StatusOK, coords)
 }
 
-// handleAverageColor handles route /average-colors.
-// Sends average color of the last image in config.ImagesDir.
+// handleScreenshots handles route /screenshots.
+func handleScreenshots(w http.ResponseWriter, r *http.Request) {
+	query, args, err := buildSQLQuery("screenshots", r.URL.Query(), allDBQueryParams)
+	if err != nil {
+		message := fmt.Sprintf("Invalid query '%s': %s", r.URL.RawQuery, err.Error())
+		respondError(w, http.StatusBadRequest, message)
+		return
+	}
+	var screenshots []map[string]interface{}
+	logger.Debug(query)
+	rows, err := db.Queryx(query, args...)
+	if err != nil {
+		if strings.Contains(err.Error(), "no such column") {
+			respondError(w, http.StatusBadRequest, err.Error())
+		} else {
+			respondError(w, http.StatusInternalServerError, err.Error())
+		}
+		return
+	}
+	for rows.Next() {
+		screenshot := make(map[string]interface{})
+		err := rows.MapScan(screenshot)
+		if err != nil {
+			respondError(w, http.StatusInternalServerError, err.Error())
+			return
+		}
+		screenshots = append(screenshots, screenshot)
+	}
+	respondJSON(w, http.StatusOK, screenshots)
+}
+
+// handleAverageColor handles route /average-color.
+// Sends average color of the screenshot of the date given in parameter "date" (YYYYMMDD),
+// of the last screenshot by default. Parameter "source" selects the ranking table.
+// Falls back to the last image of any source if the parser has not recorded screenshots of the source yet.
 func handleAverageColor(w http.ResponseWriter, r *http.Request) {
+	date := r.URL.Query().Get("date")
+	source, err := getSource(r.URL.Query())
+	if err != nil {
+		respondError(w, http.StatusBadRequest, err.Error())
+		return
+	}
+	var averageColor sql.NullString
+	if date != "" {
+		if !dateRgx.MatchString(date) {
+			respondError(w, http.StatusBadRequest, fmt.Sprintf("date must be in format YYYYMMDD, got '%s'", date))
+			return
+		}
+		err = db.Get(&averageColor, "SELECT average_color FROM screenshots WHERE source = ? AND date = ?", source, date)
+	} else {
+		err = db.Get(&averageColor, "SELECT average_color FROM screenshots WHERE source = ? ORDER BY date DESC LIMIT 1", source)
+	}
+	switch {
+	case err == nil && averageColor.Valid:
+		respondJSON(w, http.StatusOK, averageColor.String)
+	case err == nil:
+		respondError(w, http.StatusNotFound, "could not decode the screenshot")
+	case err == sql.ErrNoRows && date != "":
+		respondError(w, http.StatusNotFound, fmt.Sprintf("there is no screenshot of %s", date))
+	case err == sql.ErrNoRows:
+		handleLastImageAverageColor(w, r)
+	default:
+		respondError(w, http.StatusInternalServerError, err.Error())
+	}
+}
+
+// handleLastImageAverageColor sends average color of the latest decodable screenshot of any source
+// or, if the parser has not recorded any, of the last image in the top level of config.ImagesDir,
+// where images were stored before screenshots were recorded.
+func handleLastImageAverageColor(w http.ResponseWriter, r *http.Request) {
+	var averageColor string
+	err := db.Get(&averageColor, "SELECT average_color FROM screenshots WHERE average_color IS NOT NULL ORDER BY date DESC LIMIT 1")
+	if err == nil {
+		respondJSON(w, http.StatusOK, averageColor)
+		return
+	}
+	if err != sql.ErrNoRows {
+		respondError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
 	imagesDir := getPath(config.ImagesDir)
 	files, err := ioutil.ReadDir(imagesDir)
 	if err != nil {
@@ -132,17 +329,17 @@ func handleAverageColor(w http.ResponseWriter, r *http.Request) {
 	for _, file := range files {
 		ext := strings.ToLower(path.Ext(file.Name()))
 		// interested only in images
-		if ext != ".png" && ext != ".jpg" && ext != ".jpeg" && ext != ".gif" {
+		if file.IsDir() || ext != ".png" && ext != ".jpg" && ext != ".jpeg" && ext != ".gif" {
 			continue
 		}
 		modTime := file.ModTime()
 		if modTime.After(lastModTime) {
 			lastModTime = modTime
-			lastImage = path.Join(distrowatch.DistrsDir, file.Name())
+			lastImage = path.Join(imagesDir, file.Name())
 		}
 	}
 	if lastImage == "" {
-		respondError(w, http.StatusInternalServerError, fmt.Sprintf("could not find images in directory %s", imagesDir))
+		respondError(w, http.StatusNotFound, "there are no images yet")
 		return
 	}
 	f, err := os.Open(lastImage)
@@ -156,14 +353,6 @@ func handleAverageColor(w http.ResponseWriter, r *http.Request) {
 		respondError(w, http.StatusInternalServerError, err.Error())
 		return
 	}
-	averageColor := average_color.AverageColor(img)
-
-	var averageColorStr string
-	if averageColor.A == 0xff {
-		averageColorStr = fmt.Sprintf("#%02x%02x%02x", averageColor.R, averageColor.G, averageColor.B)
-	} else {
-		averageColorStr = fmt.Sprintf("#%02x%02x%02x%02x", averageColor.R, averageColor.G, averageColor.B, averageColor.A)
-	}
 
-	respondJSON(w, http.StatusOK, averageColorStr)
+	respondJSON(w, http.StatusOK, distrowatch.FormatColor(average_color.AverageColor(img)))
 }
@@ -13,6 +13,29 @@ type Distr struct {
 	Name       string  `json:"name"`
 	Count      int     `json:"count"`
 	LastUpdate dateInt `db:"last_update" json:"lastUpdate"`
+	Slug       string  `json:"slug"`
+	// URL is the DistroWatch page of the distr, empty if the slug is unknown.
+	URL string `db:"-" json:"url,omitempty"`
+	// Stints are given with parameter dropout=true.
+	Stints []Stint `db:"-" json:"stints,omitempty"`
+}
+
+// Stint is a period between coming to table "distrs" and dropping out, see table "dropout".
+type Stint struct {
+	Name        string   `json:"-"`
+	Stint       int      `json:"stint"`
+	Count       int      `json:"count"`
+	FirstUpdate *dateInt `db:"first_update" json:"firstUpdate"`
+	LastUpdate  dateInt  `db:"last_update" json:"lastUpdate"`
+	// DropDate is null for the current stint.
+	DropDate *dateInt `db:"drop_date" json:"dropDate"`
+}
+
+// DistrGroup is a set of distrs sharing a value of a field of table "distr_info".
+type DistrGroup struct {
+	Value  string   `json:"value"`
+	Count  int      `json:"count"`
+	Distrs []string `json:"distrs"`
 }
 
 func (d dateInt) MarshalJSON() ([]byte, error) {
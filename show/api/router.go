@@ -45,6 +45,8 @@ type Routes []Route
 var routes = Routes{
 	Route{"Status", "GET", "/status", handleStatus},
 	Route{"Distrs", "GET", "/distrs", handleDistrs},
+	Route{"DistrGroups", "GET", "/distrs/groups", handleDistrGroups},
 	Route{"Coords", "GET", "/coords", handleCoords},
 	Route{"AverageColor", "GET", "/average-color", handleAverageColor},
+	Route{"Screenshots", "GET", "/screenshots", handleScreenshots},
 }
@@ -1,9 +1,12 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"math"
+	"strings"
 
+	"github.com/andbar-ru/distrowatch"
 	"github.com/andbar-ru/distrowatch/show"
 )
 
@@ -13,16 +16,53 @@ func check(err error) {
 	}
 }
 
+var (
+	source  = flag.String("source", distrowatch.DefaultSource, "ranking table: default, 1m, 3m, 6m, 12m or a year")
+	base    = flag.String("base", "", "show only distrs based on the distribution")
+	desktop = flag.String("desktop", "", "show only distrs with the desktop")
+	origin  = flag.String("origin", "", "show only distrs from the country")
+	groupBy = flag.String("group-by", "", "group distrs by "+strings.Join(show.GroupFields, ", "))
+)
+
 func main() {
+	flag.Parse()
+	sourceName, _, err := distrowatch.ParseSource(*source)
+	check(err)
+
 	// Print coordinates in one line.
-	coords, err := show.GetCoords()
+	coords, err := show.GetCoords(sourceName)
 	check(err)
-	fmt.Printf("Coordinates: %.4f (%+.4f) %.4f (%+.4f)\n\n",
+	fmt.Printf("Coordinates: %.4f (%+.4f) %.4f (%+.4f)\n",
 		coords.Latitude, coords.LatitudeDelta, coords.Longitude, coords.LongitudeDelta)
+	fmt.Printf("Place: %s\n\n", coords.Place)
 
 	// Print distr stats in a table.
-	distrs, err := show.GetDistrs()
+	distrs, err := show.GetDistrs(sourceName)
 	check(err)
+
+	// Filter and group by info from distr pages.
+	filters := map[string]string{"base": *base, "desktop": *desktop, "origin": *origin}
+	if *base != "" || *desktop != "" || *origin != "" || *groupBy != "" {
+		infos, err := show.GetDistrInfos()
+		check(err)
+		for _, field := range show.GroupFields {
+			if filters[field] != "" {
+				distrs, err = show.FilterDistrs(distrs, infos, field, filters[field])
+				check(err)
+			}
+		}
+		if *groupBy != "" {
+			groups, err := show.GroupDistrs(distrs, infos, *groupBy)
+			check(err)
+			printGroups(groups)
+			return
+		}
+	}
+	if len(distrs) == 0 {
+		fmt.Println("No distrs.")
+		return
+	}
+
 	// Figure out table parameters.
 	var nameFieldSize, countFieldSize int
 	var newestLastUpdate, oldestLastUpdate int
@@ -72,3 +112,26 @@ func main() {
 		fmt.Println()
 	}
 }
+
+// printGroups prints groups in a table: value, total count and distrs.
+func printGroups(groups []*show.Group) {
+	var valueFieldSize, countFieldSize int
+	for _, group := range groups {
+		if group.Value == "" {
+			group.Value = "unknown"
+		}
+		if len(group.Value) > valueFieldSize {
+			valueFieldSize = len(group.Value)
+		}
+		if len(fmt.Sprint(group.Count)) > countFieldSize {
+			countFieldSize = len(fmt.Sprint(group.Count))
+		}
+	}
+	for _, group := range groups {
+		names := make([]string, len(group.Distrs))
+		for i, distr := range group.Distrs {
+			names[i] = distr.Name
+		}
+		fmt.Printf("%-*s %*d %s\n", valueFieldSize, group.Value, countFieldSize, group.Count, strings.Join(names, ", "))
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"database/sql"
 
 	"github.com/andbar-ru/distrowatch"
+	"github.com/andbar-ru/distrowatch/geo"
 )
 
 // Coords desribes the current point and vector from the previous point.
@@ -12,34 +13,46 @@ type Coords struct {
 	Longitude      float64
 	LatitudeDelta  float64
 	LongitudeDelta float64
+	// Place is like "Finland, Helsinki, 12 km" or "Pacific Ocean, Honolulu, 830 km".
+	Place string
 }
 
-// GetCoords returns current coordinates from database.
-func GetCoords() (*Coords, error) {
-	var db, err = distrowatch.GetDB()
+// GetCoords returns current coordinates of the source (see distrowatch.DefaultSource) from database.
+func GetCoords(source string) (*Coords, error) {
+	var db, err = distrowatch.GetReadOnlyDB()
 	if err != nil {
 		return &Coords{}, err
 	}
 	defer db.Close()
 
 	var latitude, longitude float64
-	var latitudeDiff, longitudeDiff float64
-	var latitudeTrend, longitudeTrend int
 	var latitudeDelta, longitudeDelta float64
-	err = db.QueryRow("SELECT latitude, longitude, latitude_diff, longitude_diff, latitude_trend, longitude_trend FROM coords ORDER BY date DESC LIMIT 1").Scan(&latitude, &longitude, &latitudeDiff, &longitudeDiff, &latitudeTrend, &longitudeTrend)
+	var date int
+	var place sql.NullString
+	err = db.QueryRow("SELECT date, latitude, longitude, places.description FROM coords LEFT JOIN places USING (source, date) WHERE source = ? ORDER BY date DESC LIMIT 1", source).Scan(&date, &latitude, &longitude, &place)
 	if err != nil {
 		if err == sql.ErrNoRows {
-			latitude = 60.0
-			latitudeDelta = 0.0
-			longitude = 30.0
-			longitudeDelta = 0.0
+			latitude = geo.Initial.Latitude
+			longitude = geo.Initial.Longitude
 		} else {
 			return &Coords{}, err
 		}
 	} else {
-		latitudeDelta = latitudeDiff * float64(latitudeTrend)
-		longitudeDelta = longitudeDiff * float64(longitudeTrend)
+		// The delta is the actual change since the previous day, the walk starts from geo.Initial.
+		prev := geo.Initial
+		err = db.QueryRow("SELECT latitude, longitude FROM coords WHERE source = ? AND date < ? ORDER BY date DESC LIMIT 1", source, date).Scan(&prev.Latitude, &prev.Longitude)
+		if err != nil && err != sql.ErrNoRows {
+			return &Coords{}, err
+		}
+		delta := geo.Delta(prev, geo.Point{Latitude: latitude, Longitude: longitude})
+		latitudeDelta = delta.Latitude
+		longitudeDelta = delta.Longitude
+	}
+
+	// Places of days before the table places existed are computed on the fly.
+	if !place.Valid {
+		place.String = geo.ReverseGeocode(geo.Point{Latitude: latitude, Longitude: longitude}).String()
 	}
 
-	return &Coords{latitude, longitude, latitudeDelta, longitudeDelta}, nil
+	return &Coords{latitude, longitude, latitudeDelta, longitudeDelta, place.String}, nil
 }
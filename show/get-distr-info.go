@@ -0,0 +1,150 @@
+package show
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/andbar-ru/distrowatch"
+)
+
+// GroupFields are fields of DistrInfo distrs can be filtered and grouped by.
+var GroupFields = []string{"base", "desktop", "origin"}
+
+// DistrInfo describes a distribution as it was given on its page the last time it was fetched.
+type DistrInfo struct {
+	Name          string
+	OSType        string
+	BasedOn       []string
+	Origin        string
+	Architectures []string
+	Desktops      []string
+	Categories    []string
+	Status        string
+	LatestVersion string
+}
+
+// Values returns values of field which is one of GroupFields.
+func (info *DistrInfo) Values(field string) ([]string, error) {
+	switch field {
+	case "base":
+		return info.BasedOn, nil
+	case "desktop":
+		return info.Desktops, nil
+	case "origin":
+		if info.Origin == "" {
+			return nil, nil
+		}
+		return []string{info.Origin}, nil
+	}
+	return nil, fmt.Errorf("invalid field %q, expected one of %s", field, strings.Join(GroupFields, ", "))
+}
+
+// splitList splits a list stored in the table distr_info.
+func splitList(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, ", ")
+}
+
+// GetDistrInfos returns the latest info of distributions by name.
+func GetDistrInfos() (map[string]*DistrInfo, error) {
+	var db, err = distrowatch.GetReadOnlyDB()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	rows, err := db.Query("SELECT name, os_type, based_on, origin, architecture, desktop, category, status, latest_version FROM distr_info_latest")
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	infos := make(map[string]*DistrInfo)
+	for rows.Next() {
+		info := new(DistrInfo)
+		var basedOn, architectures, desktops, categories string
+		err := rows.Scan(&info.Name, &info.OSType, &basedOn, &info.Origin, &architectures, &desktops, &categories, &info.Status, &info.LatestVersion)
+		if err != nil {
+			return nil, err
+		}
+		info.BasedOn = splitList(basedOn)
+		info.Architectures = splitList(architectures)
+		info.Desktops = splitList(desktops)
+		info.Categories = splitList(categories)
+		infos[info.Name] = info
+	}
+	return infos, rows.Err()
+}
+
+// FilterDistrs returns distrs whose field (one of GroupFields) has value.
+func FilterDistrs(distrs []*Distr, infos map[string]*DistrInfo, field, value string) ([]*Distr, error) {
+	filtered := make([]*Distr, 0)
+	for _, distr := range distrs {
+		info, ok := infos[distr.Name]
+		if !ok {
+			info = &DistrInfo{Name: distr.Name}
+		}
+		values, err := info.Values(field)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range values {
+			if strings.EqualFold(v, value) {
+				filtered = append(filtered, distr)
+				break
+			}
+		}
+	}
+	return filtered, nil
+}
+
+// Group is a set of distributions sharing a value of a field.
+type Group struct {
+	Value  string
+	Count  int
+	Distrs []*Distr
+}
+
+// GroupDistrs groups distrs by field (one of GroupFields). A distribution with several values
+// belongs to several groups, a distribution without info belongs to the group with empty value.
+// Groups are sorted by the total count of their distrs.
+func GroupDistrs(distrs []*Distr, infos map[string]*DistrInfo, field string) ([]*Group, error) {
+	groups := make(map[string]*Group)
+	for _, distr := range distrs {
+		info, ok := infos[distr.Name]
+		if !ok {
+			info = &DistrInfo{Name: distr.Name}
+		}
+		values, err := info.Values(field)
+		if err != nil {
+			return nil, err
+		}
+		if len(values) == 0 {
+			values = []string{""}
+		}
+		for _, v := range values {
+			group, ok := groups[v]
+			if !ok {
+				group = &Group{Value: v}
+				groups[v] = group
+			}
+			group.Count += distr.Count
+			group.Distrs = append(group.Distrs, distr)
+		}
+	}
+
+	sorted := make([]*Group, 0, len(groups))
+	for _, group := range groups {
+		sorted = append(sorted, group)
+	}
+	sort.Slice(sorted, func(i, j int) bool {
+		if sorted[i].Count != sorted[j].Count {
+			return sorted[i].Count > sorted[j].Count
+		}
+		return sorted[i].Value < sorted[j].Value
+	})
+	return sorted, nil
+}
@@ -9,19 +9,21 @@ type Distr struct {
 	Name       string
 	Count      int
 	LastUpdate int
+	// Slug identifies the distribution on DistroWatch, it is empty if unknown.
+	Slug string
 }
 
-// GetDistrs returns distribution list from database.
-func GetDistrs() ([]*Distr, error) {
-	var db, err = distrowatch.GetDB()
+// GetDistrs returns distribution list of the source (see distrowatch.DefaultSource) from database.
+func GetDistrs(source string) ([]*Distr, error) {
+	var db, err = distrowatch.GetReadOnlyDB()
 	if err != nil {
 		return nil, err
 	}
 	defer db.Close()
 
 	// Query
-	query := "SELECT name, count, last_update FROM distrs ORDER BY count DESC, last_update ASC"
-	rows, err := db.Query(query)
+	query := "SELECT name, count, last_update, slug FROM distrs WHERE source = ? ORDER BY count DESC, last_update ASC"
+	rows, err := db.Query(query, source)
 	if err != nil {
 		return nil, err
 	}
@@ -31,7 +33,7 @@ func GetDistrs() ([]*Distr, error) {
 	distrs := make([]*Distr, 0)
 	for rows.Next() {
 		d := new(Distr)
-		err := rows.Scan(&d.Name, &d.Count, &d.LastUpdate)
+		err := rows.Scan(&d.Name, &d.Count, &d.LastUpdate, &d.Slug)
 		if err != nil {
 			return nil, err
 		}
@@ -1,7 +1,8 @@
 package show
 
 import (
-	"fmt"
+	"database/sql"
+	"errors"
 	"image"
 	"image/color"
 	"io/ioutil"
@@ -14,29 +15,26 @@ import (
 	"github.com/andbar-ru/distrowatch"
 )
 
-// GetLastDistrImageAverageColor returns average color of the last distr image from distrsDir.
+// ErrNoImages is returned by GetLastDistrImageAverageColor if there are no distr images yet.
+var ErrNoImages = errors.New("there are no distr images yet")
+
+// GetLastDistrImageAverageColor returns average color of the last distr image:
+// the latest decodable screenshot recorded by the parser from any source or, if there is none,
+// the last image in the top level of distrsDir, where images were stored before screenshots were recorded.
+// Returns ErrNoImages if there are no images at all.
 func GetLastDistrImageAverageColor() (color.NRGBA, error) {
-	files, err := ioutil.ReadDir(distrowatch.DistrsDir)
+	lastImage, err := lastScreenshotPath()
 	if err != nil {
 		return color.NRGBA{}, err
 	}
-	// find last image
-	var lastImage string
-	var lastModTime time.Time
-	for _, file := range files {
-		ext := strings.ToLower(path.Ext(file.Name()))
-		// interested only in images
-		if ext != ".png" && ext != ".jpg" && ext != ".jpeg" && ext != ".gif" {
-			continue
-		}
-		modTime := file.ModTime()
-		if modTime.After(lastModTime) {
-			lastModTime = modTime
-			lastImage = path.Join(distrowatch.DistrsDir, file.Name())
+	if lastImage == "" {
+		lastImage, err = lastImageInDir(distrowatch.DistrsDir)
+		if err != nil {
+			return color.NRGBA{}, err
 		}
 	}
 	if lastImage == "" {
-		return color.NRGBA{}, fmt.Errorf("could not find images in directory %s", distrowatch.DistrsDir)
+		return color.NRGBA{}, ErrNoImages
 	}
 	f, err := os.Open(lastImage)
 	if err != nil {
@@ -51,3 +49,42 @@ func GetLastDistrImageAverageColor() (color.NRGBA, error) {
 
 	return averageColor, nil
 }
+
+// lastScreenshotPath returns path of the latest screenshot which the parser could decode, empty if there is none.
+func lastScreenshotPath() (string, error) {
+	var db, err = distrowatch.GetReadOnlyDB()
+	if err != nil {
+		return "", err
+	}
+	defer db.Close()
+
+	var lastImage string
+	err = db.QueryRow("SELECT path FROM screenshots WHERE average_color IS NOT NULL ORDER BY date DESC LIMIT 1").Scan(&lastImage)
+	if err == sql.ErrNoRows {
+		return "", nil
+	}
+	return lastImage, err
+}
+
+// lastImageInDir returns path of the last modified image in dir, not in its subdirectories, empty if there is none.
+func lastImageInDir(dir string) (string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return "", err
+	}
+	var lastImage string
+	var lastModTime time.Time
+	for _, file := range files {
+		ext := strings.ToLower(path.Ext(file.Name()))
+		// interested only in images
+		if file.IsDir() || ext != ".png" && ext != ".jpg" && ext != ".jpeg" && ext != ".gif" {
+			continue
+		}
+		modTime := file.ModTime()
+		if modTime.After(lastModTime) {
+			lastModTime = modTime
+			lastImage = path.Join(dir, file.Name())
+		}
+	}
+	return lastImage, nil
+}
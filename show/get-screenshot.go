@@ -0,0 +1,54 @@
+package show
+
+import (
+	"database/sql"
+
+	"github.com/andbar-ru/distrowatch"
+)
+
+// Screenshot describes a screenshot downloaded by the parser.
+type Screenshot struct {
+	Date     int
+	Name     string
+	URL      string
+	Path     string
+	SHA256   string
+	Size     int
+	MIMEType string
+	Width    int
+	Height   int
+	// AverageColor is "#rrggbb" or "#rrggbbaa", empty if the image could not be decoded.
+	AverageColor string
+}
+
+// GetScreenshot returns the screenshot of the source of the given date in format YYYYMMDD, the latest one if date is 0.
+// Returns sql.ErrNoRows if there is no such screenshot.
+func GetScreenshot(source string, date int) (*Screenshot, error) {
+	var db, err = distrowatch.GetReadOnlyDB()
+	if err != nil {
+		return nil, err
+	}
+	defer db.Close()
+
+	query := "SELECT date, name, url, path, sha256, size, mime_type, width, height, average_color FROM screenshots WHERE source = ?"
+	args := []interface{}{source}
+	if date == 0 {
+		query += " ORDER BY date DESC LIMIT 1"
+	} else {
+		query += " AND date = ?"
+		args = append(args, date)
+	}
+
+	s := new(Screenshot)
+	var width, height sql.NullInt64
+	var averageColor sql.NullString
+	err = db.QueryRow(query, args...).Scan(&s.Date, &s.Name, &s.URL, &s.Path, &s.SHA256, &s.Size, &s.MIMEType, &width, &height, &averageColor)
+	if err != nil {
+		return nil, err
+	}
+	s.Width = int(width.Int64)
+	s.Height = int(height.Int64)
+	s.AverageColor = averageColor.String
+
+	return s, nil
+}
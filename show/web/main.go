@@ -1,11 +1,13 @@
 package main
 
 import (
+	"database/sql"
 	"fmt"
 	"html/template"
 	"math"
 	"net/http"
 
+	"github.com/andbar-ru/distrowatch"
 	"github.com/andbar-ru/distrowatch/show"
 )
 
@@ -23,22 +25,41 @@ type Distr struct {
 	Newest        bool
 	Oldest        bool
 	LastUpdateStr string
+	// URL is the DistroWatch page of the distr, empty if its slug is unknown.
+	URL string
 }
 
 func index(writer http.ResponseWriter, request *http.Request) {
-	coords, err := show.GetCoords()
+	// Parameter "source" selects the ranking table.
+	source := request.URL.Query().Get("source")
+	if source == "" {
+		source = distrowatch.DefaultSource
+	}
+	source, _, err := distrowatch.ParseSource(source)
+	if err != nil {
+		http.Error(writer, err.Error(), http.StatusBadRequest)
+		return
+	}
+	coords, err := show.GetCoords(source)
 	check(err)
 
-	averageColor, err := show.GetLastDistrImageAverageColor()
-	check(err)
+	// Take average color from the last screenshot of the source recorded by the parser,
+	// fall back to the last image of any source, there may be none on a fresh install.
 	var averageColorStr string
-	if averageColor.A == 0xff {
-		averageColorStr = fmt.Sprintf("#%02x%02x%02x", averageColor.R, averageColor.G, averageColor.B)
+	screenshot, err := show.GetScreenshot(source, 0)
+	if err == nil && screenshot.AverageColor != "" {
+		averageColorStr = screenshot.AverageColor
+	} else if err == nil || err == sql.ErrNoRows {
+		averageColor, err := show.GetLastDistrImageAverageColor()
+		if err != show.ErrNoImages {
+			check(err)
+			averageColorStr = distrowatch.FormatColor(averageColor)
+		}
 	} else {
-		averageColorStr = fmt.Sprintf("#%02x%02x%02x%02x", averageColor.R, averageColor.G, averageColor.B, averageColor.A)
+		check(err)
 	}
 
-	distrs, err := show.GetDistrs()
+	distrs, err := show.GetDistrs(source)
 	check(err)
 	tmplDistrs := make([]*Distr, 0, len(distrs))
 	var newestLastUpdate, oldestLastUpdate int
@@ -54,11 +75,18 @@ func index(writer http.ResponseWriter, request *http.Request) {
 		d.Number = i + 1
 		lastUpdateStr := fmt.Sprint(distr.LastUpdate)
 		d.LastUpdateStr = fmt.Sprintf("%s-%s-%s", lastUpdateStr[:4], lastUpdateStr[4:6], lastUpdateStr[6:8])
+		if distr.Slug != "" {
+			d.URL = distrowatch.DistrPageURL(distr.Slug)
+		}
 		tmplDistrs = append(tmplDistrs, d)
 	}
 
 	// Difference between adjacent distrs to define leaders.
-	diff := int(math.Ceil(float64(distrs[0].Count) / 10))
+	// There are no distrs in a source which is not updated yet.
+	var diff int
+	if len(distrs) > 0 {
+		diff = int(math.Ceil(float64(distrs[0].Count) / 10))
+	}
 
 	// Define leaders from the end.
 	lastLeaderIndex := -1 // no leaders by default
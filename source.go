@@ -0,0 +1,30 @@
+package distrowatch
+
+import (
+	"fmt"
+	"strconv"
+)
+
+// sourceDataSpans maps names of sources over the last months to values of the parameter dataspan
+// of the main page, which are numbers of weeks. The table over the last 6 months (26 weeks) is the default one.
+var sourceDataSpans = map[string]string{
+	"1m":  "4",
+	"3m":  "13",
+	"12m": "52",
+}
+
+// ParseSource parses the name of a ranking table: "default", "1m", "3m", "6m", "12m" (last months) or a year like "2023".
+// Returns the name the table is recorded under, "6m" is DefaultSource, and the value of the parameter dataspan
+// of the main page, empty for the default table.
+func ParseSource(s string) (name, dataSpan string, err error) {
+	if s == DefaultSource || s == "6m" {
+		return DefaultSource, "", nil
+	}
+	if dataSpan, ok := sourceDataSpans[s]; ok {
+		return s, dataSpan, nil
+	}
+	if year, err := strconv.Atoi(s); err == nil && year >= 2002 && len(s) == 4 {
+		return s, s, nil
+	}
+	return "", "", fmt.Errorf("invalid source %q, expected default, 1m, 3m, 6m, 12m or a year", s)
+}